@@ -0,0 +1,305 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"goods_srv/global"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
+)
+
+// ===============================================
+// 商品服务读缓存：本地LRU + Redis 两级缓存
+// ===============================================
+//
+// 读取顺序：本地LRU -> Redis -> 数据库（回源）
+// 1. 防击穿：同一进程内相同key的回源请求通过singleflight合并，只查一次数据库
+// 2. 防雪崩：Redis过期时间附加随机抖动，避免大量key同时失效
+// 3. 防穿透：不存在的记录写入空值标记，短时间内直接返回ErrNotFound
+// 4. 失效：写操作后删除Redis和本地缓存，并通过Redis发布订阅通知其他副本清理本地缓存
+//
+// Redis不可用（或未初始化）时自动降级为仅使用本地缓存
+
+const (
+	keyPrefix         = "goods_srv:cache:"
+	namespaceSetKey   = "goods_srv:cache:ns:"
+	invalidateChannel = "goods_srv:cache:invalidate"
+	nullValue         = "__null__" // 不能用JSON的null，空切片序列化后也是null
+
+	defaultLocalSize = 1000
+	defaultLocalTTL  = 30 * time.Second
+	defaultRedisTTL  = 10 * time.Minute
+	defaultNullTTL   = time.Minute
+
+	// 延迟双删间隔，覆盖写库与删缓存之间并发读回填旧值的窗口
+	delayDeleteInterval = 500 * time.Millisecond
+	// 广播消息中表示清理整个命名空间的后缀
+	namespaceWildcard = "*"
+)
+
+// ErrNotFound 记录不存在（包括命中空值缓存）
+var ErrNotFound = errors.New("缓存记录不存在")
+
+var (
+	local     *localCache
+	localOnce sync.Once
+	group     singleflight.Group
+	pubsub    *redis.PubSub
+)
+
+// Init 初始化缓存组件，并订阅其他副本发出的失效通知
+func Init() {
+	getLocal()
+
+	if global.RedisClient == nil {
+		zap.S().Warn("Redis未初始化，商品缓存仅使用本地缓存")
+		return
+	}
+
+	pubsub = global.RedisClient.Subscribe(context.Background(), invalidateChannel)
+	go func() {
+		for msg := range pubsub.Channel() {
+			evictLocal(msg.Payload)
+		}
+	}()
+	zap.S().Info("商品缓存初始化完成")
+}
+
+// Close 停止订阅失效通知
+func Close() {
+	if pubsub != nil {
+		if err := pubsub.Close(); err != nil {
+			zap.S().Errorf("关闭缓存失效订阅失败: %v", err)
+		}
+	}
+}
+
+// GetOrLoad 读取缓存，未命中时调用loader回源并写入缓存
+// loader返回gorm.ErrRecordNotFound时写入空值缓存并返回ErrNotFound
+func GetOrLoad[T any](ctx context.Context, key string, loader func() (T, error)) (T, error) {
+	var result T
+
+	data, err := get(ctx, key)
+	if err != nil {
+		return result, err
+	}
+	if data == nil {
+		v, err, _ := group.Do(key, func() (interface{}, error) {
+			return load(ctx, key, loader)
+		})
+		if err != nil {
+			return result, err
+		}
+		data = v.([]byte)
+	}
+
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// Invalidate 删除指定key的缓存
+func Invalidate(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if global.RedisClient != nil {
+			if err := global.RedisClient.Del(ctx, keyPrefix+key).Err(); err != nil {
+				zap.S().Warnf("删除Redis缓存失败，key: %s，错误: %v", key, err)
+			}
+		}
+		evictLocal(key)
+		publish(ctx, key)
+	}
+	delayInvalidate(keys, nil)
+}
+
+// InvalidateNamespace 删除命名空间下的全部缓存，用于列表类缓存
+func InvalidateNamespace(ctx context.Context, namespaces ...string) {
+	for _, ns := range namespaces {
+		deleteNamespace(ctx, ns)
+		evictLocal(ns + ":" + namespaceWildcard)
+		publish(ctx, ns+":"+namespaceWildcard)
+	}
+	delayInvalidate(nil, namespaces)
+}
+
+// get 依次查询本地缓存和Redis，均未命中时返回nil
+func get(ctx context.Context, key string) ([]byte, error) {
+	if data, ok := getLocal().Get(key); ok {
+		return decodeNull(data)
+	}
+
+	if global.RedisClient == nil {
+		return nil, nil
+	}
+	data, err := global.RedisClient.Get(ctx, keyPrefix+key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			zap.S().Warnf("读取Redis缓存失败，降级回源，key: %s，错误: %v", key, err)
+		}
+		return nil, nil
+	}
+
+	getLocal().Set(key, data, localTTL())
+	return decodeNull(data)
+}
+
+// load 回源加载数据并写入两级缓存
+func load[T any](ctx context.Context, key string, loader func() (T, error)) ([]byte, error) {
+	// singleflight排队期间可能已有其他副本回填了Redis
+	if data, err := get(ctx, key); err != nil || data != nil {
+		return data, err
+	}
+
+	value, err := loader()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			set(ctx, key, []byte(nullValue), nullTTL())
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	set(ctx, key, data, redisTTL())
+	return data, nil
+}
+
+// set 写入两级缓存，Redis过期时间附加最多10%的随机抖动
+func set(ctx context.Context, key string, data []byte, ttl time.Duration) {
+	lttl := localTTL()
+	if ttl < lttl {
+		lttl = ttl
+	}
+	getLocal().Set(key, data, lttl)
+
+	if global.RedisClient == nil {
+		return
+	}
+	jitter := time.Duration(rand.Int63n(int64(ttl)/10 + 1))
+	pipe := global.RedisClient.TxPipeline()
+	pipe.Set(ctx, keyPrefix+key, data, ttl+jitter)
+	if ns := namespaceOf(key); ns != "" {
+		pipe.SAdd(ctx, namespaceSetKey+ns, key)
+		pipe.Expire(ctx, namespaceSetKey+ns, redisTTL()*2)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		zap.S().Warnf("写入Redis缓存失败，key: %s，错误: %v", key, err)
+	}
+}
+
+// deleteNamespace 删除Redis中命名空间下记录的所有key
+func deleteNamespace(ctx context.Context, ns string) {
+	if global.RedisClient == nil {
+		return
+	}
+	keys, err := global.RedisClient.SMembers(ctx, namespaceSetKey+ns).Result()
+	if err != nil {
+		zap.S().Warnf("查询缓存命名空间失败，namespace: %s，错误: %v", ns, err)
+		return
+	}
+	redisKeys := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		redisKeys = append(redisKeys, keyPrefix+key)
+	}
+	redisKeys = append(redisKeys, namespaceSetKey+ns)
+	if err := global.RedisClient.Del(ctx, redisKeys...).Err(); err != nil {
+		zap.S().Warnf("删除缓存命名空间失败，namespace: %s，错误: %v", ns, err)
+	}
+}
+
+// delayInvalidate 延迟双删：写库后并发读可能把旧值回填到缓存，稍后再删一次
+func delayInvalidate(keys []string, namespaces []string) {
+	time.AfterFunc(delayDeleteInterval, func() {
+		ctx := context.Background()
+		for _, key := range keys {
+			if global.RedisClient != nil {
+				global.RedisClient.Del(ctx, keyPrefix+key)
+			}
+			evictLocal(key)
+			publish(ctx, key)
+		}
+		for _, ns := range namespaces {
+			deleteNamespace(ctx, ns)
+			evictLocal(ns + ":" + namespaceWildcard)
+			publish(ctx, ns+":"+namespaceWildcard)
+		}
+	})
+}
+
+// publish 通知其他副本清理本地缓存
+func publish(ctx context.Context, payload string) {
+	if global.RedisClient == nil {
+		return
+	}
+	if err := global.RedisClient.Publish(ctx, invalidateChannel, payload).Err(); err != nil {
+		zap.S().Warnf("发布缓存失效通知失败: %v", err)
+	}
+}
+
+// evictLocal 清理本地缓存，payload以":*"结尾时清理整个命名空间
+func evictLocal(payload string) {
+	if strings.HasSuffix(payload, ":"+namespaceWildcard) {
+		getLocal().DeletePrefix(strings.TrimSuffix(payload, namespaceWildcard))
+		return
+	}
+	getLocal().Delete(payload)
+}
+
+func decodeNull(data []byte) ([]byte, error) {
+	if string(data) == nullValue {
+		return nil, ErrNotFound
+	}
+	return data, nil
+}
+
+func namespaceOf(key string) string {
+	if i := strings.Index(key, ":"); i > 0 {
+		return key[:i]
+	}
+	return ""
+}
+
+func getLocal() *localCache {
+	localOnce.Do(func() {
+		size := 0
+		if global.ServerConfig != nil {
+			size = global.ServerConfig.Cache.LocalSize
+		}
+		local = newLocalCache(size)
+	})
+	return local
+}
+
+func localTTL() time.Duration {
+	if global.ServerConfig != nil && global.ServerConfig.Cache.LocalTTL > 0 {
+		return time.Duration(global.ServerConfig.Cache.LocalTTL) * time.Second
+	}
+	return defaultLocalTTL
+}
+
+func redisTTL() time.Duration {
+	if global.ServerConfig != nil && global.ServerConfig.Cache.RedisTTL > 0 {
+		return time.Duration(global.ServerConfig.Cache.RedisTTL) * time.Second
+	}
+	return defaultRedisTTL
+}
+
+func nullTTL() time.Duration {
+	if global.ServerConfig != nil && global.ServerConfig.Cache.NullTTL > 0 {
+		return time.Duration(global.ServerConfig.Cache.NullTTL) * time.Second
+	}
+	return defaultNullTTL
+}
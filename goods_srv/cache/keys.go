@@ -0,0 +1,36 @@
+package cache
+
+import "fmt"
+
+// 缓存命名空间，key格式为 "<命名空间>:<标识>"
+const (
+	NamespaceGoods    = "goods"
+	NamespaceCategory = "category"
+	NamespaceBrand    = "brand"
+	NamespaceBanner   = "banner"
+)
+
+// GoodsDetailKey 商品详情缓存key
+func GoodsDetailKey(id int32) string {
+	return fmt.Sprintf("%s:%d", NamespaceGoods, id)
+}
+
+// CategoryListKey 全部分类缓存key
+func CategoryListKey() string {
+	return NamespaceCategory + ":all"
+}
+
+// BrandListKey 品牌列表缓存key，包含分页、过滤和排序条件
+func BrandListKey(page, pageSize int32, name, desc, sortBy string, sortDesc bool) string {
+	return fmt.Sprintf("%s:list:%d:%d:%s:%s:%s:%t", NamespaceBrand, page, pageSize, name, desc, sortBy, sortDesc)
+}
+
+// BannerListKey 轮播图列表缓存key，按投放位置和定向条件区分
+func BannerListKey(placement string, categoryId, brandId int32) string {
+	return fmt.Sprintf("%s:list:%s:%d:%d", NamespaceBanner, placement, categoryId, brandId)
+}
+
+// CategoryAttributeKey 分类属性模板缓存key，包含上级分类属性
+func CategoryAttributeKey(categoryId int32) string {
+	return fmt.Sprintf("%s:attrs:%d", NamespaceCategory, categoryId)
+}
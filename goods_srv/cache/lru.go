@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+// localCache 进程内LRU缓存，每个条目带独立过期时间
+// 作为Redis之前的一级缓存，缓存序列化后的字节，避免调用方修改共享对象
+type localCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type localEntry struct {
+	key      string
+	value    []byte
+	expireAt time.Time
+}
+
+// newLocalCache 创建本地缓存，capacity为最大条目数
+func newLocalCache(capacity int) *localCache {
+	if capacity <= 0 {
+		capacity = defaultLocalSize
+	}
+	return &localCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Get 获取缓存，过期条目视为未命中并被移除
+func (c *localCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*localEntry)
+	if time.Now().After(entry.expireAt) {
+		c.removeElement(elem)
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set 写入缓存，超出容量时淘汰最久未使用的条目
+func (c *localCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expireAt := time.Now().Add(ttl)
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*localEntry)
+		entry.value = value
+		entry.expireAt = expireAt
+		c.ll.MoveToFront(elem)
+		return
+	}
+
+	elem := c.ll.PushFront(&localEntry{key: key, value: value, expireAt: expireAt})
+	c.items[key] = elem
+	for c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Delete 删除指定缓存
+func (c *localCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// DeletePrefix 删除所有以prefix开头的缓存
+func (c *localCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, elem := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(elem)
+		}
+	}
+}
+
+// Len 当前缓存条目数
+func (c *localCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *localCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*localEntry).key)
+}
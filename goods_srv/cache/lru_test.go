@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLocalCacheEviction 测试超出容量时淘汰最久未使用的条目
+func TestLocalCacheEviction(t *testing.T) {
+	c := newLocalCache(2)
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Minute)
+
+	// 访问a，使b成为最久未使用
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("期望命中a")
+	}
+	c.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("b应已被淘汰")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("a不应被淘汰")
+	}
+	if c.Len() != 2 {
+		t.Errorf("缓存条目数不匹配，期望: 2, 实际: %d", c.Len())
+	}
+}
+
+// TestLocalCacheExpire 测试过期条目不会命中
+func TestLocalCacheExpire(t *testing.T) {
+	c := newLocalCache(10)
+	c.Set("a", []byte("1"), 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Error("过期条目不应命中")
+	}
+	if c.Len() != 0 {
+		t.Errorf("过期条目应被移除，实际条目数: %d", c.Len())
+	}
+}
+
+// TestLocalCacheDeletePrefix 测试按命名空间清理
+func TestLocalCacheDeletePrefix(t *testing.T) {
+	c := newLocalCache(10)
+	c.Set("brand:list:1:10::", []byte("1"), time.Minute)
+	c.Set("brand:list:2:10::", []byte("2"), time.Minute)
+	c.Set("goods:1", []byte("3"), time.Minute)
+
+	c.DeletePrefix(NamespaceBrand + ":")
+
+	if _, ok := c.Get("brand:list:1:10::"); ok {
+		t.Error("品牌列表缓存应被清理")
+	}
+	if _, ok := c.Get("goods:1"); !ok {
+		t.Error("商品缓存不应被清理")
+	}
+}
+
+// TestNullValue 测试空值标记与空列表区分
+func TestNullValue(t *testing.T) {
+	if _, err := decodeNull([]byte(nullValue)); err != ErrNotFound {
+		t.Errorf("空值标记应返回ErrNotFound，实际: %v", err)
+	}
+	if data, err := decodeNull([]byte("null")); err != nil || string(data) != "null" {
+		t.Errorf("JSON null应原样返回，实际: %s, %v", data, err)
+	}
+}
@@ -38,6 +38,19 @@ type ServerConfig struct {
 		MaxAge     int    `mapstructure:"max_age"`
 		MaxBackups int    `mapstructure:"max_backups"`
 	} `mapstructure:"log"`
+
+	Redis struct {
+		Host string `mapstructure:"host"`
+		Port int    `mapstructure:"port"`
+	} `mapstructure:"redis"`
+
+	// Cache 读缓存配置，时间单位均为秒，未配置时使用默认值
+	Cache struct {
+		LocalSize int `mapstructure:"local_size"` // 本地LRU最大条目数
+		LocalTTL  int `mapstructure:"local_ttl"`  // 本地缓存过期时间
+		RedisTTL  int `mapstructure:"redis_ttl"`  // Redis缓存过期时间
+		NullTTL   int `mapstructure:"null_ttl"`   // 空值缓存过期时间（防穿透）
+	} `mapstructure:"cache"`
 }
 
 // NacosConfig 是 Nacos 配置的结构体
@@ -12,7 +12,9 @@ import (
 	"goods_srv/config"
 
 	"github.com/hashicorp/consul/api"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
 
@@ -21,4 +23,6 @@ var (
 	Logger       *zap.SugaredLogger
 	ServerConfig *config.ServerConfig
 	ConsulClient *api.Client
+	RedisClient  *redis.Client
+	UseropClient *grpc.ClientConn
 )
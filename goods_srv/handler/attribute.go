@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"goods_srv/cache"
+	"goods_srv/global"
+	"goods_srv/model"
+	"goods_srv/proto"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// CategoryAttributeList 获取分类属性模板（读缓存），包含上级分类定义的属性
+func (s *GoodsServer) CategoryAttributeList(ctx context.Context, req *proto.CategoryAttributeFilterRequest) (*proto.CategoryAttributeListResponse, error) {
+	attrs, err := cache.GetOrLoad(ctx, cache.CategoryAttributeKey(req.CategoryId), func() ([]model.CategoryAttribute, error) {
+		return model.GetCategoryAttributes(uint(req.CategoryId))
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询分类属性失败")
+	}
+
+	var attrList []*proto.CategoryAttributeResponse
+	for _, a := range attrs {
+		attrList = append(attrList, ModelToProtoCategoryAttribute(&a))
+	}
+
+	return &proto.CategoryAttributeListResponse{
+		Total: int32(len(attrList)),
+		Data:  attrList,
+	}, nil
+}
+
+// CreateCategoryAttribute 创建分类属性
+func (s *GoodsServer) CreateCategoryAttribute(ctx context.Context, req *proto.CategoryAttributeRequest) (*proto.CategoryAttributeResponse, error) {
+	attr := ProtoToModelCategoryAttribute(req)
+	if err := validateCategoryAttribute(attr); err != nil {
+		return nil, err
+	}
+	if err := global.DB.First(&model.Category{}, req.CategoryId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "分类不存在")
+		}
+		return nil, status.Error(codes.Internal, "查询分类失败")
+	}
+
+	if err := model.CreateCategoryAttribute(attr); err != nil {
+		if errors.Is(err, model.ErrAttributeNameExists) {
+			return nil, status.Error(codes.InvalidArgument, "属性名称已存在")
+		}
+		zap.S().Errorf("创建分类属性失败: %v", err)
+		return nil, status.Error(codes.Internal, "创建分类属性失败")
+	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceCategory)
+
+	return ModelToProtoCategoryAttribute(attr), nil
+}
+
+// UpdateCategoryAttribute 更新分类属性，已有商品的属性值在下次更新商品时按新模板校验
+func (s *GoodsServer) UpdateCategoryAttribute(ctx context.Context, req *proto.CategoryAttributeRequest) (*emptypb.Empty, error) {
+	var attr model.CategoryAttribute
+	if err := global.DB.First(&attr, req.Id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "分类属性不存在")
+		}
+		return nil, status.Error(codes.Internal, "查询分类属性失败")
+	}
+
+	update := ProtoToModelCategoryAttribute(req)
+	attr.Name = update.Name
+	attr.Type = update.Type
+	attr.Unit = update.Unit
+	attr.Options = update.Options
+	attr.Required = update.Required
+	attr.Sort = update.Sort
+	if err := validateCategoryAttribute(&attr); err != nil {
+		return nil, err
+	}
+
+	if err := model.UpdateCategoryAttribute(&attr); err != nil {
+		if errors.Is(err, model.ErrAttributeNameExists) {
+			return nil, status.Error(codes.InvalidArgument, "属性名称已存在")
+		}
+		zap.S().Errorf("更新分类属性失败: %v", err)
+		return nil, status.Error(codes.Internal, "更新分类属性失败")
+	}
+	// 商品详情包含属性名称和单位
+	cache.InvalidateNamespace(ctx, cache.NamespaceCategory, cache.NamespaceGoods)
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteCategoryAttribute 删除分类属性，同时删除商品上的对应属性值
+func (s *GoodsServer) DeleteCategoryAttribute(ctx context.Context, req *proto.CategoryAttributeRequest) (*emptypb.Empty, error) {
+	if err := model.DeleteCategoryAttribute(uint(req.Id)); err != nil {
+		zap.S().Errorf("删除分类属性失败: %v", err)
+		return nil, status.Error(codes.Internal, "删除分类属性失败")
+	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceCategory, cache.NamespaceGoods)
+	return &emptypb.Empty{}, nil
+}
+
+// validateCategoryAttribute 校验属性模板定义
+func validateCategoryAttribute(attr *model.CategoryAttribute) error {
+	if attr.Name == "" {
+		return status.Error(codes.InvalidArgument, "属性名称不能为空")
+	}
+	if !model.IsValidAttributeType(attr.Type) {
+		return status.Error(codes.InvalidArgument, "属性类型无效")
+	}
+	if attr.Type == model.AttributeTypeEnum && len(attr.Options) == 0 {
+		return status.Error(codes.InvalidArgument, "枚举类型属性必须配置可选值")
+	}
+	return nil
+}
+
+// validateGoodsAttributes 按商品分类的属性模板校验属性值
+func validateGoodsAttributes(categoryIds []uint, values []model.GoodsAttributeValue) ([]model.GoodsAttributeValue, error) {
+	templates, err := model.GetCategoryAttributes(categoryIds...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询分类属性失败")
+	}
+	validated, err := model.ValidateGoodsAttributes(templates, values)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return validated, nil
+}
@@ -10,25 +10,41 @@ package handler
 
 import (
 	"context"
+	"goods_srv/cache"
 	"goods_srv/global"
 	"goods_srv/model"
 	"goods_srv/proto"
+	"time"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 )
 
-// BannerList 获取轮播图列表
-func (s *GoodsServer) BannerList(ctx context.Context, _ *emptypb.Empty) (*proto.BannerListResponse, error) {
-	banners, err := model.GetBannerList()
+// BannerList 获取投放位置下当前生效的轮播图列表（读缓存）
+// 缓存中保存投放位置下所有启用的轮播图，投放时间在读取后按当前时间过滤，避免缓存过期前投放状态不更新
+func (s *GoodsServer) BannerList(ctx context.Context, req *proto.BannerFilterRequest) (*proto.BannerListResponse, error) {
+	placement := req.Placement
+	if placement == "" {
+		placement = model.BannerPlacementHome
+	}
+
+	key := cache.BannerListKey(placement, req.CategoryId, req.BrandId)
+	banners, err := cache.GetOrLoad(ctx, key, func() ([]model.Banner, error) {
+		return model.GetBannerList(placement, uint(req.CategoryId), uint(req.BrandId))
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	now := time.Now()
 	var bannerList []*proto.BannerResponse
 	for _, b := range banners {
+		if !b.IsActive(now) {
+			continue
+		}
 		bannerList = append(bannerList, ModelToProtoBanner(&b))
 	}
 
@@ -41,10 +57,14 @@ func (s *GoodsServer) BannerList(ctx context.Context, _ *emptypb.Empty) (*proto.
 // CreateBanner 创建轮播图
 func (s *GoodsServer) CreateBanner(ctx context.Context, req *proto.BannerRequest) (*proto.BannerResponse, error) {
 	banner := ProtoToModelBanner(req)
+	if err := validateBanner(banner); err != nil {
+		return nil, err
+	}
 	err := model.CreateBanner(banner)
 	if err != nil {
 		return nil, err
 	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceBanner)
 
 	return ModelToProtoBanner(banner), nil
 }
@@ -55,6 +75,7 @@ func (s *GoodsServer) DeleteBanner(ctx context.Context, req *proto.BannerRequest
 	if err != nil {
 		return nil, err
 	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceBanner)
 	return &emptypb.Empty{}, nil
 }
 
@@ -69,15 +90,72 @@ func (s *GoodsServer) UpdateBanner(ctx context.Context, req *proto.BannerRequest
 		return nil, status.Error(codes.Internal, "查询轮播图失败")
 	}
 
-	// 更新轮播图信息
-	banner.Image = req.Image
-	banner.Url = req.Url
-	banner.Index = int(req.Index)
+	// 更新轮播图信息，投放时间按合并后的结果校验
+	applyBannerRequest(&banner, req)
+	if err := validateBanner(&banner); err != nil {
+		return nil, err
+	}
 
 	err := model.UpdateBanner(&banner)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "更新轮播图失败")
 	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceBanner)
+
+	return &emptypb.Empty{}, nil
+}
+
+// RecordBannerClick 记录轮播图点击
+func (s *GoodsServer) RecordBannerClick(ctx context.Context, req *proto.BannerClickRequest) (*emptypb.Empty, error) {
+	var banner model.Banner
+	if err := global.DB.First(&banner, req.Id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "轮播图不存在")
+		}
+		return nil, status.Error(codes.Internal, "查询轮播图失败")
+	}
 
+	if err := model.RecordBannerClick(&banner, time.Now()); err != nil {
+		zap.S().Errorf("记录轮播图点击失败，bannerId: %d，错误: %v", req.Id, err)
+		return nil, status.Error(codes.Internal, "记录轮播图点击失败")
+	}
 	return &emptypb.Empty{}, nil
 }
+
+// BannerClickStats 按轮播图汇总点击数
+func (s *GoodsServer) BannerClickStats(ctx context.Context, req *proto.BannerClickStatsRequest) (*proto.BannerClickStatsResponse, error) {
+	ids := make([]uint, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		ids = append(ids, uint(id))
+	}
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	stats, err := model.GetBannerClickStats(ids, req.Placement, start, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "查询轮播图点击统计失败")
+	}
+
+	resp := &proto.BannerClickStatsResponse{Total: int32(len(stats))}
+	for _, stat := range stats {
+		resp.Data = append(resp.Data, &proto.BannerClickStat{
+			BannerId:  int32(stat.BannerId),
+			Placement: stat.Placement,
+			ClickNum:  stat.ClickNum,
+		})
+	}
+	return resp, nil
+}
+
+// validateBanner 校验轮播图投放时间
+func validateBanner(b *model.Banner) error {
+	if b.StartTime != nil && b.EndTime != nil && !b.EndTime.After(*b.StartTime) {
+		return status.Error(codes.InvalidArgument, "结束时间必须晚于开始时间")
+	}
+	return nil
+}
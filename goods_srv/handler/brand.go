@@ -10,26 +10,52 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"goods_srv/cache"
 	"goods_srv/global"
 	"goods_srv/model"
 	"goods_srv/proto"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 )
 
-// BrandList 获取品牌列表
+// brandListResult 品牌列表缓存内容
+type brandListResult struct {
+	Brands []model.BrandListItem
+	Total  int64
+}
+
+// BrandList 获取品牌列表（读缓存），附带在售商品数
 func (s *GoodsServer) BrandList(ctx context.Context, req *proto.BrandFilterRequest) (*proto.BrandListResponse, error) {
-	brands, total, err := model.GetBrandList(int(req.Pages), int(req.PagePerNums), req.Name, req.Desc)
+	key := cache.BrandListKey(req.Pages, req.PagePerNums, req.Name, req.Desc, req.SortBy, req.SortDesc)
+	result, err := cache.GetOrLoad(ctx, key, func() (*brandListResult, error) {
+		brands, total, err := model.GetBrandList(&model.BrandFilter{
+			Page:     int(req.Pages),
+			PageSize: int(req.PagePerNums),
+			Name:     req.Name,
+			Desc:     req.Desc,
+			SortBy:   req.SortBy,
+			SortDesc: req.SortDesc,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &brandListResult{Brands: brands, Total: total}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
+	brands, total := result.Brands, result.Total
 
 	var brandList []*proto.BrandInfoResponse
 	for _, b := range brands {
-		brandList = append(brandList, ModelToProtoBrand(&b))
+		info := ModelToProtoBrand(&b.Brand)
+		info.GoodsNum = int32(b.GoodsNum)
+		brandList = append(brandList, info)
 	}
 
 	return &proto.BrandListResponse{
@@ -52,8 +78,13 @@ func (s *GoodsServer) CreateBrand(ctx context.Context, req *proto.BrandRequest)
 	brand := ProtoToModelBrand(req)
 	err = model.CreateBrand(brand)
 	if err != nil {
+		// 并发创建同名品牌时由唯一索引兜底
+		if errors.Is(err, model.ErrBrandNameExists) {
+			return nil, status.Error(codes.InvalidArgument, "品牌名称已存在")
+		}
 		return nil, status.Error(codes.Internal, "创建品牌失败")
 	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceBrand)
 
 	return ModelToProtoBrand(brand), nil
 }
@@ -64,6 +95,7 @@ func (s *GoodsServer) DeleteBrand(ctx context.Context, req *proto.BrandRequest)
 	if err != nil {
 		return nil, err
 	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceBrand)
 	return &emptypb.Empty{}, nil
 }
 
@@ -88,11 +120,40 @@ func (s *GoodsServer) UpdateBrand(ctx context.Context, req *proto.BrandRequest)
 	}
 
 	// 更新品牌信息
-	brand = *ProtoToModelBrand(req)
+	brand.Name = req.Name
+	brand.Logo = req.Logo
+	brand.Desc = req.Desc
 	err = model.UpdateBrand(&brand)
 	if err != nil {
+		if errors.Is(err, model.ErrBrandNameExists) {
+			return nil, status.Error(codes.InvalidArgument, "品牌名称已存在")
+		}
 		return nil, status.Error(codes.Internal, "更新品牌失败")
 	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceBrand)
 
 	return &emptypb.Empty{}, nil
 }
+
+// MergeBrand 合并品牌，源品牌下的商品、分类关联和定向轮播图迁移到目标品牌后删除源品牌
+func (s *GoodsServer) MergeBrand(ctx context.Context, req *proto.MergeBrandRequest) (*proto.MergeBrandResponse, error) {
+	if req.SourceId <= 0 || req.TargetId <= 0 || req.SourceId == req.TargetId {
+		return nil, status.Error(codes.InvalidArgument, "源品牌和目标品牌必须为不同的有效品牌")
+	}
+
+	result, err := model.MergeBrand(uint(req.SourceId), uint(req.TargetId))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "品牌不存在")
+		}
+		zap.S().Errorf("合并品牌失败，sourceId: %d，targetId: %d，错误: %v", req.SourceId, req.TargetId, err)
+		return nil, status.Error(codes.Internal, "合并品牌失败")
+	}
+	// 商品详情包含品牌信息，合并后一并清理
+	cache.InvalidateNamespace(ctx, cache.NamespaceBrand, cache.NamespaceGoods, cache.NamespaceBanner)
+
+	return &proto.MergeBrandResponse{
+		GoodsNum:         int32(result.GoodsNum),
+		CategoryBrandNum: int32(result.CategoryBrandNum),
+	}, nil
+}
@@ -10,15 +10,16 @@ package handler
 
 import (
 	"context"
+	"goods_srv/cache"
 	"goods_srv/model"
 	"goods_srv/proto"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// GetAllCategoriesList 获取所有分类
+// GetAllCategoriesList 获取所有分类（读缓存）
 func (s *GoodsServer) GetAllCategoriesList(ctx context.Context, _ *emptypb.Empty) (*proto.CategoryListResponse, error) {
-	categories, err := model.GetAllCategories()
+	categories, err := cache.GetOrLoad(ctx, cache.CategoryListKey(), model.GetAllCategories)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +62,7 @@ func (s *GoodsServer) CreateCategory(ctx context.Context, req *proto.CategoryInf
 	if err != nil {
 		return nil, err
 	}
+	cache.InvalidateNamespace(ctx, cache.NamespaceCategory)
 
 	return ModelToProtoCategory(category), nil
 }
@@ -71,6 +73,8 @@ func (s *GoodsServer) DeleteCategory(ctx context.Context, req *proto.DeleteCateg
 	if err != nil {
 		return nil, err
 	}
+	// 商品详情缓存中包含商品的分类
+	cache.InvalidateNamespace(ctx, cache.NamespaceCategory, cache.NamespaceGoods)
 	return &emptypb.Empty{}, nil
 }
 
@@ -81,5 +85,7 @@ func (s *GoodsServer) UpdateCategory(ctx context.Context, req *proto.CategoryInf
 	if err != nil {
 		return nil, err
 	}
+	// 商品详情缓存中包含商品的分类
+	cache.InvalidateNamespace(ctx, cache.NamespaceCategory, cache.NamespaceGoods)
 	return &emptypb.Empty{}, nil
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"goods_srv/model"
 	"goods_srv/proto"
+	"time"
 )
 
 // ModelToProtoGoods 将model.Goods转换为proto.GoodsInfoResponse
@@ -32,9 +33,37 @@ func ModelToProtoGoods(g *model.Goods) *proto.GoodsInfoResponse {
 		ShipFree:        g.ShipFree,
 		BrandId:         int32(g.BrandId),
 		CategoryIds:     categoryIds,
+		Attributes:      ModelToProtoGoodsAttributes(g.Attributes),
 	}
 }
 
+// ModelToProtoGoodsAttributes 将商品属性值转换为proto.GoodsAttributeInfo
+func ModelToProtoGoodsAttributes(values []model.GoodsAttributeValue) []*proto.GoodsAttributeInfo {
+	var attributes []*proto.GoodsAttributeInfo
+	for _, v := range values {
+		attributes = append(attributes, &proto.GoodsAttributeInfo{
+			AttributeId: int32(v.AttributeId),
+			Name:        v.Attribute.Name,
+			Type:        v.Attribute.Type,
+			Unit:        v.Attribute.Unit,
+			Value:       v.Value,
+		})
+	}
+	return attributes
+}
+
+// ProtoToModelGoodsAttributes 将proto.GoodsAttributeValue转换为model.GoodsAttributeValue
+func ProtoToModelGoodsAttributes(values []*proto.GoodsAttributeValue) []model.GoodsAttributeValue {
+	attributes := make([]model.GoodsAttributeValue, 0, len(values))
+	for _, v := range values {
+		attributes = append(attributes, model.GoodsAttributeValue{
+			AttributeId: uint(v.AttributeId),
+			Value:       v.Value,
+		})
+	}
+	return attributes
+}
+
 // ProtoToModelGoods 将proto.CreateGoodsInfo转换为model.Goods
 func ProtoToModelGoods(req *proto.CreateGoodsInfo) *model.Goods {
 	return &model.Goods{
@@ -111,6 +140,19 @@ func ProtoToModelFilter(req *proto.GoodsFilterRequest) *model.GoodsFilter {
 		filter.Keywords = req.Keywords
 	}
 
+	// 属性筛选
+	for _, a := range req.Attributes {
+		if a.AttributeId <= 0 {
+			continue
+		}
+		filter.Attributes = append(filter.Attributes, model.AttributeFilter{
+			AttributeId: uint(a.AttributeId),
+			Values:      a.Values,
+			Min:         a.MinValue,
+			Max:         a.MaxValue,
+		})
+	}
+
 	return filter
 }
 
@@ -144,6 +186,37 @@ func ProtoToModelCategory(req *proto.CategoryInfoRequest) *model.Category {
 	}
 }
 
+// ModelToProtoCategoryAttribute 将model.CategoryAttribute转换为proto.CategoryAttributeResponse
+func ModelToProtoCategoryAttribute(a *model.CategoryAttribute) *proto.CategoryAttributeResponse {
+	return &proto.CategoryAttributeResponse{
+		Id:         int32(a.ID),
+		CategoryId: int32(a.CategoryId),
+		Name:       a.Name,
+		Type:       a.Type,
+		Unit:       a.Unit,
+		Options:    a.Options,
+		Required:   a.Required,
+		Sort:       int32(a.Sort),
+	}
+}
+
+// ProtoToModelCategoryAttribute 将proto.CategoryAttributeRequest转换为model.CategoryAttribute
+func ProtoToModelCategoryAttribute(req *proto.CategoryAttributeRequest) *model.CategoryAttribute {
+	attrType := req.Type
+	if attrType == "" {
+		attrType = model.AttributeTypeText
+	}
+	return &model.CategoryAttribute{
+		CategoryId: uint(req.CategoryId),
+		Name:       req.Name,
+		Type:       attrType,
+		Unit:       req.Unit,
+		Options:    req.Options,
+		Required:   req.Required,
+		Sort:       int(req.Sort),
+	}
+}
+
 // ModelToProtoBrand 将model.Brand转换为proto.BrandInfoResponse
 func ModelToProtoBrand(b *model.Brand) *proto.BrandInfoResponse {
 	return &proto.BrandInfoResponse{
@@ -165,21 +238,78 @@ func ProtoToModelBrand(req *proto.BrandRequest) *model.Brand {
 
 // ModelToProtoBanner 将model.Banner转换为proto.BannerResponse
 func ModelToProtoBanner(b *model.Banner) *proto.BannerResponse {
-	return &proto.BannerResponse{
-		Id:    int32(b.ID),
-		Image: b.Image,
-		Url:   b.Url,
-		Index: int32(b.Index),
+	resp := &proto.BannerResponse{
+		Id:        int32(b.ID),
+		Image:     b.Image,
+		Url:       b.Url,
+		Index:     int32(b.Index),
+		Placement: b.Placement,
+		Enabled:   b.IsEnabled(),
+	}
+	if b.CategoryId != nil {
+		resp.CategoryId = int32(*b.CategoryId)
 	}
+	if b.BrandId != nil {
+		resp.BrandId = int32(*b.BrandId)
+	}
+	if b.StartTime != nil {
+		resp.StartTime = b.StartTime.Unix()
+	}
+	if b.EndTime != nil {
+		resp.EndTime = b.EndTime.Unix()
+	}
+	return resp
 }
 
 // ProtoToModelBanner 将proto.BannerRequest转换为model.Banner
 func ProtoToModelBanner(req *proto.BannerRequest) *model.Banner {
-	return &model.Banner{
-		Image: req.Image,
-		Url:   req.Url,
-		Index: int(req.Index),
+	banner := &model.Banner{Enabled: req.Enabled}
+	applyBannerRequest(banner, req)
+	return banner
+}
+
+// applyBannerRequest 将请求中的轮播图字段写入model，未传的可选字段保持不变，0值表示不定向/不限时间
+func applyBannerRequest(b *model.Banner, req *proto.BannerRequest) {
+	b.Image = req.Image
+	b.Url = req.Url
+	b.Index = int(req.Index)
+	if req.Placement != nil {
+		b.Placement = *req.Placement
+	}
+	if b.Placement == "" {
+		b.Placement = model.BannerPlacementHome
+	}
+	if req.CategoryId != nil {
+		b.CategoryId = optionalID(*req.CategoryId)
+	}
+	if req.BrandId != nil {
+		b.BrandId = optionalID(*req.BrandId)
+	}
+	if req.StartTime != nil {
+		b.StartTime = optionalTime(*req.StartTime)
+	}
+	if req.EndTime != nil {
+		b.EndTime = optionalTime(*req.EndTime)
+	}
+	if req.Enabled != nil {
+		b.Enabled = req.Enabled
+	}
+}
+
+func optionalID(id int32) *uint {
+	if id <= 0 {
+		return nil
+	}
+	v := uint(id)
+	return &v
+}
+
+func optionalTime(unix int64) *time.Time {
+	if unix <= 0 {
+		return nil
 	}
+	t := time.Unix(unix, 0)
+	return &t
 }
 
 // ModelToProtoCategoryBrand 将model.CategoryBrand转换为proto.CategoryBrandResponse
@@ -3,6 +3,8 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"goods_srv/cache"
 	"goods_srv/global"
 	"goods_srv/model"
 	"goods_srv/proto"
@@ -10,6 +12,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
 )
 
 type GoodsServer struct {
@@ -31,6 +34,7 @@ func (s *GoodsServer) GoodsList(ctx context.Context, req *proto.GoodsFilterReque
 	for _, g := range goods {
 		goodsList = append(goodsList, ModelToProtoGoods(&g))
 	}
+	fillGoodsRatings(ctx, goodsList)
 
 	return &proto.GoodsListResponse{
 		Total: int32(total),
@@ -38,24 +42,55 @@ func (s *GoodsServer) GoodsList(ctx context.Context, req *proto.GoodsFilterReque
 	}, nil
 }
 
-// GetGoodsById 获取商品详情
+// GetGoodsById 获取商品详情（读缓存）
 func (s *GoodsServer) GetGoodsDetail(ctx context.Context, req *proto.GoodInfoRequest) (*proto.GoodsInfoResponse, error) {
-	goods, err := model.GetGoodsById(uint(req.Id))
+	goods, err := cache.GetOrLoad(ctx, cache.GoodsDetailKey(req.Id), func() (*model.Goods, error) {
+		return model.GetGoodsById(uint(req.Id))
+	})
 	if err != nil {
+		if errors.Is(err, cache.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "商品不存在")
+		}
 		return nil, err
 	}
 
-	return ModelToProtoGoods(goods), nil
+	resp := ModelToProtoGoods(goods)
+	fillGoodsRatings(ctx, []*proto.GoodsInfoResponse{resp})
+	return resp, nil
 }
 
 // CreateGoods 创建商品
 func (s *GoodsServer) CreateGoods(ctx context.Context, req *proto.CreateGoodsInfo) (*proto.GoodsInfoResponse, error) {
 	goods := ProtoToModelGoods(req)
-	err := model.CreateGoods(goods)
+
+	// 加载商品分类，并按分类属性模板校验属性值
+	var categoryIds []uint
+	if len(req.CategoryIds) > 0 {
+		if err := global.DB.Where("id IN ?", req.CategoryIds).Find(&goods.Categories).Error; err != nil {
+			return nil, status.Error(codes.Internal, "查询商品分类失败")
+		}
+		if len(goods.Categories) != len(req.CategoryIds) {
+			return nil, status.Error(codes.InvalidArgument, "商品分类不存在")
+		}
+		for _, c := range goods.Categories {
+			categoryIds = append(categoryIds, c.ID)
+		}
+	}
+	attributes, err := validateGoodsAttributes(categoryIds, ProtoToModelGoodsAttributes(req.Attributes))
 	if err != nil {
 		return nil, err
 	}
+	goods.Attributes = attributes
 
+	err = model.CreateGoods(goods)
+	if err != nil {
+		return nil, err
+	}
+
+	// 清除可能存在的空值缓存
+	cache.Invalidate(ctx, cache.GoodsDetailKey(int32(goods.ID)))
+	// 品牌列表包含在售商品数
+	cache.InvalidateNamespace(ctx, cache.NamespaceBrand)
 	return ModelToProtoGoods(goods), nil
 }
 
@@ -88,10 +123,33 @@ func (s *GoodsServer) UpdateGoods(ctx context.Context, req *proto.CreateGoodsInf
 		"goods_front_image": req.GoodsFrontImage,
 		"status":            req.Status,
 	}
-	err := model.UpdateGoodsByMap(uint(req.Id), updateMap)
+
+	// 传入属性时按商品当前分类的属性模板校验并整体替换
+	var attributes []model.GoodsAttributeValue
+	if len(req.Attributes) > 0 {
+		goods, err := model.GetGoodsById(uint(req.Id))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Error(codes.NotFound, "商品不存在")
+			}
+			return nil, status.Error(codes.Internal, "查询商品失败")
+		}
+		var categoryIds []uint
+		for _, c := range goods.Categories {
+			categoryIds = append(categoryIds, c.ID)
+		}
+		attributes, err = validateGoodsAttributes(categoryIds, ProtoToModelGoodsAttributes(req.Attributes))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := model.UpdateGoodsWithAttributes(uint(req.Id), updateMap, attributes)
 	if err != nil {
 		return nil, err
 	}
+	cache.Invalidate(ctx, cache.GoodsDetailKey(req.Id))
+	cache.InvalidateNamespace(ctx, cache.NamespaceBrand)
 	return &emptypb.Empty{}, nil
 }
 
@@ -101,6 +159,8 @@ func (s *GoodsServer) DeleteGoods(ctx context.Context, req *proto.DeleteGoodsInf
 	if err != nil {
 		return nil, err
 	}
+	cache.Invalidate(ctx, cache.GoodsDetailKey(req.Id))
+	cache.InvalidateNamespace(ctx, cache.NamespaceBrand)
 	return &emptypb.Empty{}, nil
 }
 
@@ -115,9 +175,9 @@ func (s *GoodsServer) BatchGetGoods(ctx context.Context, req *proto.BatchGoodsId
 		}, nil
 	}
 
-	// 从数据库批量查询商品
+	// 从数据库批量查询商品，订单服务按分类匹配促销活动和优惠券，需要同时加载分类
 	var goods []model.Goods
-	if err := global.DB.Where("id IN ?", req.Id).Find(&goods).Error; err != nil {
+	if err := global.DB.Preload("Categories").Where("id IN ?", req.Id).Find(&goods).Error; err != nil {
 		global.Logger.Errorf("批量查询商品失败: %v", err)
 		return nil, status.Errorf(codes.Internal, "批量查询商品失败")
 	}
@@ -165,6 +225,7 @@ func (s *GoodsServer) GetGoodsByCategory(ctx context.Context, req *proto.Categor
 	for _, g := range goods {
 		goodsList = append(goodsList, ModelToProtoGoods(&g))
 	}
+	fillGoodsRatings(ctx, goodsList)
 
 	return &proto.GoodsListResponse{
 		Total: int32(total),
@@ -183,6 +244,7 @@ func (s *GoodsServer) SearchGoods(ctx context.Context, req *proto.GoodsFilterReq
 	for _, g := range goods {
 		goodsList = append(goodsList, ModelToProtoGoods(&g))
 	}
+	fillGoodsRatings(ctx, goodsList)
 
 	return &proto.GoodsListResponse{
 		Total: int32(total),
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"goods_srv/global"
+	"goods_srv/proto"
+	useroppb "goods_srv/proto/userop"
+	"goods_srv/util"
+)
+
+// 获取评分只影响展示，超时后直接返回不带评分的列表
+const goodsRatingTimeout = 500 * time.Millisecond
+
+// fillGoodsRatings 从用户操作服务批量获取评分汇总填入商品列表，一页商品只调用一次
+// 用户操作服务不可用时评分保持为0，不影响商品列表本身
+func fillGoodsRatings(ctx context.Context, goods []*proto.GoodsInfoResponse) {
+	if len(goods) == 0 {
+		return
+	}
+	conn := util.ServiceConn(&global.UseropClient, "userop_srv")
+	if conn == nil {
+		return
+	}
+
+	ids := make([]int32, 0, len(goods))
+	for _, g := range goods {
+		ids = append(ids, g.Id)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, goodsRatingTimeout)
+	defer cancel()
+	resp, err := useroppb.NewUserOpClient(conn).GetGoodsRatings(ctx, &useroppb.GoodsRatingRequest{GoodsIds: ids})
+	if err != nil {
+		global.Logger.Warnf("获取商品评分失败，商品数: %d，错误: %v", len(ids), err)
+		return
+	}
+
+	ratings := make(map[int32]*useroppb.GoodsRating, len(resp.Data))
+	for _, r := range resp.Data {
+		ratings[r.GoodsId] = r
+	}
+	for _, g := range goods {
+		if r, ok := ratings[g.Id]; ok {
+			g.AvgRating = r.AvgRating
+			g.RatingCount = r.RatingCount
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package initialize
+
+import (
+	"goods_srv/global"
+	"goods_srv/util"
+
+	"go.uber.org/zap"
+)
+
+// InitServiceClients 初始化依赖的服务客户端连接
+// 依赖的服务尚未启动时不阻止启动，使用时再通过util.ServiceConn重新连接
+func InitServiceClients() {
+	// 用户操作服务，商品列表通过它获取评分汇总，不可用时评分返回0
+	if util.ServiceConn(&global.UseropClient, "userop_srv") == nil {
+		zap.S().Warn("用户操作服务暂不可用，商品列表不返回评分，使用时重新连接")
+	}
+}
+
+// CloseServiceClients 关闭所有服务客户端连接
+func CloseServiceClients() {
+	if global.UseropClient != nil {
+		global.UseropClient.Close()
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"goods_srv/cache"
 	"goods_srv/global"
 	"goods_srv/handler"
 	"goods_srv/initialize"
@@ -37,9 +38,19 @@ func main() {
 	// 初始化数据库
 	initialize.InitDB()
 
+	// 初始化 Redis
+	initialize.InitRedis()
+
+	// 初始化读缓存
+	cache.Init()
+
 	// 初始化 Consul
 	global.ConsulClient = initialize.InitConsul()
 
+	// 初始化服务客户端
+	initialize.InitServiceClients()
+	defer initialize.CloseServiceClients()
+
 	// 创建 gRPC 服务器
 	server := grpc.NewServer()
 
@@ -81,6 +92,9 @@ func main() {
 		zap.S().Errorf("从 Consul 注销服务失败: %v", err)
 	}
 
+	// 停止缓存失效订阅
+	cache.Close()
+
 	// 优雅关闭
 	server.GracefulStop()
 	zap.S().Info("商品服务已关闭")
@@ -0,0 +1,257 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"goods_srv/global"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// 属性类型
+const (
+	AttributeTypeText   = "text"   // 文本，配置了可选值时只能取可选值
+	AttributeTypeNumber = "number" // 数值，支持按范围筛选
+	AttributeTypeEnum   = "enum"   // 枚举，必须取可选值
+	AttributeTypeBool   = "bool"   // 布尔，取值为true/false
+)
+
+// 分类层级最多三级，查找上级分类模板时的最大深度
+const maxCategoryDepth = 3
+
+var (
+	// ErrInvalidAttribute 商品属性值不符合分类属性模板
+	ErrInvalidAttribute = errors.New("商品属性不符合分类属性模板")
+	// ErrAttributeNameExists 同一分类下属性名称已存在
+	ErrAttributeNameExists = errors.New("属性名称已存在")
+)
+
+// CategoryAttribute 分类属性模板，子分类继承上级分类的属性
+type CategoryAttribute struct {
+	gorm.Model
+	CategoryId uint     `gorm:"type:int unsigned;not null;index:idx_category_attr_name,unique;comment:分类ID"`
+	Name       string   `gorm:"type:varchar(50);not null;index:idx_category_attr_name,unique;comment:属性名称"`
+	Type       string   `gorm:"type:varchar(16);not null;default:'text';comment:属性类型"`
+	Unit       string   `gorm:"type:varchar(20);not null;default:'';comment:单位"`
+	Options    GormList `gorm:"type:json;comment:可选值"`
+	Required   bool     `gorm:"type:boolean;not null;default:false;comment:是否必填"`
+	Sort       int      `gorm:"type:int;not null;default:0;comment:排序"`
+}
+
+// GoodsAttributeValue 商品属性值
+type GoodsAttributeValue struct {
+	gorm.Model
+	GoodsId     uint              `gorm:"type:int unsigned;not null;index:idx_goods_attr,unique;comment:商品ID"`
+	AttributeId uint              `gorm:"type:int unsigned;not null;index:idx_goods_attr,unique;index:idx_attr_value,priority:1;comment:属性ID"`
+	Attribute   CategoryAttribute `gorm:"foreignKey:AttributeId;references:ID"`
+	Value       string            `gorm:"type:varchar(200);not null;index:idx_attr_value,priority:2;comment:属性值"`
+	NumValue    *float64          `gorm:"type:decimal(20,4);default:null;comment:数值型属性值，用于范围筛选"`
+}
+
+// AttributeFilter 商品属性筛选条件，Values与Min/Max可同时使用
+type AttributeFilter struct {
+	AttributeId uint
+	Values      []string
+	Min         *float64
+	Max         *float64
+}
+
+// TableName 设置表名
+func (CategoryAttribute) TableName() string {
+	return "category_attribute"
+}
+
+// TableName 设置表名
+func (GoodsAttributeValue) TableName() string {
+	return "goods_attribute_value"
+}
+
+// IsValidAttributeType 判断属性类型是否合法
+func IsValidAttributeType(t string) bool {
+	switch t {
+	case AttributeTypeText, AttributeTypeNumber, AttributeTypeEnum, AttributeTypeBool:
+		return true
+	}
+	return false
+}
+
+// GetCategoryAttributes 获取分类的属性模板，包含上级分类定义的属性
+func GetCategoryAttributes(categoryIds ...uint) ([]CategoryAttribute, error) {
+	ids, err := categoryAncestors(categoryIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var attrs []CategoryAttribute
+	if err := global.DB.Where("category_id IN ?", ids).Order("sort asc, id asc").Find(&attrs).Error; err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// categoryAncestors 返回分类及其所有上级分类的ID
+func categoryAncestors(categoryIds []uint) ([]uint, error) {
+	seen := make(map[uint]bool)
+	var result []uint
+	current := categoryIds
+	for depth := 0; depth < maxCategoryDepth && len(current) > 0; depth++ {
+		var categories []Category
+		if err := global.DB.Select("id, parent_id").Where("id IN ?", current).Find(&categories).Error; err != nil {
+			return nil, err
+		}
+		current = nil
+		for _, c := range categories {
+			if seen[c.ID] {
+				continue
+			}
+			seen[c.ID] = true
+			result = append(result, c.ID)
+			if c.ParentId != nil {
+				current = append(current, uint(*c.ParentId))
+			}
+		}
+	}
+	return result, nil
+}
+
+// CreateCategoryAttribute 创建分类属性，同一分类下名称重复时返回ErrAttributeNameExists
+func CreateCategoryAttribute(attr *CategoryAttribute) error {
+	return translateAttributeError(global.DB.Create(attr).Error)
+}
+
+// UpdateCategoryAttribute 更新分类属性，同一分类下名称重复时返回ErrAttributeNameExists
+func UpdateCategoryAttribute(attr *CategoryAttribute) error {
+	return translateAttributeError(global.DB.Save(attr).Error)
+}
+
+func translateAttributeError(err error) error {
+	if isDuplicateKeyError(err) {
+		return ErrAttributeNameExists
+	}
+	return err
+}
+
+// DeleteCategoryAttribute 删除分类属性及商品上对应的属性值
+// 属性模板直接物理删除，软删除的记录仍占用(category_id, name)唯一索引，会导致同名属性无法重新创建
+func DeleteCategoryAttribute(id uint) error {
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("attribute_id = ?", id).Delete(&GoodsAttributeValue{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&CategoryAttribute{}, id).Error
+	})
+}
+
+// ValidateGoodsAttributes 按属性模板校验并规范化商品属性值
+// 属性必须属于模板，值需符合属性类型和可选值，模板中的必填属性必须提供
+func ValidateGoodsAttributes(templates []CategoryAttribute, values []GoodsAttributeValue) ([]GoodsAttributeValue, error) {
+	byId := make(map[uint]*CategoryAttribute, len(templates))
+	for i := range templates {
+		byId[templates[i].ID] = &templates[i]
+	}
+
+	seen := make(map[uint]bool, len(values))
+	result := make([]GoodsAttributeValue, 0, len(values))
+	for _, v := range values {
+		attr, ok := byId[v.AttributeId]
+		if !ok {
+			return nil, fmt.Errorf("%w: 属性%d不属于商品分类", ErrInvalidAttribute, v.AttributeId)
+		}
+		if seen[v.AttributeId] {
+			return nil, fmt.Errorf("%w: 属性「%s」重复", ErrInvalidAttribute, attr.Name)
+		}
+		seen[v.AttributeId] = true
+
+		normalized, err := normalizeAttributeValue(attr, v.Value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, normalized)
+	}
+
+	for _, attr := range templates {
+		if attr.Required && !seen[attr.ID] {
+			return nil, fmt.Errorf("%w: 缺少必填属性「%s」", ErrInvalidAttribute, attr.Name)
+		}
+	}
+	return result, nil
+}
+
+// normalizeAttributeValue 按属性类型校验属性值，数值型同时填充NumValue
+func normalizeAttributeValue(attr *CategoryAttribute, raw string) (GoodsAttributeValue, error) {
+	value := strings.TrimSpace(raw)
+	result := GoodsAttributeValue{AttributeId: attr.ID, Value: value}
+	if value == "" {
+		return result, fmt.Errorf("%w: 属性「%s」的值不能为空", ErrInvalidAttribute, attr.Name)
+	}
+
+	switch attr.Type {
+	case AttributeTypeNumber:
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return result, fmt.Errorf("%w: 属性「%s」必须为数值", ErrInvalidAttribute, attr.Name)
+		}
+		result.NumValue = &num
+	case AttributeTypeBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return result, fmt.Errorf("%w: 属性「%s」必须为true或false", ErrInvalidAttribute, attr.Name)
+		}
+		result.Value = strconv.FormatBool(b)
+	case AttributeTypeEnum, AttributeTypeText:
+		if attr.Type == AttributeTypeEnum || len(attr.Options) > 0 {
+			if !containsString(attr.Options, value) {
+				return result, fmt.Errorf("%w: 属性「%s」的值「%s」不在可选值中", ErrInvalidAttribute, attr.Name, value)
+			}
+		}
+	}
+	return result, nil
+}
+
+// SaveGoodsAttributes 替换商品的全部属性值
+func SaveGoodsAttributes(tx *gorm.DB, goodsId uint, values []GoodsAttributeValue) error {
+	if err := tx.Unscoped().Where("goods_id = ?", goodsId).Delete(&GoodsAttributeValue{}).Error; err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	for i := range values {
+		values[i].GoodsId = goodsId
+	}
+	return tx.Omit("Attribute").Create(&values).Error
+}
+
+// applyAttributeFilters 为商品查询追加属性筛选条件，每个条件通过EXISTS子查询匹配
+func applyAttributeFilters(query *gorm.DB, filters []AttributeFilter) *gorm.DB {
+	for _, f := range filters {
+		sub := global.DB.Model(&GoodsAttributeValue{}).
+			Select("1").
+			Where("goods_attribute_value.goods_id = goods.id AND goods_attribute_value.attribute_id = ?", f.AttributeId)
+		if len(f.Values) > 0 {
+			sub = sub.Where("goods_attribute_value.value IN ?", f.Values)
+		}
+		if f.Min != nil {
+			sub = sub.Where("goods_attribute_value.num_value >= ?", *f.Min)
+		}
+		if f.Max != nil {
+			sub = sub.Where("goods_attribute_value.num_value <= ?", *f.Max)
+		}
+		query = query.Where("EXISTS (?)", sub)
+	}
+	return query
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
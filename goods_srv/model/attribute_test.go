@@ -0,0 +1,60 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func testAttributeTemplates() []CategoryAttribute {
+	return []CategoryAttribute{
+		{Model: gorm.Model{ID: 1}, Name: "屏幕尺寸", Type: AttributeTypeNumber, Unit: "英寸", Required: true},
+		{Model: gorm.Model{ID: 2}, Name: "颜色", Type: AttributeTypeEnum, Options: GormList{"黑色", "白色"}},
+		{Model: gorm.Model{ID: 3}, Name: "支持5G", Type: AttributeTypeBool},
+		{Model: gorm.Model{ID: 4}, Name: "材质", Type: AttributeTypeText},
+	}
+}
+
+// TestValidateGoodsAttributes 测试属性值按模板校验和规范化
+func TestValidateGoodsAttributes(t *testing.T) {
+	values, err := ValidateGoodsAttributes(testAttributeTemplates(), []GoodsAttributeValue{
+		{AttributeId: 1, Value: " 6.7 "},
+		{AttributeId: 2, Value: "黑色"},
+		{AttributeId: 3, Value: "1"},
+		{AttributeId: 4, Value: "钛金属"},
+	})
+	if err != nil {
+		t.Fatalf("校验属性失败: %v", err)
+	}
+	if values[0].NumValue == nil || *values[0].NumValue != 6.7 {
+		t.Errorf("数值型属性应填充NumValue，实际: %v", values[0].NumValue)
+	}
+	if values[0].Value != "6.7" {
+		t.Errorf("属性值应去除首尾空格，实际: %q", values[0].Value)
+	}
+	if values[2].Value != "true" {
+		t.Errorf("布尔属性应规范化为true，实际: %s", values[2].Value)
+	}
+}
+
+// TestValidateGoodsAttributesInvalid 测试不符合模板的属性值
+func TestValidateGoodsAttributesInvalid(t *testing.T) {
+	cases := map[string][]GoodsAttributeValue{
+		"缺少必填属性":  {{AttributeId: 2, Value: "黑色"}},
+		"属性不属于分类": {{AttributeId: 1, Value: "6.1"}, {AttributeId: 99, Value: "x"}},
+		"数值格式错误":  {{AttributeId: 1, Value: "六寸"}},
+		"枚举值不合法":  {{AttributeId: 1, Value: "6.1"}, {AttributeId: 2, Value: "红色"}},
+		"布尔值不合法":  {{AttributeId: 1, Value: "6.1"}, {AttributeId: 3, Value: "maybe"}},
+		"属性重复":    {{AttributeId: 1, Value: "6.1"}, {AttributeId: 1, Value: "6.7"}},
+		"属性值为空":   {{AttributeId: 1, Value: " "}},
+	}
+	for name, values := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ValidateGoodsAttributes(testAttributeTemplates(), values)
+			if !errors.Is(err, ErrInvalidAttribute) {
+				t.Errorf("期望返回ErrInvalidAttribute，实际: %v", err)
+			}
+		})
+	}
+}
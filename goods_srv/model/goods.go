@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"goods_srv/global"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GormList 自定义类型，用于存储字符串列表
@@ -53,8 +56,37 @@ type Brand struct {
 	Name string `gorm:"type:varchar(50);not null;comment:品牌名称"`
 	Logo string `gorm:"type:varchar(200);not null;default:'';comment:品牌logo"`
 	Desc string `gorm:"type:varchar(200);not null;default:'';comment:品牌描述"`
+	// NameKey 由数据库根据名称生成的小写唯一键，软删除后置空，保证未删除品牌名称不区分大小写唯一
+	NameKey *string `gorm:"->;type:varchar(50) GENERATED ALWAYS AS (IF(deleted_at IS NULL, LOWER(TRIM(name)), NULL)) STORED;uniqueIndex:uk_brand_name_key" json:"-"`
 }
 
+// BrandListItem 品牌列表项，附带在售商品数
+type BrandListItem struct {
+	Brand    `gorm:"embedded"`
+	GoodsNum int64
+}
+
+// BrandFilter 品牌列表过滤与排序条件
+type BrandFilter struct {
+	Page     int
+	PageSize int
+	Name     string
+	Desc     string
+	SortBy   string // id、name、goods_num、created_at，默认id
+	SortDesc bool
+}
+
+// 品牌列表允许的排序字段
+var brandSortColumns = map[string]string{
+	"id":         "brand.id",
+	"name":       "brand.name",
+	"goods_num":  "goods_num",
+	"created_at": "brand.created_at",
+}
+
+// ErrBrandNameExists 品牌名称已存在（不区分大小写）
+var ErrBrandNameExists = errors.New("品牌名称已存在")
+
 // GoodsCategoryBrand 商品分类和品牌关联表,这两个建立一个同名索引
 type CategoryBrand struct {
 	gorm.Model
@@ -67,32 +99,80 @@ type CategoryBrand struct {
 // Goods 商品
 type Goods struct {
 	gorm.Model
-	BrandId         uint       `gorm:"type:int;not null;comment:品牌ID"`
-	Brand           Brand      `gorm:"foreignKey:BrandId;references:ID"`
-	OnSale          bool       `gorm:"type:boolean;not null;default:false;comment:是否上架"`
-	ShipFree        bool       `gorm:"type:boolean;not null;default:false;comment:是否包邮"`
-	IsNew           bool       `gorm:"type:boolean;not null;default:false;comment:是否新品"`
-	IsHot           bool       `gorm:"type:boolean;not null;default:false;comment:是否热销"`
-	Name            string     `gorm:"type:varchar(100);not null;comment:商品名称"`
-	GoodsSn         string     `gorm:"type:varchar(50);not null;comment:商品编号"`
-	ClickNum        int        `gorm:"type:int;not null;default:0;comment:点击数"`
-	FavNum          int        `gorm:"type:int;not null;default:0;comment:收藏数"`
-	MarketPrice     float64    `gorm:"type:decimal(10,2);not null;comment:市场价格"`
-	ShopPrice       float64    `gorm:"type:decimal(10,2);not null;comment:本店价格"`
-	GoodsBrief      string     `gorm:"type:varchar(200);not null;comment:商品简介"`
-	Images          GormList   `gorm:"type:json;not null;comment:商品图片"`
-	DescImages      GormList   `gorm:"type:json;not null;comment:商品详情图片"`
-	GoodsFrontImage string     `gorm:"type:varchar(200);not null;comment:商品主图"`
-	Status          int        `gorm:"type:tinyint;not null;default:1;comment:商品状态"`
-	Categories      []Category `gorm:"many2many:goods_category;"`
-}
+	BrandId         uint                  `gorm:"type:int;not null;comment:品牌ID"`
+	Brand           Brand                 `gorm:"foreignKey:BrandId;references:ID"`
+	OnSale          bool                  `gorm:"type:boolean;not null;default:false;comment:是否上架"`
+	ShipFree        bool                  `gorm:"type:boolean;not null;default:false;comment:是否包邮"`
+	IsNew           bool                  `gorm:"type:boolean;not null;default:false;comment:是否新品"`
+	IsHot           bool                  `gorm:"type:boolean;not null;default:false;comment:是否热销"`
+	Name            string                `gorm:"type:varchar(100);not null;comment:商品名称"`
+	GoodsSn         string                `gorm:"type:varchar(50);not null;comment:商品编号"`
+	ClickNum        int                   `gorm:"type:int;not null;default:0;comment:点击数"`
+	FavNum          int                   `gorm:"type:int;not null;default:0;comment:收藏数"`
+	MarketPrice     float64               `gorm:"type:decimal(10,2);not null;comment:市场价格"`
+	ShopPrice       float64               `gorm:"type:decimal(10,2);not null;comment:本店价格"`
+	GoodsBrief      string                `gorm:"type:varchar(200);not null;comment:商品简介"`
+	Images          GormList              `gorm:"type:json;not null;comment:商品图片"`
+	DescImages      GormList              `gorm:"type:json;not null;comment:商品详情图片"`
+	GoodsFrontImage string                `gorm:"type:varchar(200);not null;comment:商品主图"`
+	Status          int                   `gorm:"type:tinyint;not null;default:1;comment:商品状态"`
+	Categories      []Category            `gorm:"many2many:goods_category;"`
+	Attributes      []GoodsAttributeValue `gorm:"foreignKey:GoodsId"`
+}
+
+// 轮播图投放位置
+const (
+	BannerPlacementHome     = "home"
+	BannerPlacementCategory = "category"
+)
 
 // Banner 轮播图
 type Banner struct {
 	gorm.Model
-	Image string `gorm:"type:varchar(200);not null;comment:轮播图图片"`
-	Url   string `gorm:"type:varchar(200);not null;comment:轮播图链接"`
-	Index int    `gorm:"type:int;not null;default:0;comment:轮播图索引"`
+	Image      string     `gorm:"type:varchar(200);not null;comment:轮播图图片"`
+	Url        string     `gorm:"type:varchar(200);not null;comment:轮播图链接"`
+	Index      int        `gorm:"type:int;not null;default:0;comment:轮播图索引"`
+	Placement  string     `gorm:"type:varchar(32);not null;default:'home';index:idx_banner_placement;comment:投放位置"`
+	CategoryId *uint      `gorm:"type:int unsigned;default:null;comment:定向分类ID"`
+	BrandId    *uint      `gorm:"type:int unsigned;default:null;comment:定向品牌ID"`
+	StartTime  *time.Time `gorm:"type:datetime;default:null;comment:开始时间"`
+	EndTime    *time.Time `gorm:"type:datetime;default:null;comment:结束时间"`
+	Enabled    *bool      `gorm:"type:boolean;not null;default:true;comment:是否启用"` // 指针类型，避免false被GORM当作零值替换为默认值
+}
+
+// IsEnabled 是否启用，未设置时视为启用
+func (b *Banner) IsEnabled() bool {
+	return b.Enabled == nil || *b.Enabled
+}
+
+// IsActive 判断轮播图在指定时间是否处于投放期
+func (b *Banner) IsActive(now time.Time) bool {
+	if !b.IsEnabled() {
+		return false
+	}
+	if b.StartTime != nil && now.Before(*b.StartTime) {
+		return false
+	}
+	if b.EndTime != nil && !now.Before(*b.EndTime) {
+		return false
+	}
+	return true
+}
+
+// BannerClick 轮播图点击统计，按天累计
+type BannerClick struct {
+	gorm.Model
+	BannerId  uint      `gorm:"type:int unsigned;not null;index:idx_banner_click_date,unique;comment:轮播图ID"`
+	Date      time.Time `gorm:"type:date;not null;index:idx_banner_click_date,unique;comment:统计日期"`
+	Placement string    `gorm:"type:varchar(32);not null;default:'home';comment:投放位置"`
+	ClickNum  int64     `gorm:"type:bigint;not null;default:0;comment:点击数"`
+}
+
+// BannerClickStat 轮播图点击汇总结果
+type BannerClickStat struct {
+	BannerId  uint
+	Placement string
+	ClickNum  int64
 }
 
 // TableName 设置表名
@@ -115,15 +195,31 @@ func (CategoryBrand) TableName() string {
 	return "category_brand"
 }
 
-// CreateGoods 创建商品
+// TableName 设置表名
+func (BannerClick) TableName() string {
+	return "banner_click"
+}
+
+// CreateGoods 创建商品，同时写入分类关联和属性值
 func CreateGoods(goods *Goods) error {
-	return global.DB.Create(goods).Error
+	attributes := goods.Attributes
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		// 分类已存在，只写入关联表
+		if err := tx.Omit("Categories.*", "Attributes").Create(goods).Error; err != nil {
+			return err
+		}
+		if err := SaveGoodsAttributes(tx, goods.ID, attributes); err != nil {
+			return err
+		}
+		goods.Attributes = attributes
+		return nil
+	})
 }
 
 // GetGoodsById 根据ID获取商品
 func GetGoodsById(id uint) (*Goods, error) {
 	var goods Goods
-	err := global.DB.Preload("Categories").First(&goods, id).Error
+	err := global.DB.Preload("Categories").Preload("Attributes.Attribute").First(&goods, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +258,19 @@ func UpdateGoodsByMap(id uint, updateMap map[string]interface{}) error {
 	return global.DB.Model(&Goods{}).Where("id = ?", id).Updates(updateMap).Error
 }
 
+// UpdateGoodsWithAttributes 更新指定字段并替换商品属性值，attributes为nil时不修改属性
+func UpdateGoodsWithAttributes(id uint, updateMap map[string]interface{}, attributes []GoodsAttributeValue) error {
+	if attributes == nil {
+		return UpdateGoodsByMap(id, updateMap)
+	}
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Goods{}).Where("id = ?", id).Updates(updateMap).Error; err != nil {
+			return err
+		}
+		return SaveGoodsAttributes(tx, id, attributes)
+	})
+}
+
 // GoodsFilter 商品查询过滤器
 type GoodsFilter struct {
 	Page       int
@@ -175,6 +284,7 @@ type GoodsFilter struct {
 	CategoryId uint
 	Keywords   string
 	OnSale     *bool
+	Attributes []AttributeFilter
 }
 
 // GetGoodsList 获取商品列表
@@ -214,6 +324,7 @@ func GetGoodsList(filter *GoodsFilter) ([]Goods, int64, error) {
 	if filter.OnSale != nil {
 		query = query.Where("on_sale = ?", *filter.OnSale)
 	}
+	query = applyAttributeFilters(query, filter.Attributes)
 
 	// 获取总数
 	if err := query.Count(&total).Error; err != nil {
@@ -271,22 +382,22 @@ func SearchGoods(keyword string, page, pageSize int32) ([]Goods, int64, error) {
 	return goods, total, nil
 }
 
-// GetBrandList 获取品牌列表
-func GetBrandList(page, pageSize int, name, desc string) ([]Brand, int64, error) {
-	var brands []Brand
+// GetBrandList 获取品牌列表，附带每个品牌的在售商品数
+func GetBrandList(filter *BrandFilter) ([]BrandListItem, int64, error) {
+	var brands []BrandListItem
 	var total int64
 
 	// 构建查询
 	query := global.DB.Model(&Brand{})
 
 	// 添加名称过滤条件
-	if name != "" {
-		query = query.Where("name LIKE ?", "%"+name+"%")
+	if filter.Name != "" {
+		query = query.Where("brand.name LIKE ?", "%"+filter.Name+"%")
 	}
 
 	// 添加描述过滤条件
-	if desc != "" {
-		query = query.Where("desc LIKE ?", "%"+desc+"%")
+	if filter.Desc != "" {
+		query = query.Where("brand.`desc` LIKE ?", "%"+filter.Desc+"%")
 	}
 
 	// 获取总数
@@ -294,17 +405,34 @@ func GetBrandList(page, pageSize int, name, desc string) ([]Brand, int64, error)
 		return nil, 0, err
 	}
 
-	// 分页查询
-	if err := query.Offset((page - 1) * pageSize).Limit(pageSize).Find(&brands).Error; err != nil {
+	// 排序
+	column, ok := brandSortColumns[filter.SortBy]
+	if !ok {
+		column = brandSortColumns["id"]
+	}
+	order := column + " asc"
+	if filter.SortDesc {
+		order = column + " desc"
+	}
+
+	// 分页查询，在售商品数通过子查询统计
+	goodsNum := global.DB.Model(&Goods{}).Select("COUNT(*)").Where("goods.brand_id = brand.id AND goods.on_sale = ?", true)
+	err := query.Select("brand.*, (?) AS goods_num", goodsNum).
+		Order(order).
+		Order("brand.id asc").
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&brands).Error
+	if err != nil {
 		return nil, 0, err
 	}
 
 	return brands, total, nil
 }
 
-// CreateBrand 创建品牌
+// CreateBrand 创建品牌，名称与已有品牌重复（不区分大小写）时返回ErrBrandNameExists
 func CreateBrand(brand *Brand) error {
-	return global.DB.Create(brand).Error
+	return translateBrandError(global.DB.Create(brand).Error)
 }
 
 // DeleteBrand 删除品牌
@@ -312,9 +440,92 @@ func DeleteBrand(id uint) error {
 	return global.DB.Delete(&Brand{}, id).Error
 }
 
-// UpdateBrand 更新品牌
+// UpdateBrand 更新品牌，名称与其他品牌重复（不区分大小写）时返回ErrBrandNameExists
 func UpdateBrand(brand *Brand) error {
-	return global.DB.Save(brand).Error
+	return translateBrandError(global.DB.Save(brand).Error)
+}
+
+// BrandMergeResult 品牌合并结果
+type BrandMergeResult struct {
+	GoodsNum         int64 // 迁移的商品数
+	CategoryBrandNum int64 // 迁移的分类品牌关联数
+}
+
+// MergeBrand 将源品牌合并到目标品牌：迁移商品、分类品牌关联和定向轮播图后删除源品牌
+// 目标品牌已关联的分类直接删除源品牌的关联，避免违反分类品牌唯一索引
+func MergeBrand(sourceId, targetId uint) (*BrandMergeResult, error) {
+	result := &BrandMergeResult{}
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		var brands []Brand
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []uint{sourceId, targetId}).Find(&brands).Error; err != nil {
+			return err
+		}
+		if len(brands) != 2 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// 迁移商品
+		res := tx.Model(&Goods{}).Where("brand_id = ?", sourceId).Update("brand_id", targetId)
+		if res.Error != nil {
+			return res.Error
+		}
+		result.GoodsNum = res.RowsAffected
+
+		// 迁移分类品牌关联
+		var links []CategoryBrand
+		if err := tx.Where("brand_id = ?", sourceId).Find(&links).Error; err != nil {
+			return err
+		}
+		for _, link := range links {
+			var existing CategoryBrand
+			err := tx.Unscoped().Where("category_id = ? AND brand_id = ?", link.CategoryId, targetId).First(&existing).Error
+			switch {
+			case err == nil:
+				// 目标品牌已有该分类（可能已软删除），恢复目标关联并删除源关联
+				if existing.DeletedAt.Valid {
+					if err := tx.Unscoped().Model(&existing).Update("deleted_at", nil).Error; err != nil {
+						return err
+					}
+				}
+				if err := tx.Unscoped().Delete(&CategoryBrand{}, link.ID).Error; err != nil {
+					return err
+				}
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				if err := tx.Model(&CategoryBrand{}).Where("id = ?", link.ID).Update("brand_id", targetId).Error; err != nil {
+					return err
+				}
+			default:
+				return err
+			}
+			result.CategoryBrandNum++
+		}
+
+		// 迁移定向到源品牌的轮播图
+		if err := tx.Model(&Banner{}).Where("brand_id = ?", sourceId).Update("brand_id", targetId).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&Brand{}, sourceId).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// translateBrandError 将唯一索引冲突转换为ErrBrandNameExists
+func translateBrandError(err error) error {
+	if isDuplicateKeyError(err) {
+		return ErrBrandNameExists
+	}
+	return err
+}
+
+// isDuplicateKeyError 判断是否为MySQL唯一索引冲突
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
 }
 
 // GetAllCategories 获取所有分类
@@ -359,10 +570,22 @@ func UpdateCategory(category *Category) error {
 	return global.DB.Save(category).Error
 }
 
-// GetBannerList 获取轮播图列表
-func GetBannerList() ([]Banner, error) {
+// GetBannerList 获取投放位置下已启用的轮播图，投放时间由调用方按当前时间过滤
+// categoryId/brandId为0时只返回未定向的轮播图，否则同时返回未定向和定向到该分类/品牌的轮播图
+func GetBannerList(placement string, categoryId, brandId uint) ([]Banner, error) {
 	var banners []Banner
-	if err := global.DB.Order("`index` asc").Find(&banners).Error; err != nil {
+	query := global.DB.Where("placement = ? AND enabled = ?", placement, true)
+	if categoryId > 0 {
+		query = query.Where("category_id IS NULL OR category_id = ?", categoryId)
+	} else {
+		query = query.Where("category_id IS NULL")
+	}
+	if brandId > 0 {
+		query = query.Where("brand_id IS NULL OR brand_id = ?", brandId)
+	} else {
+		query = query.Where("brand_id IS NULL")
+	}
+	if err := query.Order("`index` asc").Find(&banners).Error; err != nil {
 		return nil, err
 	}
 	return banners, nil
@@ -383,10 +606,50 @@ func UpdateBanner(banner *Banner) error {
 	return global.DB.Save(banner).Error
 }
 
-// CheckBrandNameExists 检查品牌名称是否存在
+// RecordBannerClick 累加轮播图当天的点击数
+func RecordBannerClick(banner *Banner, clickTime time.Time) error {
+	y, m, d := clickTime.Date()
+	click := BannerClick{
+		BannerId:  banner.ID,
+		Date:      time.Date(y, m, d, 0, 0, 0, 0, clickTime.Location()),
+		Placement: banner.Placement,
+		ClickNum:  1,
+	}
+	return global.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "banner_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"click_num":  gorm.Expr("click_num + ?", 1),
+			"placement":  banner.Placement,
+			"updated_at": clickTime,
+		}),
+	}).Create(&click).Error
+}
+
+// GetBannerClickStats 汇总轮播图点击数，start/end为零值时不限制日期
+func GetBannerClickStats(ids []uint, placement string, start, end time.Time) ([]BannerClickStat, error) {
+	var stats []BannerClickStat
+	query := global.DB.Model(&BannerClick{}).
+		Select("banner_id, MAX(placement) AS placement, SUM(click_num) AS click_num")
+	if len(ids) > 0 {
+		query = query.Where("banner_id IN ?", ids)
+	}
+	if placement != "" {
+		query = query.Where("placement = ?", placement)
+	}
+	if !start.IsZero() {
+		query = query.Where("date >= ?", start.Format("2006-01-02"))
+	}
+	if !end.IsZero() {
+		query = query.Where("date <= ?", end.Format("2006-01-02"))
+	}
+	err := query.Group("banner_id").Order("click_num desc").Scan(&stats).Error
+	return stats, err
+}
+
+// CheckBrandNameExists 检查品牌名称是否存在（不区分大小写，忽略首尾空格）
 func CheckBrandNameExists(name string, excludeId ...uint) (bool, error) {
 	var count int64
-	query := global.DB.Model(&Brand{}).Where("name = ?", name)
+	query := global.DB.Model(&Brand{}).Where("LOWER(TRIM(name)) = LOWER(TRIM(?))", name)
 
 	// 如果提供了排除ID，则排除该ID的品牌
 	if len(excludeId) > 0 {
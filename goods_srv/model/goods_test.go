@@ -193,6 +193,9 @@ func setupTestDB(t *testing.T) {
 			&Goods{},
 			&CategoryBrand{},
 			&Banner{},
+			&BannerClick{},
+			&CategoryAttribute{},
+			&GoodsAttributeValue{},
 		); err != nil {
 			t.Fatalf("自动迁移表结构失败: %v", err)
 		}
@@ -20,30 +20,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Goods_GoodsList_FullMethodName            = "/Goods/GoodsList"
-	Goods_BatchGetGoods_FullMethodName        = "/Goods/BatchGetGoods"
-	Goods_CreateGoods_FullMethodName          = "/Goods/CreateGoods"
-	Goods_DeleteGoods_FullMethodName          = "/Goods/DeleteGoods"
-	Goods_UpdateGoods_FullMethodName          = "/Goods/UpdateGoods"
-	Goods_GetGoodsDetail_FullMethodName       = "/Goods/GetGoodsDetail"
-	Goods_GetAllCategoriesList_FullMethodName = "/Goods/GetAllCategoriesList"
-	Goods_GetSubCategory_FullMethodName       = "/Goods/GetSubCategory"
-	Goods_CreateCategory_FullMethodName       = "/Goods/CreateCategory"
-	Goods_DeleteCategory_FullMethodName       = "/Goods/DeleteCategory"
-	Goods_UpdateCategory_FullMethodName       = "/Goods/UpdateCategory"
-	Goods_BrandList_FullMethodName            = "/Goods/BrandList"
-	Goods_CreateBrand_FullMethodName          = "/Goods/CreateBrand"
-	Goods_DeleteBrand_FullMethodName          = "/Goods/DeleteBrand"
-	Goods_UpdateBrand_FullMethodName          = "/Goods/UpdateBrand"
-	Goods_BannerList_FullMethodName           = "/Goods/BannerList"
-	Goods_CreateBanner_FullMethodName         = "/Goods/CreateBanner"
-	Goods_DeleteBanner_FullMethodName         = "/Goods/DeleteBanner"
-	Goods_UpdateBanner_FullMethodName         = "/Goods/UpdateBanner"
-	Goods_CategoryBrandList_FullMethodName    = "/Goods/CategoryBrandList"
-	Goods_GetCategoryBrandList_FullMethodName = "/Goods/GetCategoryBrandList"
-	Goods_CreateCategoryBrand_FullMethodName  = "/Goods/CreateCategoryBrand"
-	Goods_DeleteCategoryBrand_FullMethodName  = "/Goods/DeleteCategoryBrand"
-	Goods_UpdateCategoryBrand_FullMethodName  = "/Goods/UpdateCategoryBrand"
+	Goods_GoodsList_FullMethodName               = "/Goods/GoodsList"
+	Goods_BatchGetGoods_FullMethodName           = "/Goods/BatchGetGoods"
+	Goods_CreateGoods_FullMethodName             = "/Goods/CreateGoods"
+	Goods_DeleteGoods_FullMethodName             = "/Goods/DeleteGoods"
+	Goods_UpdateGoods_FullMethodName             = "/Goods/UpdateGoods"
+	Goods_GetGoodsDetail_FullMethodName          = "/Goods/GetGoodsDetail"
+	Goods_GetAllCategoriesList_FullMethodName    = "/Goods/GetAllCategoriesList"
+	Goods_GetSubCategory_FullMethodName          = "/Goods/GetSubCategory"
+	Goods_CreateCategory_FullMethodName          = "/Goods/CreateCategory"
+	Goods_DeleteCategory_FullMethodName          = "/Goods/DeleteCategory"
+	Goods_UpdateCategory_FullMethodName          = "/Goods/UpdateCategory"
+	Goods_CategoryAttributeList_FullMethodName   = "/Goods/CategoryAttributeList"
+	Goods_CreateCategoryAttribute_FullMethodName = "/Goods/CreateCategoryAttribute"
+	Goods_UpdateCategoryAttribute_FullMethodName = "/Goods/UpdateCategoryAttribute"
+	Goods_DeleteCategoryAttribute_FullMethodName = "/Goods/DeleteCategoryAttribute"
+	Goods_BrandList_FullMethodName               = "/Goods/BrandList"
+	Goods_CreateBrand_FullMethodName             = "/Goods/CreateBrand"
+	Goods_DeleteBrand_FullMethodName             = "/Goods/DeleteBrand"
+	Goods_UpdateBrand_FullMethodName             = "/Goods/UpdateBrand"
+	Goods_MergeBrand_FullMethodName              = "/Goods/MergeBrand"
+	Goods_BannerList_FullMethodName              = "/Goods/BannerList"
+	Goods_CreateBanner_FullMethodName            = "/Goods/CreateBanner"
+	Goods_DeleteBanner_FullMethodName            = "/Goods/DeleteBanner"
+	Goods_UpdateBanner_FullMethodName            = "/Goods/UpdateBanner"
+	Goods_RecordBannerClick_FullMethodName       = "/Goods/RecordBannerClick"
+	Goods_BannerClickStats_FullMethodName        = "/Goods/BannerClickStats"
+	Goods_CategoryBrandList_FullMethodName       = "/Goods/CategoryBrandList"
+	Goods_GetCategoryBrandList_FullMethodName    = "/Goods/GetCategoryBrandList"
+	Goods_CreateCategoryBrand_FullMethodName     = "/Goods/CreateCategoryBrand"
+	Goods_DeleteCategoryBrand_FullMethodName     = "/Goods/DeleteCategoryBrand"
+	Goods_UpdateCategoryBrand_FullMethodName     = "/Goods/UpdateCategoryBrand"
 )
 
 // GoodsClient is the client API for Goods service.
@@ -65,16 +72,24 @@ type GoodsClient interface {
 	CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*CategoryInfoResponse, error)
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 分类属性模板
+	CategoryAttributeList(ctx context.Context, in *CategoryAttributeFilterRequest, opts ...grpc.CallOption) (*CategoryAttributeListResponse, error)
+	CreateCategoryAttribute(ctx context.Context, in *CategoryAttributeRequest, opts ...grpc.CallOption) (*CategoryAttributeResponse, error)
+	UpdateCategoryAttribute(ctx context.Context, in *CategoryAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCategoryAttribute(ctx context.Context, in *CategoryAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 品牌
 	BrandList(ctx context.Context, in *BrandFilterRequest, opts ...grpc.CallOption) (*BrandListResponse, error)
 	CreateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*BrandInfoResponse, error)
 	DeleteBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MergeBrand(ctx context.Context, in *MergeBrandRequest, opts ...grpc.CallOption) (*MergeBrandResponse, error)
 	// 轮播图
-	BannerList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BannerListResponse, error)
+	BannerList(ctx context.Context, in *BannerFilterRequest, opts ...grpc.CallOption) (*BannerListResponse, error)
 	CreateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*BannerResponse, error)
 	DeleteBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateBanner(ctx context.Context, in *BannerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RecordBannerClick(ctx context.Context, in *BannerClickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BannerClickStats(ctx context.Context, in *BannerClickStatsRequest, opts ...grpc.CallOption) (*BannerClickStatsResponse, error)
 	// 品牌-分类关联
 	CategoryBrandList(ctx context.Context, in *CategoryBrandFilterRequest, opts ...grpc.CallOption) (*CategoryBrandListResponse, error)
 	GetCategoryBrandList(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*BrandListResponse, error)
@@ -201,6 +216,46 @@ func (c *goodsClient) UpdateCategory(ctx context.Context, in *CategoryInfoReques
 	return out, nil
 }
 
+func (c *goodsClient) CategoryAttributeList(ctx context.Context, in *CategoryAttributeFilterRequest, opts ...grpc.CallOption) (*CategoryAttributeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttributeListResponse)
+	err := c.cc.Invoke(ctx, Goods_CategoryAttributeList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateCategoryAttribute(ctx context.Context, in *CategoryAttributeRequest, opts ...grpc.CallOption) (*CategoryAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttributeResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateCategoryAttribute(ctx context.Context, in *CategoryAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteCategoryAttribute(ctx context.Context, in *CategoryAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) BrandList(ctx context.Context, in *BrandFilterRequest, opts ...grpc.CallOption) (*BrandListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BrandListResponse)
@@ -241,7 +296,17 @@ func (c *goodsClient) UpdateBrand(ctx context.Context, in *BrandRequest, opts ..
 	return out, nil
 }
 
-func (c *goodsClient) BannerList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BannerListResponse, error) {
+func (c *goodsClient) MergeBrand(ctx context.Context, in *MergeBrandRequest, opts ...grpc.CallOption) (*MergeBrandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeBrandResponse)
+	err := c.cc.Invoke(ctx, Goods_MergeBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BannerList(ctx context.Context, in *BannerFilterRequest, opts ...grpc.CallOption) (*BannerListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BannerListResponse)
 	err := c.cc.Invoke(ctx, Goods_BannerList_FullMethodName, in, out, cOpts...)
@@ -281,6 +346,26 @@ func (c *goodsClient) UpdateBanner(ctx context.Context, in *BannerRequest, opts
 	return out, nil
 }
 
+func (c *goodsClient) RecordBannerClick(ctx context.Context, in *BannerClickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_RecordBannerClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BannerClickStats(ctx context.Context, in *BannerClickStatsRequest, opts ...grpc.CallOption) (*BannerClickStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BannerClickStatsResponse)
+	err := c.cc.Invoke(ctx, Goods_BannerClickStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) CategoryBrandList(ctx context.Context, in *CategoryBrandFilterRequest, opts ...grpc.CallOption) (*CategoryBrandListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CategoryBrandListResponse)
@@ -350,16 +435,24 @@ type GoodsServer interface {
 	CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error)
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
 	UpdateCategory(context.Context, *CategoryInfoRequest) (*emptypb.Empty, error)
+	// 分类属性模板
+	CategoryAttributeList(context.Context, *CategoryAttributeFilterRequest) (*CategoryAttributeListResponse, error)
+	CreateCategoryAttribute(context.Context, *CategoryAttributeRequest) (*CategoryAttributeResponse, error)
+	UpdateCategoryAttribute(context.Context, *CategoryAttributeRequest) (*emptypb.Empty, error)
+	DeleteCategoryAttribute(context.Context, *CategoryAttributeRequest) (*emptypb.Empty, error)
 	// 品牌
 	BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error)
 	CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error)
 	DeleteBrand(context.Context, *BrandRequest) (*emptypb.Empty, error)
 	UpdateBrand(context.Context, *BrandRequest) (*emptypb.Empty, error)
+	MergeBrand(context.Context, *MergeBrandRequest) (*MergeBrandResponse, error)
 	// 轮播图
-	BannerList(context.Context, *emptypb.Empty) (*BannerListResponse, error)
+	BannerList(context.Context, *BannerFilterRequest) (*BannerListResponse, error)
 	CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error)
 	DeleteBanner(context.Context, *BannerRequest) (*emptypb.Empty, error)
 	UpdateBanner(context.Context, *BannerRequest) (*emptypb.Empty, error)
+	RecordBannerClick(context.Context, *BannerClickRequest) (*emptypb.Empty, error)
+	BannerClickStats(context.Context, *BannerClickStatsRequest) (*BannerClickStatsResponse, error)
 	// 品牌-分类关联
 	CategoryBrandList(context.Context, *CategoryBrandFilterRequest) (*CategoryBrandListResponse, error)
 	GetCategoryBrandList(context.Context, *CategoryInfoRequest) (*BrandListResponse, error)
@@ -409,6 +502,18 @@ func (UnimplementedGoodsServer) DeleteCategory(context.Context, *DeleteCategoryR
 func (UnimplementedGoodsServer) UpdateCategory(context.Context, *CategoryInfoRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
 }
+func (UnimplementedGoodsServer) CategoryAttributeList(context.Context, *CategoryAttributeFilterRequest) (*CategoryAttributeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryAttributeList not implemented")
+}
+func (UnimplementedGoodsServer) CreateCategoryAttribute(context.Context, *CategoryAttributeRequest) (*CategoryAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryAttribute not implemented")
+}
+func (UnimplementedGoodsServer) UpdateCategoryAttribute(context.Context, *CategoryAttributeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryAttribute not implemented")
+}
+func (UnimplementedGoodsServer) DeleteCategoryAttribute(context.Context, *CategoryAttributeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryAttribute not implemented")
+}
 func (UnimplementedGoodsServer) BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BrandList not implemented")
 }
@@ -421,7 +526,10 @@ func (UnimplementedGoodsServer) DeleteBrand(context.Context, *BrandRequest) (*em
 func (UnimplementedGoodsServer) UpdateBrand(context.Context, *BrandRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
 }
-func (UnimplementedGoodsServer) BannerList(context.Context, *emptypb.Empty) (*BannerListResponse, error) {
+func (UnimplementedGoodsServer) MergeBrand(context.Context, *MergeBrandRequest) (*MergeBrandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBrand not implemented")
+}
+func (UnimplementedGoodsServer) BannerList(context.Context, *BannerFilterRequest) (*BannerListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BannerList not implemented")
 }
 func (UnimplementedGoodsServer) CreateBanner(context.Context, *BannerRequest) (*BannerResponse, error) {
@@ -433,6 +541,12 @@ func (UnimplementedGoodsServer) DeleteBanner(context.Context, *BannerRequest) (*
 func (UnimplementedGoodsServer) UpdateBanner(context.Context, *BannerRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBanner not implemented")
 }
+func (UnimplementedGoodsServer) RecordBannerClick(context.Context, *BannerClickRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordBannerClick not implemented")
+}
+func (UnimplementedGoodsServer) BannerClickStats(context.Context, *BannerClickStatsRequest) (*BannerClickStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BannerClickStats not implemented")
+}
 func (UnimplementedGoodsServer) CategoryBrandList(context.Context, *CategoryBrandFilterRequest) (*CategoryBrandListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CategoryBrandList not implemented")
 }
@@ -667,6 +781,78 @@ func _Goods_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_CategoryAttributeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributeFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CategoryAttributeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CategoryAttributeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CategoryAttributeList(ctx, req.(*CategoryAttributeFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateCategoryAttribute(ctx, req.(*CategoryAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateCategoryAttribute(ctx, req.(*CategoryAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteCategoryAttribute(ctx, req.(*CategoryAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_BrandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BrandFilterRequest)
 	if err := dec(in); err != nil {
@@ -739,8 +925,26 @@ func _Goods_UpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_MergeBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).MergeBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_MergeBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).MergeBrand(ctx, req.(*MergeBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_BannerList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(BannerFilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -752,7 +956,7 @@ func _Goods_BannerList_Handler(srv interface{}, ctx context.Context, dec func(in
 		FullMethod: Goods_BannerList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServer).BannerList(ctx, req.(*emptypb.Empty))
+		return srv.(GoodsServer).BannerList(ctx, req.(*BannerFilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -811,6 +1015,42 @@ func _Goods_UpdateBanner_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_RecordBannerClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).RecordBannerClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_RecordBannerClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).RecordBannerClick(ctx, req.(*BannerClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BannerClickStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BannerClickStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BannerClickStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BannerClickStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BannerClickStats(ctx, req.(*BannerClickStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_CategoryBrandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CategoryBrandFilterRequest)
 	if err := dec(in); err != nil {
@@ -952,6 +1192,22 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCategory",
 			Handler:    _Goods_UpdateCategory_Handler,
 		},
+		{
+			MethodName: "CategoryAttributeList",
+			Handler:    _Goods_CategoryAttributeList_Handler,
+		},
+		{
+			MethodName: "CreateCategoryAttribute",
+			Handler:    _Goods_CreateCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryAttribute",
+			Handler:    _Goods_UpdateCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryAttribute",
+			Handler:    _Goods_DeleteCategoryAttribute_Handler,
+		},
 		{
 			MethodName: "BrandList",
 			Handler:    _Goods_BrandList_Handler,
@@ -968,6 +1224,10 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBrand",
 			Handler:    _Goods_UpdateBrand_Handler,
 		},
+		{
+			MethodName: "MergeBrand",
+			Handler:    _Goods_MergeBrand_Handler,
+		},
 		{
 			MethodName: "BannerList",
 			Handler:    _Goods_BannerList_Handler,
@@ -984,6 +1244,14 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBanner",
 			Handler:    _Goods_UpdateBanner_Handler,
 		},
+		{
+			MethodName: "RecordBannerClick",
+			Handler:    _Goods_RecordBannerClick_Handler,
+		},
+		{
+			MethodName: "BannerClickStats",
+			Handler:    _Goods_BannerClickStats_Handler,
+		},
 		{
 			MethodName: "CategoryBrandList",
 			Handler:    _Goods_CategoryBrandList_Handler,
@@ -0,0 +1,1763 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: userop.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 收藏相关消息
+type UserFavRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GoodsId       int32                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	Pages         int32                  `protobuf:"varint,3,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int32                  `protobuf:"varint,4,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserFavRequest) Reset() {
+	*x = UserFavRequest{}
+	mi := &file_userop_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserFavRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFavRequest) ProtoMessage() {}
+
+func (x *UserFavRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFavRequest.ProtoReflect.Descriptor instead.
+func (*UserFavRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserFavRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserFavRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *UserFavRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *UserFavRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type FavListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*GoodsInfo           `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavListResponse) Reset() {
+	*x = FavListResponse{}
+	mi := &file_userop_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavListResponse) ProtoMessage() {}
+
+func (x *FavListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavListResponse.ProtoReflect.Descriptor instead.
+func (*FavListResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FavListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FavListResponse) GetData() []*GoodsInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GoodsInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShopPrice     float32                `protobuf:"fixed32,3,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`
+	GoodsBrief    string                 `protobuf:"bytes,4,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`
+	Images        string                 `protobuf:"bytes,5,opt,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsInfo) Reset() {
+	*x = GoodsInfo{}
+	mi := &file_userop_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsInfo) ProtoMessage() {}
+
+func (x *GoodsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsInfo.ProtoReflect.Descriptor instead.
+func (*GoodsInfo) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GoodsInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsInfo) GetShopPrice() float32 {
+	if x != nil {
+		return x.ShopPrice
+	}
+	return 0
+}
+
+func (x *GoodsInfo) GetGoodsBrief() string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return ""
+}
+
+func (x *GoodsInfo) GetImages() string {
+	if x != nil {
+		return x.Images
+	}
+	return ""
+}
+
+type IsFavResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsFavResponse) Reset() {
+	*x = IsFavResponse{}
+	mi := &file_userop_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsFavResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsFavResponse) ProtoMessage() {}
+
+func (x *IsFavResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsFavResponse.ProtoReflect.Descriptor instead.
+func (*IsFavResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IsFavResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 收货地址相关消息
+type AddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressRequest) Reset() {
+	*x = AddressRequest{}
+	mi := &file_userop_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRequest) ProtoMessage() {}
+
+func (x *AddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressRequest.ProtoReflect.Descriptor instead.
+func (*AddressRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddressRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddressRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Province      string                 `protobuf:"bytes,3,opt,name=province,proto3" json:"province,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	District      string                 `protobuf:"bytes,5,opt,name=district,proto3" json:"district,omitempty"`
+	Address       string                 `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	SignerName    string                 `protobuf:"bytes,7,opt,name=signerName,proto3" json:"signerName,omitempty"`
+	SignerMobile  string                 `protobuf:"bytes,8,opt,name=signerMobile,proto3" json:"signerMobile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_userop_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Address) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Address) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Address) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *Address) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Address) GetSignerName() string {
+	if x != nil {
+		return x.SignerName
+	}
+	return ""
+}
+
+func (x *Address) GetSignerMobile() string {
+	if x != nil {
+		return x.SignerMobile
+	}
+	return ""
+}
+
+type AddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressResponse) Reset() {
+	*x = AddressResponse{}
+	mi := &file_userop_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressResponse) ProtoMessage() {}
+
+func (x *AddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressResponse.ProtoReflect.Descriptor instead.
+func (*AddressResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddressResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AddressListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*Address             `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressListResponse) Reset() {
+	*x = AddressListResponse{}
+	mi := &file_userop_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressListResponse) ProtoMessage() {}
+
+func (x *AddressListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressListResponse.ProtoReflect.Descriptor instead.
+func (*AddressListResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddressListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AddressListResponse) GetData() []*Address {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 留言相关消息
+type MessageRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	MessageType int32                  `protobuf:"varint,3,opt,name=messageType,proto3" json:"messageType,omitempty"`
+	Subject     string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message     string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	File        string                 `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"`
+	Pages       int32                  `protobuf:"varint,7,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums int32                  `protobuf:"varint,8,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	// 以下字段仅售后留言使用
+	OrderId       int32   `protobuf:"varint,9,opt,name=orderId,proto3" json:"orderId,omitempty"`             // 关联订单ID
+	OrderGoodsId  int32   `protobuf:"varint,10,opt,name=orderGoodsId,proto3" json:"orderGoodsId,omitempty"`  // 订单商品ID，0表示整单
+	RefundType    string  `protobuf:"bytes,11,opt,name=refundType,proto3" json:"refundType,omitempty"`       // REFUND_ONLY(仅退款，默认), RETURN_GOODS(退货退款)
+	RefundAmount  float32 `protobuf:"fixed32,12,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"` // 退款金额，0表示可退的全部金额
+	RefundNums    int32   `protobuf:"varint,13,opt,name=refundNums,proto3" json:"refundNums,omitempty"`      // 退货数量，0表示可退的全部数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageRequest) Reset() {
+	*x = MessageRequest{}
+	mi := &file_userop_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRequest) ProtoMessage() {}
+
+func (x *MessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRequest.ProtoReflect.Descriptor instead.
+func (*MessageRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MessageRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetMessageType() int32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *MessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MessageRequest) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *MessageRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetRefundType() string {
+	if x != nil {
+		return x.RefundType
+	}
+	return ""
+}
+
+func (x *MessageRequest) GetRefundAmount() float32 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *MessageRequest) GetRefundNums() int32 {
+	if x != nil {
+		return x.RefundNums
+	}
+	return 0
+}
+
+type MessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageResponse) Reset() {
+	*x = MessageResponse{}
+	mi := &file_userop_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResponse) ProtoMessage() {}
+
+func (x *MessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
+func (*MessageResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MessageResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MessageListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*MessageInfo         `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageListResponse) Reset() {
+	*x = MessageListResponse{}
+	mi := &file_userop_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageListResponse) ProtoMessage() {}
+
+func (x *MessageListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageListResponse.ProtoReflect.Descriptor instead.
+func (*MessageListResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MessageListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MessageListResponse) GetData() []*MessageInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MessageInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	MessageType   int32                  `protobuf:"varint,3,opt,name=messageType,proto3" json:"messageType,omitempty"`
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	File          string                 `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"`
+	OrderId       int32                  `protobuf:"varint,7,opt,name=orderId,proto3" json:"orderId,omitempty"`   // 关联订单ID
+	RefundId      int32                  `protobuf:"varint,8,opt,name=refundId,proto3" json:"refundId,omitempty"` // 关联售后单ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageInfo) Reset() {
+	*x = MessageInfo{}
+	mi := &file_userop_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageInfo) ProtoMessage() {}
+
+func (x *MessageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageInfo.ProtoReflect.Descriptor instead.
+func (*MessageInfo) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MessageInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetMessageType() int32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetRefundId() int32 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+// 商品评价相关消息
+type ReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	OrderId       int32                  `protobuf:"varint,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderGoodsId  int32                  `protobuf:"varint,3,opt,name=orderGoodsId,proto3" json:"orderGoodsId,omitempty"` // 订单商品ID
+	Rating        int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`             // 评分1-5
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Images        []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	mi := &file_userop_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRequest) ProtoMessage() {}
+
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReviewRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type ReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewResponse) Reset() {
+	*x = ReviewResponse{}
+	mi := &file_userop_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResponse) ProtoMessage() {}
+
+func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReviewResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReviewResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReviewFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status        *int32                 `protobuf:"varint,3,opt,name=status,proto3,oneof" json:"status,omitempty"` // 审核状态，不传时只返回审核通过的评价
+	Pages         int32                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int32                  `protobuf:"varint,5,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewFilterRequest) Reset() {
+	*x = ReviewFilterRequest{}
+	mi := &file_userop_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFilterRequest) ProtoMessage() {}
+
+func (x *ReviewFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFilterRequest.ProtoReflect.Descriptor instead.
+func (*ReviewFilterRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReviewFilterRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *ReviewFilterRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReviewFilterRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *ReviewFilterRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *ReviewFilterRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type ReviewInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	GoodsId       int32                  `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	OrderId       int32                  `protobuf:"varint,4,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderGoodsId  int32                  `protobuf:"varint,5,opt,name=orderGoodsId,proto3" json:"orderGoodsId,omitempty"`
+	Rating        int32                  `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	Content       string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Images        []string               `protobuf:"bytes,8,rep,name=images,proto3" json:"images,omitempty"`
+	Status        int32                  `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,10,opt,name=rejectReason,proto3" json:"rejectReason,omitempty"`
+	Reply         string                 `protobuf:"bytes,11,opt,name=reply,proto3" json:"reply,omitempty"`
+	ReplyTime     int64                  `protobuf:"varint,12,opt,name=replyTime,proto3" json:"replyTime,omitempty"`
+	AddTime       int64                  `protobuf:"varint,13,opt,name=addTime,proto3" json:"addTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewInfo) Reset() {
+	*x = ReviewInfo{}
+	mi := &file_userop_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewInfo) ProtoMessage() {}
+
+func (x *ReviewInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewInfo.ProtoReflect.Descriptor instead.
+func (*ReviewInfo) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReviewInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ReviewInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetReplyTime() int64 {
+	if x != nil {
+		return x.ReplyTime
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+type ReviewListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*ReviewInfo          `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewListResponse) Reset() {
+	*x = ReviewListResponse{}
+	mi := &file_userop_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewListResponse) ProtoMessage() {}
+
+func (x *ReviewListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewListResponse.ProtoReflect.Descriptor instead.
+func (*ReviewListResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReviewListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReviewListResponse) GetData() []*ReviewInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ModerateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 2(通过),3(拒绝)
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`  // 拒绝原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_userop_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ModerateReviewRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReplyReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reply         string                 `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyReviewRequest) Reset() {
+	*x = ReplyReviewRequest{}
+	mi := &file_userop_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyReviewRequest) ProtoMessage() {}
+
+func (x *ReplyReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyReviewRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplyReviewRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplyReviewRequest) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+type GoodsRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsIds      []int32                `protobuf:"varint,1,rep,packed,name=goodsIds,proto3" json:"goodsIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsRatingRequest) Reset() {
+	*x = GoodsRatingRequest{}
+	mi := &file_userop_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsRatingRequest) ProtoMessage() {}
+
+func (x *GoodsRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsRatingRequest.ProtoReflect.Descriptor instead.
+func (*GoodsRatingRequest) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GoodsRatingRequest) GetGoodsIds() []int32 {
+	if x != nil {
+		return x.GoodsIds
+	}
+	return nil
+}
+
+type GoodsRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	AvgRating     float32                `protobuf:"fixed32,2,opt,name=avgRating,proto3" json:"avgRating,omitempty"`
+	RatingCount   int32                  `protobuf:"varint,3,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsRating) Reset() {
+	*x = GoodsRating{}
+	mi := &file_userop_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsRating) ProtoMessage() {}
+
+func (x *GoodsRating) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsRating.ProtoReflect.Descriptor instead.
+func (*GoodsRating) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GoodsRating) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GoodsRating) GetAvgRating() float32 {
+	if x != nil {
+		return x.AvgRating
+	}
+	return 0
+}
+
+func (x *GoodsRating) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type GoodsRatingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*GoodsRating         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsRatingListResponse) Reset() {
+	*x = GoodsRatingListResponse{}
+	mi := &file_userop_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsRatingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsRatingListResponse) ProtoMessage() {}
+
+func (x *GoodsRatingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_userop_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsRatingListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsRatingListResponse) Descriptor() ([]byte, []int) {
+	return file_userop_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GoodsRatingListResponse) GetData() []*GoodsRating {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_userop_proto protoreflect.FileDescriptor
+
+const file_userop_proto_rawDesc = "" +
+	"\n" +
+	"\fuserop.proto\x12\x06userop\x1a\x1bgoogle/protobuf/empty.proto\"z\n" +
+	"\x0eUserFavRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x05R\agoodsId\x12\x14\n" +
+	"\x05pages\x18\x03 \x01(\x05R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\x04 \x01(\x05R\vpagePerNums\"N\n" +
+	"\x0fFavListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12%\n" +
+	"\x04data\x18\x02 \x03(\v2\x11.userop.GoodsInfoR\x04data\"\x85\x01\n" +
+	"\tGoodsInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tshopPrice\x18\x03 \x01(\x02R\tshopPrice\x12\x1e\n" +
+	"\n" +
+	"goodsBrief\x18\x04 \x01(\tR\n" +
+	"goodsBrief\x12\x16\n" +
+	"\x06images\x18\x05 \x01(\tR\x06images\")\n" +
+	"\rIsFavResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"8\n" +
+	"\x0eAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\"\xdb\x01\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\bprovince\x18\x03 \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x1a\n" +
+	"\bdistrict\x18\x05 \x01(\tR\bdistrict\x12\x18\n" +
+	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x1e\n" +
+	"\n" +
+	"signerName\x18\a \x01(\tR\n" +
+	"signerName\x12\"\n" +
+	"\fsignerMobile\x18\b \x01(\tR\fsignerMobile\"!\n" +
+	"\x0fAddressResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"P\n" +
+	"\x13AddressListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12#\n" +
+	"\x04data\x18\x02 \x03(\v2\x0f.userop.AddressR\x04data\"\xfc\x02\n" +
+	"\x0eMessageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12 \n" +
+	"\vmessageType\x18\x03 \x01(\x05R\vmessageType\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
+	"\x04file\x18\x06 \x01(\tR\x04file\x12\x14\n" +
+	"\x05pages\x18\a \x01(\x05R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\b \x01(\x05R\vpagePerNums\x12\x18\n" +
+	"\aorderId\x18\t \x01(\x05R\aorderId\x12\"\n" +
+	"\forderGoodsId\x18\n" +
+	" \x01(\x05R\forderGoodsId\x12\x1e\n" +
+	"\n" +
+	"refundType\x18\v \x01(\tR\n" +
+	"refundType\x12\"\n" +
+	"\frefundAmount\x18\f \x01(\x02R\frefundAmount\x12\x1e\n" +
+	"\n" +
+	"refundNums\x18\r \x01(\x05R\n" +
+	"refundNums\"!\n" +
+	"\x0fMessageResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"T\n" +
+	"\x13MessageListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12'\n" +
+	"\x04data\x18\x02 \x03(\v2\x13.userop.MessageInfoR\x04data\"\xd5\x01\n" +
+	"\vMessageInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12 \n" +
+	"\vmessageType\x18\x03 \x01(\x05R\vmessageType\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x12\n" +
+	"\x04file\x18\x06 \x01(\tR\x04file\x12\x18\n" +
+	"\aorderId\x18\a \x01(\x05R\aorderId\x12\x1a\n" +
+	"\brefundId\x18\b \x01(\x05R\brefundId\"\xaf\x01\n" +
+	"\rReviewRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x05R\x06userId\x12\x18\n" +
+	"\aorderId\x18\x02 \x01(\x05R\aorderId\x12\"\n" +
+	"\forderGoodsId\x18\x03 \x01(\x05R\forderGoodsId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x16\n" +
+	"\x06images\x18\x06 \x03(\tR\x06images\" \n" +
+	"\x0eReviewResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xa7\x01\n" +
+	"\x13ReviewFilterRequest\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\x05H\x00R\x06status\x88\x01\x01\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x05R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\x05 \x01(\x05R\vpagePerNumsB\t\n" +
+	"\a_status\"\xe0\x02\n" +
+	"\n" +
+	"ReviewInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x05R\x06userId\x12\x18\n" +
+	"\agoodsId\x18\x03 \x01(\x05R\agoodsId\x12\x18\n" +
+	"\aorderId\x18\x04 \x01(\x05R\aorderId\x12\"\n" +
+	"\forderGoodsId\x18\x05 \x01(\x05R\forderGoodsId\x12\x16\n" +
+	"\x06rating\x18\x06 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x16\n" +
+	"\x06images\x18\b \x03(\tR\x06images\x12\x16\n" +
+	"\x06status\x18\t \x01(\x05R\x06status\x12\"\n" +
+	"\frejectReason\x18\n" +
+	" \x01(\tR\frejectReason\x12\x14\n" +
+	"\x05reply\x18\v \x01(\tR\x05reply\x12\x1c\n" +
+	"\treplyTime\x18\f \x01(\x03R\treplyTime\x12\x18\n" +
+	"\aaddTime\x18\r \x01(\x03R\aaddTime\"R\n" +
+	"\x12ReviewListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12&\n" +
+	"\x04data\x18\x02 \x03(\v2\x12.userop.ReviewInfoR\x04data\"W\n" +
+	"\x15ModerateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\":\n" +
+	"\x12ReplyReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05reply\x18\x02 \x01(\tR\x05reply\"0\n" +
+	"\x12GoodsRatingRequest\x12\x1a\n" +
+	"\bgoodsIds\x18\x01 \x03(\x05R\bgoodsIds\"g\n" +
+	"\vGoodsRating\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12\x1c\n" +
+	"\tavgRating\x18\x02 \x01(\x02R\tavgRating\x12 \n" +
+	"\vratingCount\x18\x03 \x01(\x05R\vratingCount\"B\n" +
+	"\x17GoodsRatingListResponse\x12'\n" +
+	"\x04data\x18\x01 \x03(\v2\x13.userop.GoodsRatingR\x04data2\xa7\b\n" +
+	"\x06UserOp\x12=\n" +
+	"\n" +
+	"GetFavList\x12\x16.userop.UserFavRequest\x1a\x17.userop.FavListResponse\x12<\n" +
+	"\n" +
+	"AddUserFav\x12\x16.userop.UserFavRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\rDeleteUserFav\x12\x16.userop.UserFavRequest\x1a\x16.google.protobuf.Empty\x12B\n" +
+	"\x10GetUserFavDetail\x12\x16.userop.UserFavRequest\x1a\x16.google.protobuf.Empty\x126\n" +
+	"\x05IsFav\x12\x16.userop.UserFavRequest\x1a\x15.userop.IsFavResponse\x12E\n" +
+	"\x0eGetAddressList\x12\x16.userop.AddressRequest\x1a\x1b.userop.AddressListResponse\x129\n" +
+	"\rCreateAddress\x12\x0f.userop.Address\x1a\x17.userop.AddressResponse\x128\n" +
+	"\rUpdateAddress\x12\x0f.userop.Address\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\rDeleteAddress\x12\x16.userop.AddressRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\rCreateMessage\x12\x16.userop.MessageRequest\x1a\x17.userop.MessageResponse\x12B\n" +
+	"\vMessageList\x12\x16.userop.MessageRequest\x1a\x1b.userop.MessageListResponse\x12=\n" +
+	"\fCreateReview\x12\x15.userop.ReviewRequest\x1a\x16.userop.ReviewResponse\x12E\n" +
+	"\n" +
+	"ReviewList\x12\x1b.userop.ReviewFilterRequest\x1a\x1a.userop.ReviewListResponse\x12G\n" +
+	"\x0eModerateReview\x12\x1d.userop.ModerateReviewRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\vReplyReview\x12\x1a.userop.ReplyReviewRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x0fGetGoodsRatings\x12\x1a.userop.GoodsRatingRequest\x1a\x1f.userop.GoodsRatingListResponseB\n" +
+	"Z\b./;protob\x06proto3"
+
+var (
+	file_userop_proto_rawDescOnce sync.Once
+	file_userop_proto_rawDescData []byte
+)
+
+func file_userop_proto_rawDescGZIP() []byte {
+	file_userop_proto_rawDescOnce.Do(func() {
+		file_userop_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_userop_proto_rawDesc), len(file_userop_proto_rawDesc)))
+	})
+	return file_userop_proto_rawDescData
+}
+
+var file_userop_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_userop_proto_goTypes = []any{
+	(*UserFavRequest)(nil),          // 0: userop.UserFavRequest
+	(*FavListResponse)(nil),         // 1: userop.FavListResponse
+	(*GoodsInfo)(nil),               // 2: userop.GoodsInfo
+	(*IsFavResponse)(nil),           // 3: userop.IsFavResponse
+	(*AddressRequest)(nil),          // 4: userop.AddressRequest
+	(*Address)(nil),                 // 5: userop.Address
+	(*AddressResponse)(nil),         // 6: userop.AddressResponse
+	(*AddressListResponse)(nil),     // 7: userop.AddressListResponse
+	(*MessageRequest)(nil),          // 8: userop.MessageRequest
+	(*MessageResponse)(nil),         // 9: userop.MessageResponse
+	(*MessageListResponse)(nil),     // 10: userop.MessageListResponse
+	(*MessageInfo)(nil),             // 11: userop.MessageInfo
+	(*ReviewRequest)(nil),           // 12: userop.ReviewRequest
+	(*ReviewResponse)(nil),          // 13: userop.ReviewResponse
+	(*ReviewFilterRequest)(nil),     // 14: userop.ReviewFilterRequest
+	(*ReviewInfo)(nil),              // 15: userop.ReviewInfo
+	(*ReviewListResponse)(nil),      // 16: userop.ReviewListResponse
+	(*ModerateReviewRequest)(nil),   // 17: userop.ModerateReviewRequest
+	(*ReplyReviewRequest)(nil),      // 18: userop.ReplyReviewRequest
+	(*GoodsRatingRequest)(nil),      // 19: userop.GoodsRatingRequest
+	(*GoodsRating)(nil),             // 20: userop.GoodsRating
+	(*GoodsRatingListResponse)(nil), // 21: userop.GoodsRatingListResponse
+	(*emptypb.Empty)(nil),           // 22: google.protobuf.Empty
+}
+var file_userop_proto_depIdxs = []int32{
+	2,  // 0: userop.FavListResponse.data:type_name -> userop.GoodsInfo
+	5,  // 1: userop.AddressListResponse.data:type_name -> userop.Address
+	11, // 2: userop.MessageListResponse.data:type_name -> userop.MessageInfo
+	15, // 3: userop.ReviewListResponse.data:type_name -> userop.ReviewInfo
+	20, // 4: userop.GoodsRatingListResponse.data:type_name -> userop.GoodsRating
+	0,  // 5: userop.UserOp.GetFavList:input_type -> userop.UserFavRequest
+	0,  // 6: userop.UserOp.AddUserFav:input_type -> userop.UserFavRequest
+	0,  // 7: userop.UserOp.DeleteUserFav:input_type -> userop.UserFavRequest
+	0,  // 8: userop.UserOp.GetUserFavDetail:input_type -> userop.UserFavRequest
+	0,  // 9: userop.UserOp.IsFav:input_type -> userop.UserFavRequest
+	4,  // 10: userop.UserOp.GetAddressList:input_type -> userop.AddressRequest
+	5,  // 11: userop.UserOp.CreateAddress:input_type -> userop.Address
+	5,  // 12: userop.UserOp.UpdateAddress:input_type -> userop.Address
+	4,  // 13: userop.UserOp.DeleteAddress:input_type -> userop.AddressRequest
+	8,  // 14: userop.UserOp.CreateMessage:input_type -> userop.MessageRequest
+	8,  // 15: userop.UserOp.MessageList:input_type -> userop.MessageRequest
+	12, // 16: userop.UserOp.CreateReview:input_type -> userop.ReviewRequest
+	14, // 17: userop.UserOp.ReviewList:input_type -> userop.ReviewFilterRequest
+	17, // 18: userop.UserOp.ModerateReview:input_type -> userop.ModerateReviewRequest
+	18, // 19: userop.UserOp.ReplyReview:input_type -> userop.ReplyReviewRequest
+	19, // 20: userop.UserOp.GetGoodsRatings:input_type -> userop.GoodsRatingRequest
+	1,  // 21: userop.UserOp.GetFavList:output_type -> userop.FavListResponse
+	22, // 22: userop.UserOp.AddUserFav:output_type -> google.protobuf.Empty
+	22, // 23: userop.UserOp.DeleteUserFav:output_type -> google.protobuf.Empty
+	22, // 24: userop.UserOp.GetUserFavDetail:output_type -> google.protobuf.Empty
+	3,  // 25: userop.UserOp.IsFav:output_type -> userop.IsFavResponse
+	7,  // 26: userop.UserOp.GetAddressList:output_type -> userop.AddressListResponse
+	6,  // 27: userop.UserOp.CreateAddress:output_type -> userop.AddressResponse
+	22, // 28: userop.UserOp.UpdateAddress:output_type -> google.protobuf.Empty
+	22, // 29: userop.UserOp.DeleteAddress:output_type -> google.protobuf.Empty
+	9,  // 30: userop.UserOp.CreateMessage:output_type -> userop.MessageResponse
+	10, // 31: userop.UserOp.MessageList:output_type -> userop.MessageListResponse
+	13, // 32: userop.UserOp.CreateReview:output_type -> userop.ReviewResponse
+	16, // 33: userop.UserOp.ReviewList:output_type -> userop.ReviewListResponse
+	22, // 34: userop.UserOp.ModerateReview:output_type -> google.protobuf.Empty
+	22, // 35: userop.UserOp.ReplyReview:output_type -> google.protobuf.Empty
+	21, // 36: userop.UserOp.GetGoodsRatings:output_type -> userop.GoodsRatingListResponse
+	21, // [21:37] is the sub-list for method output_type
+	5,  // [5:21] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_userop_proto_init() }
+func file_userop_proto_init() {
+	if File_userop_proto != nil {
+		return
+	}
+	file_userop_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_userop_proto_rawDesc), len(file_userop_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_userop_proto_goTypes,
+		DependencyIndexes: file_userop_proto_depIdxs,
+		MessageInfos:      file_userop_proto_msgTypes,
+	}.Build()
+	File_userop_proto = out.File
+	file_userop_proto_goTypes = nil
+	file_userop_proto_depIdxs = nil
+}
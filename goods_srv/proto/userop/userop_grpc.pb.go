@@ -0,0 +1,700 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: userop.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserOp_GetFavList_FullMethodName       = "/userop.UserOp/GetFavList"
+	UserOp_AddUserFav_FullMethodName       = "/userop.UserOp/AddUserFav"
+	UserOp_DeleteUserFav_FullMethodName    = "/userop.UserOp/DeleteUserFav"
+	UserOp_GetUserFavDetail_FullMethodName = "/userop.UserOp/GetUserFavDetail"
+	UserOp_IsFav_FullMethodName            = "/userop.UserOp/IsFav"
+	UserOp_GetAddressList_FullMethodName   = "/userop.UserOp/GetAddressList"
+	UserOp_CreateAddress_FullMethodName    = "/userop.UserOp/CreateAddress"
+	UserOp_UpdateAddress_FullMethodName    = "/userop.UserOp/UpdateAddress"
+	UserOp_DeleteAddress_FullMethodName    = "/userop.UserOp/DeleteAddress"
+	UserOp_CreateMessage_FullMethodName    = "/userop.UserOp/CreateMessage"
+	UserOp_MessageList_FullMethodName      = "/userop.UserOp/MessageList"
+	UserOp_CreateReview_FullMethodName     = "/userop.UserOp/CreateReview"
+	UserOp_ReviewList_FullMethodName       = "/userop.UserOp/ReviewList"
+	UserOp_ModerateReview_FullMethodName   = "/userop.UserOp/ModerateReview"
+	UserOp_ReplyReview_FullMethodName      = "/userop.UserOp/ReplyReview"
+	UserOp_GetGoodsRatings_FullMethodName  = "/userop.UserOp/GetGoodsRatings"
+)
+
+// UserOpClient is the client API for UserOp service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserOpClient interface {
+	// 收藏相关
+	GetFavList(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*FavListResponse, error)
+	AddUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserFavDetail(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*IsFavResponse, error)
+	// 收货地址相关
+	GetAddressList(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
+	CreateAddress(ctx context.Context, in *Address, opts ...grpc.CallOption) (*AddressResponse, error)
+	UpdateAddress(ctx context.Context, in *Address, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 留言相关
+	CreateMessage(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	MessageList(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageListResponse, error)
+	// 商品评价相关
+	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	ReviewList(ctx context.Context, in *ReviewFilterRequest, opts ...grpc.CallOption) (*ReviewListResponse, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetGoodsRatings(ctx context.Context, in *GoodsRatingRequest, opts ...grpc.CallOption) (*GoodsRatingListResponse, error)
+}
+
+type userOpClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserOpClient(cc grpc.ClientConnInterface) UserOpClient {
+	return &userOpClient{cc}
+}
+
+func (c *userOpClient) GetFavList(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*FavListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavListResponse)
+	err := c.cc.Invoke(ctx, UserOp_GetFavList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) AddUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_AddUserFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) DeleteUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_DeleteUserFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) GetUserFavDetail(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_GetUserFavDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) IsFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*IsFavResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsFavResponse)
+	err := c.cc.Invoke(ctx, UserOp_IsFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) GetAddressList(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*AddressListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressListResponse)
+	err := c.cc.Invoke(ctx, UserOp_GetAddressList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) CreateAddress(ctx context.Context, in *Address, opts ...grpc.CallOption) (*AddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressResponse)
+	err := c.cc.Invoke(ctx, UserOp_CreateAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) UpdateAddress(ctx context.Context, in *Address, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_UpdateAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) DeleteAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_DeleteAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) CreateMessage(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, UserOp_CreateMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) MessageList(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageListResponse)
+	err := c.cc.Invoke(ctx, UserOp_MessageList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, UserOp_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) ReviewList(ctx context.Context, in *ReviewFilterRequest, opts ...grpc.CallOption) (*ReviewListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewListResponse)
+	err := c.cc.Invoke(ctx, UserOp_ReviewList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_ReplyReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) GetGoodsRatings(ctx context.Context, in *GoodsRatingRequest, opts ...grpc.CallOption) (*GoodsRatingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsRatingListResponse)
+	err := c.cc.Invoke(ctx, UserOp_GetGoodsRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserOpServer is the server API for UserOp service.
+// All implementations must embed UnimplementedUserOpServer
+// for forward compatibility.
+type UserOpServer interface {
+	// 收藏相关
+	GetFavList(context.Context, *UserFavRequest) (*FavListResponse, error)
+	AddUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error)
+	DeleteUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error)
+	GetUserFavDetail(context.Context, *UserFavRequest) (*emptypb.Empty, error)
+	IsFav(context.Context, *UserFavRequest) (*IsFavResponse, error)
+	// 收货地址相关
+	GetAddressList(context.Context, *AddressRequest) (*AddressListResponse, error)
+	CreateAddress(context.Context, *Address) (*AddressResponse, error)
+	UpdateAddress(context.Context, *Address) (*emptypb.Empty, error)
+	DeleteAddress(context.Context, *AddressRequest) (*emptypb.Empty, error)
+	// 留言相关
+	CreateMessage(context.Context, *MessageRequest) (*MessageResponse, error)
+	MessageList(context.Context, *MessageRequest) (*MessageListResponse, error)
+	// 商品评价相关
+	CreateReview(context.Context, *ReviewRequest) (*ReviewResponse, error)
+	ReviewList(context.Context, *ReviewFilterRequest) (*ReviewListResponse, error)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*emptypb.Empty, error)
+	ReplyReview(context.Context, *ReplyReviewRequest) (*emptypb.Empty, error)
+	GetGoodsRatings(context.Context, *GoodsRatingRequest) (*GoodsRatingListResponse, error)
+	mustEmbedUnimplementedUserOpServer()
+}
+
+// UnimplementedUserOpServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserOpServer struct{}
+
+func (UnimplementedUserOpServer) GetFavList(context.Context, *UserFavRequest) (*FavListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavList not implemented")
+}
+func (UnimplementedUserOpServer) AddUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserFav not implemented")
+}
+func (UnimplementedUserOpServer) DeleteUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserFav not implemented")
+}
+func (UnimplementedUserOpServer) GetUserFavDetail(context.Context, *UserFavRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFavDetail not implemented")
+}
+func (UnimplementedUserOpServer) IsFav(context.Context, *UserFavRequest) (*IsFavResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFav not implemented")
+}
+func (UnimplementedUserOpServer) GetAddressList(context.Context, *AddressRequest) (*AddressListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressList not implemented")
+}
+func (UnimplementedUserOpServer) CreateAddress(context.Context, *Address) (*AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddress not implemented")
+}
+func (UnimplementedUserOpServer) UpdateAddress(context.Context, *Address) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddress not implemented")
+}
+func (UnimplementedUserOpServer) DeleteAddress(context.Context, *AddressRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddress not implemented")
+}
+func (UnimplementedUserOpServer) CreateMessage(context.Context, *MessageRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMessage not implemented")
+}
+func (UnimplementedUserOpServer) MessageList(context.Context, *MessageRequest) (*MessageListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageList not implemented")
+}
+func (UnimplementedUserOpServer) CreateReview(context.Context, *ReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedUserOpServer) ReviewList(context.Context, *ReviewFilterRequest) (*ReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewList not implemented")
+}
+func (UnimplementedUserOpServer) ModerateReview(context.Context, *ModerateReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedUserOpServer) ReplyReview(context.Context, *ReplyReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+}
+func (UnimplementedUserOpServer) GetGoodsRatings(context.Context, *GoodsRatingRequest) (*GoodsRatingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsRatings not implemented")
+}
+func (UnimplementedUserOpServer) mustEmbedUnimplementedUserOpServer() {}
+func (UnimplementedUserOpServer) testEmbeddedByValue()                {}
+
+// UnsafeUserOpServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserOpServer will
+// result in compilation errors.
+type UnsafeUserOpServer interface {
+	mustEmbedUnimplementedUserOpServer()
+}
+
+func RegisterUserOpServer(s grpc.ServiceRegistrar, srv UserOpServer) {
+	// If the following call pancis, it indicates UnimplementedUserOpServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserOp_ServiceDesc, srv)
+}
+
+func _UserOp_GetFavList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).GetFavList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_GetFavList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).GetFavList(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_AddUserFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).AddUserFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_AddUserFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).AddUserFav(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_DeleteUserFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).DeleteUserFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_DeleteUserFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).DeleteUserFav(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_GetUserFavDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).GetUserFavDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_GetUserFavDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).GetUserFavDetail(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_IsFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).IsFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_IsFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).IsFav(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_GetAddressList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).GetAddressList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_GetAddressList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).GetAddressList(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).CreateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_CreateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).CreateAddress(ctx, req.(*Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_UpdateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).UpdateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_UpdateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).UpdateAddress(ctx, req.(*Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).DeleteAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_DeleteAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).DeleteAddress(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_CreateMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).CreateMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_CreateMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).CreateMessage(ctx, req.(*MessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_MessageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).MessageList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_MessageList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).MessageList(ctx, req.(*MessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).CreateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_ReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).ReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_ReviewList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).ReviewList(ctx, req.(*ReviewFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).ReplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_ReplyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).ReplyReview(ctx, req.(*ReplyReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_GetGoodsRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).GetGoodsRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_GetGoodsRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).GetGoodsRatings(ctx, req.(*GoodsRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserOp_ServiceDesc is the grpc.ServiceDesc for UserOp service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserOp_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "userop.UserOp",
+	HandlerType: (*UserOpServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFavList",
+			Handler:    _UserOp_GetFavList_Handler,
+		},
+		{
+			MethodName: "AddUserFav",
+			Handler:    _UserOp_AddUserFav_Handler,
+		},
+		{
+			MethodName: "DeleteUserFav",
+			Handler:    _UserOp_DeleteUserFav_Handler,
+		},
+		{
+			MethodName: "GetUserFavDetail",
+			Handler:    _UserOp_GetUserFavDetail_Handler,
+		},
+		{
+			MethodName: "IsFav",
+			Handler:    _UserOp_IsFav_Handler,
+		},
+		{
+			MethodName: "GetAddressList",
+			Handler:    _UserOp_GetAddressList_Handler,
+		},
+		{
+			MethodName: "CreateAddress",
+			Handler:    _UserOp_CreateAddress_Handler,
+		},
+		{
+			MethodName: "UpdateAddress",
+			Handler:    _UserOp_UpdateAddress_Handler,
+		},
+		{
+			MethodName: "DeleteAddress",
+			Handler:    _UserOp_DeleteAddress_Handler,
+		},
+		{
+			MethodName: "CreateMessage",
+			Handler:    _UserOp_CreateMessage_Handler,
+		},
+		{
+			MethodName: "MessageList",
+			Handler:    _UserOp_MessageList_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _UserOp_CreateReview_Handler,
+		},
+		{
+			MethodName: "ReviewList",
+			Handler:    _UserOp_ReviewList_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _UserOp_ModerateReview_Handler,
+		},
+		{
+			MethodName: "ReplyReview",
+			Handler:    _UserOp_ReplyReview_Handler,
+		},
+		{
+			MethodName: "GetGoodsRatings",
+			Handler:    _UserOp_GetGoodsRatings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "userop.proto",
+}
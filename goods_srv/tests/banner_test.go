@@ -3,10 +3,9 @@ package tests
 import (
 	"context"
 	"testing"
+	"time"
 
 	"goods_srv/proto"
-
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestBanner(t *testing.T) {
@@ -39,7 +38,7 @@ func TestBanner(t *testing.T) {
 
 	// 测试获取轮播图列表
 	t.Run("获取轮播图列表", func(t *testing.T) {
-		resp, err := goodsClient.BannerList(context.Background(), &emptypb.Empty{})
+		resp, err := goodsClient.BannerList(context.Background(), &proto.BannerFilterRequest{})
 		if err != nil {
 			t.Fatalf("获取轮播图列表失败: %v", err)
 		}
@@ -79,7 +78,7 @@ func TestBanner(t *testing.T) {
 		}
 
 		// 验证更新后的数据
-		listResp, err := goodsClient.BannerList(context.Background(), &emptypb.Empty{})
+		listResp, err := goodsClient.BannerList(context.Background(), &proto.BannerFilterRequest{})
 		if err != nil {
 			t.Fatalf("获取更新后的轮播图列表失败: %v", err)
 		}
@@ -105,6 +104,117 @@ func TestBanner(t *testing.T) {
 		}
 	})
 
+	// 测试投放位置和投放时间过滤
+	t.Run("轮播图投放过滤", func(t *testing.T) {
+		now := time.Now().Unix()
+		future, expired := now+3600, now-3600
+		disabled := false
+		placement := "category"
+		cases := []*proto.BannerRequest{
+			{Image: "http://example.com/future.jpg", Url: "http://example.com/future", StartTime: &future},
+			{Image: "http://example.com/expired.jpg", Url: "http://example.com/expired", EndTime: &expired},
+			{Image: "http://example.com/disabled.jpg", Url: "http://example.com/disabled", Enabled: &disabled},
+			{Image: "http://example.com/category.jpg", Url: "http://example.com/category", Placement: &placement},
+		}
+		hidden := make(map[int32]bool)
+		for _, req := range cases {
+			resp, err := goodsClient.CreateBanner(context.Background(), req)
+			if err != nil {
+				t.Fatalf("创建轮播图失败: %v", err)
+			}
+			hidden[resp.Id] = true
+			defer goodsClient.DeleteBanner(context.Background(), &proto.BannerRequest{Id: resp.Id})
+		}
+
+		listResp, err := goodsClient.BannerList(context.Background(), &proto.BannerFilterRequest{Placement: "home"})
+		if err != nil {
+			t.Fatalf("获取轮播图列表失败: %v", err)
+		}
+		for _, banner := range listResp.Data {
+			if hidden[banner.Id] {
+				t.Errorf("轮播图 %d 不应出现在首页当前投放列表中", banner.Id)
+			}
+		}
+
+		start := now + 10
+		_, err = goodsClient.CreateBanner(context.Background(), &proto.BannerRequest{
+			Image: "http://example.com/invalid.jpg", StartTime: &start, EndTime: &now,
+		})
+		if err == nil {
+			t.Error("结束时间早于开始时间时应返回错误")
+		}
+	})
+
+	// 测试更新时未传的投放字段保持不变
+	t.Run("部分更新轮播图", func(t *testing.T) {
+		future := time.Now().Unix() + 3600
+		resp, err := goodsClient.CreateBanner(context.Background(), &proto.BannerRequest{
+			Image: "http://example.com/partial.jpg", Url: "http://example.com/partial", StartTime: &future,
+		})
+		if err != nil {
+			t.Fatalf("创建轮播图失败: %v", err)
+		}
+		defer goodsClient.DeleteBanner(context.Background(), &proto.BannerRequest{Id: resp.Id})
+
+		listed := func() bool {
+			listResp, err := goodsClient.BannerList(context.Background(), &proto.BannerFilterRequest{Placement: "home"})
+			if err != nil {
+				t.Fatalf("获取轮播图列表失败: %v", err)
+			}
+			for _, banner := range listResp.Data {
+				if banner.Id == resp.Id {
+					return true
+				}
+			}
+			return false
+		}
+
+		// 只修改图片，未传开始时间，仍应未到投放时间
+		if _, err := goodsClient.UpdateBanner(context.Background(), &proto.BannerRequest{
+			Id: resp.Id, Image: "http://example.com/partial_updated.jpg", Url: "http://example.com/partial",
+		}); err != nil {
+			t.Fatalf("更新轮播图失败: %v", err)
+		}
+		if listed() {
+			t.Error("未传开始时间时不应清除原有的投放时间")
+		}
+
+		// 显式传0清除开始时间
+		var zero int64
+		if _, err := goodsClient.UpdateBanner(context.Background(), &proto.BannerRequest{
+			Id: resp.Id, Image: "http://example.com/partial_updated.jpg", Url: "http://example.com/partial", StartTime: &zero,
+		}); err != nil {
+			t.Fatalf("更新轮播图失败: %v", err)
+		}
+		if !listed() {
+			t.Error("清除开始时间后轮播图应立即投放")
+		}
+
+		past := time.Now().Unix() - 60
+		if _, err := goodsClient.UpdateBanner(context.Background(), &proto.BannerRequest{
+			Id: resp.Id, Image: "http://example.com/partial_updated.jpg", Url: "http://example.com/partial", EndTime: &past, StartTime: &future,
+		}); err == nil {
+			t.Error("结束时间早于开始时间时应返回错误")
+		}
+	})
+
+	// 测试点击统计
+	t.Run("记录轮播图点击", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			if _, err := goodsClient.RecordBannerClick(context.Background(), &proto.BannerClickRequest{Id: bannerId}); err != nil {
+				t.Fatalf("记录轮播图点击失败: %v", err)
+			}
+		}
+
+		statsResp, err := goodsClient.BannerClickStats(context.Background(), &proto.BannerClickStatsRequest{Ids: []int32{bannerId}})
+		if err != nil {
+			t.Fatalf("获取轮播图点击统计失败: %v", err)
+		}
+		if len(statsResp.Data) != 1 || statsResp.Data[0].ClickNum < 3 {
+			t.Errorf("期望轮播图点击数至少为3, 实际为 %v", statsResp.Data)
+		}
+	})
+
 	// 测试删除轮播图
 	t.Run("删除轮播图", func(t *testing.T) {
 		req := &proto.BannerRequest{
@@ -117,7 +227,7 @@ func TestBanner(t *testing.T) {
 		}
 
 		// 验证删除后的数据
-		listResp, err := goodsClient.BannerList(context.Background(), &emptypb.Empty{})
+		listResp, err := goodsClient.BannerList(context.Background(), &proto.BannerFilterRequest{})
 		if err != nil {
 			t.Fatalf("获取删除后的轮播图列表失败: %v", err)
 		}
@@ -2,8 +2,11 @@ package tests
 
 import (
 	"context"
+	"fmt"
 	"goods_srv/proto"
+	"strings"
 	"testing"
+	"time"
 )
 
 // 测试品牌列表
@@ -248,3 +251,56 @@ func TestBrandFullProcess(t *testing.T) {
 		t.Logf("删除品牌成功 - ID: %d", brandId)
 	})
 }
+
+// 测试品牌名称不区分大小写唯一
+func TestBrandNameCaseInsensitive(t *testing.T) {
+	name := fmt.Sprintf("CaseBrand%d", time.Now().UnixNano())
+	createRsp, err := goodsClient.CreateBrand(context.Background(), &proto.BrandRequest{Name: name})
+	if err != nil {
+		t.Fatalf("创建品牌失败: %v", err)
+	}
+	defer goodsClient.DeleteBrand(context.Background(), &proto.BrandRequest{Id: createRsp.Id})
+
+	_, err = goodsClient.CreateBrand(context.Background(), &proto.BrandRequest{Name: strings.ToUpper(name)})
+	if err == nil {
+		t.Error("仅大小写不同的品牌名称应创建失败")
+	}
+}
+
+// 测试品牌合并
+func TestMergeBrand(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	source, err := goodsClient.CreateBrand(context.Background(), &proto.BrandRequest{Name: fmt.Sprintf("MergeSource%d", suffix)})
+	if err != nil {
+		t.Fatalf("创建源品牌失败: %v", err)
+	}
+	target, err := goodsClient.CreateBrand(context.Background(), &proto.BrandRequest{Name: fmt.Sprintf("MergeTarget%d", suffix)})
+	if err != nil {
+		t.Fatalf("创建目标品牌失败: %v", err)
+	}
+	defer goodsClient.DeleteBrand(context.Background(), &proto.BrandRequest{Id: target.Id})
+
+	if _, err := goodsClient.MergeBrand(context.Background(), &proto.MergeBrandRequest{SourceId: source.Id, TargetId: source.Id}); err == nil {
+		t.Error("源品牌和目标品牌相同时应返回错误")
+	}
+
+	if _, err := goodsClient.MergeBrand(context.Background(), &proto.MergeBrandRequest{SourceId: source.Id, TargetId: target.Id}); err != nil {
+		t.Fatalf("合并品牌失败: %v", err)
+	}
+
+	rsp, err := goodsClient.BrandList(context.Background(), &proto.BrandFilterRequest{
+		Pages:       1,
+		PagePerNums: 10,
+		Name:        fmt.Sprintf("Merge%%%d", suffix),
+		SortBy:      "goods_num",
+		SortDesc:    true,
+	})
+	if err != nil {
+		t.Fatalf("获取品牌列表失败: %v", err)
+	}
+	for _, brand := range rsp.Data {
+		if brand.Id == source.Id {
+			t.Error("合并后源品牌应被删除")
+		}
+	}
+}
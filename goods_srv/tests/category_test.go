@@ -99,3 +99,33 @@ func TestCategory(t *testing.T) {
 		}
 	})
 }
+
+// 删除分类属性后可以重新创建同名属性
+func TestCategoryAttributeRecreate(t *testing.T) {
+	category, err := goodsClient.CreateCategory(context.Background(), &proto.CategoryInfoRequest{
+		Name:  "属性测试分类",
+		Level: 1,
+	})
+	if err != nil {
+		t.Fatalf("创建分类失败: %v", err)
+	}
+	defer goodsClient.DeleteCategory(context.Background(), &proto.DeleteCategoryRequest{Id: category.Id})
+
+	req := &proto.CategoryAttributeRequest{CategoryId: category.Id, Name: "颜色", Type: "text"}
+	attr, err := goodsClient.CreateCategoryAttribute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("创建分类属性失败: %v", err)
+	}
+	if _, err := goodsClient.CreateCategoryAttribute(context.Background(), req); err == nil {
+		t.Error("同一分类下属性名称重复时应返回错误")
+	}
+	if _, err := goodsClient.DeleteCategoryAttribute(context.Background(), &proto.CategoryAttributeRequest{Id: attr.Id}); err != nil {
+		t.Fatalf("删除分类属性失败: %v", err)
+	}
+
+	recreated, err := goodsClient.CreateCategoryAttribute(context.Background(), req)
+	if err != nil {
+		t.Fatalf("删除后重新创建同名属性失败: %v", err)
+	}
+	goodsClient.DeleteCategoryAttribute(context.Background(), &proto.CategoryAttributeRequest{Id: recreated.Id})
+}
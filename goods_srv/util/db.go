@@ -25,6 +25,9 @@ func SetupTestTables() error {
 		&model.Goods{},
 		&model.CategoryBrand{},
 		&model.Banner{},
+		&model.BannerClick{},
+		&model.CategoryAttribute{},
+		&model.GoodsAttributeValue{},
 	)
 }
 
@@ -37,6 +40,9 @@ func CleanTestTables() error {
 		&model.Category{},
 		&model.Brand{},
 		&model.Banner{},
+		&model.BannerClick{},
+		&model.CategoryAttribute{},
+		&model.GoodsAttributeValue{},
 	}
 	for _, table := range tables {
 		if err := global.DB.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(table).Error; err != nil {
@@ -72,5 +78,8 @@ func DropAllTables() error {
 		&model.Category{},
 		&model.Brand{},
 		&model.Banner{},
+		&model.BannerClick{},
+		&model.CategoryAttribute{},
+		&model.GoodsAttributeValue{},
 	)
 }
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type InventoryServer struct {
@@ -79,6 +80,37 @@ func (s *InventoryServer) GetInventory(ctx context.Context, req *proto.GoodsInvI
 	}, nil
 }
 
+// BatchGetInventory 批量获取库存，结果按请求的商品ID顺序返回，重复的ID只返回一次
+func (s *InventoryServer) BatchGetInventory(ctx context.Context, req *proto.BatchInvRequest) (*proto.BatchInvResponse, error) {
+	if len(req.GoodsIds) == 0 {
+		return &proto.BatchInvResponse{}, nil
+	}
+
+	var invs []model.Inventory
+	if err := global.DB.Where("goods_id IN ?", req.GoodsIds).Order("id").Find(&invs).Error; err != nil {
+		zap.S().Errorf("批量查询库存记录失败: %v", err)
+		return nil, status.Error(codes.Internal, "查询库存记录失败")
+	}
+	// 同一商品有多条记录时与 GetInventory 一致取ID最小的一条
+	stocks := make(map[int32]int32, len(invs))
+	for _, inv := range invs {
+		if _, ok := stocks[inv.GoodsID]; !ok {
+			stocks[inv.GoodsID] = inv.Stock
+		}
+	}
+
+	rsp := &proto.BatchInvResponse{Data: make([]*proto.GoodsInvInfo, 0, len(req.GoodsIds))}
+	seen := make(map[int32]bool, len(req.GoodsIds))
+	for _, goodsId := range req.GoodsIds {
+		if seen[goodsId] {
+			continue
+		}
+		seen[goodsId] = true
+		rsp.Data = append(rsp.Data, &proto.GoodsInvInfo{GoodsId: goodsId, Num: stocks[goodsId]})
+	}
+	return rsp, nil
+}
+
 
 // Sell 库存扣减 - 改进的Redis分布式锁实现
 func (s *InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
@@ -195,7 +227,7 @@ func (s *InventoryServer) Reback(ctx context.Context, req *proto.SellInfo) (*emp
 		return nil, status.Error(codes.InvalidArgument, "商品信息不能为空")
 	}
 
-	zap.S().Infof("开始归还库存，商品数量: %d", len(req.GoodsInvInfo))
+	zap.S().Infof("开始归还库存，商品数量: %d，归还键: %s", len(req.GoodsInvInfo), req.RebackKey)
 
 	// 提取所有商品ID
 	goodsIds := make([]int32, len(req.GoodsInvInfo))
@@ -230,6 +262,21 @@ func (s *InventoryServer) Reback(ctx context.Context, req *proto.SellInfo) (*emp
 		}
 	}()
 
+	// 归还记录与库存在同一事务中写入，相同归还键已归还过时直接返回成功，调用方重试不会重复归还
+	if req.RebackKey != "" {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.InventoryReback{RebackKey: req.RebackKey})
+		if result.Error != nil {
+			tx.Rollback()
+			zap.S().Errorf("写入库存归还记录失败: %v", result.Error)
+			return nil, status.Error(codes.Internal, "归还库存失败")
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			zap.S().Infof("库存已按该归还键归还过，跳过，归还键: %s", req.RebackKey)
+			return &emptypb.Empty{}, nil
+		}
+	}
+
 	// 查询并归还库存
 	for _, goodsInfo := range req.GoodsInvInfo {
 		var inv model.Inventory
@@ -20,6 +20,12 @@ type Inventory struct {
 	Version int32 `json:"version" gorm:"type:int;not null;default:0;comment:版本号"` // 分布式锁使用的版本号（乐观锁）
 }
 
+// InventoryReback 库存归还记录，调用方至少一次投递归还请求时按归还键去重，相同键只归还一次
+type InventoryReback struct {
+	BaseModel
+	RebackKey string `json:"reback_key" gorm:"type:varchar(100);not null;uniqueIndex;comment:归还幂等键"`
+}
+
 // 哪个用户，哪个商品，哪个订单
 type InventoryHistory struct {
 	user  int32
@@ -79,9 +79,10 @@ func setupTestDB(t *testing.T) {
 	global.DB = db
 
 	// 在测试开始前检查表是否存在
-	if !db.Migrator().HasTable(&Inventory{}) {
+	if !db.Migrator().HasTable(&Inventory{}) || !db.Migrator().HasTable(&InventoryReback{}) {
 		if err := db.AutoMigrate(
 			&Inventory{},
+			&InventoryReback{},
 		); err != nil {
 			t.Fatalf("自动迁移表结构失败: %v", err)
 		}
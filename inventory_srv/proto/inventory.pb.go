@@ -74,16 +74,105 @@ func (x *GoodsInvInfo) GetNum() int32 {
 	return 0
 }
 
+type BatchInvRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsIds      []int32                `protobuf:"varint,1,rep,packed,name=goodsIds,proto3" json:"goodsIds,omitempty"` // 商品ID列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchInvRequest) Reset() {
+	*x = BatchInvRequest{}
+	mi := &file_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchInvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchInvRequest) ProtoMessage() {}
+
+func (x *BatchInvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchInvRequest.ProtoReflect.Descriptor instead.
+func (*BatchInvRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchInvRequest) GetGoodsIds() []int32 {
+	if x != nil {
+		return x.GoodsIds
+	}
+	return nil
+}
+
+type BatchInvResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*GoodsInvInfo        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"` // 商品库存信息，没有库存记录的商品库存为0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchInvResponse) Reset() {
+	*x = BatchInvResponse{}
+	mi := &file_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchInvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchInvResponse) ProtoMessage() {}
+
+func (x *BatchInvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchInvResponse.ProtoReflect.Descriptor instead.
+func (*BatchInvResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchInvResponse) GetData() []*GoodsInvInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type SellInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GoodsInvInfo  []*GoodsInvInfo        `protobuf:"bytes,1,rep,name=goodsInvInfo,proto3" json:"goodsInvInfo,omitempty"` // 商品库存信息
+	RebackKey     string                 `protobuf:"bytes,2,opt,name=rebackKey,proto3" json:"rebackKey,omitempty"`       // 归还幂等键，仅归还库存使用，相同键只归还一次，为空时不去重
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SellInfo) Reset() {
 	*x = SellInfo{}
-	mi := &file_inventory_proto_msgTypes[1]
+	mi := &file_inventory_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +184,7 @@ func (x *SellInfo) String() string {
 func (*SellInfo) ProtoMessage() {}
 
 func (x *SellInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_proto_msgTypes[1]
+	mi := &file_inventory_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +197,7 @@ func (x *SellInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SellInfo.ProtoReflect.Descriptor instead.
 func (*SellInfo) Descriptor() ([]byte, []int) {
-	return file_inventory_proto_rawDescGZIP(), []int{1}
+	return file_inventory_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SellInfo) GetGoodsInvInfo() []*GoodsInvInfo {
@@ -118,6 +207,13 @@ func (x *SellInfo) GetGoodsInvInfo() []*GoodsInvInfo {
 	return nil
 }
 
+func (x *SellInfo) GetRebackKey() string {
+	if x != nil {
+		return x.RebackKey
+	}
+	return ""
+}
+
 var File_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_proto_rawDesc = "" +
@@ -125,12 +221,18 @@ const file_inventory_proto_rawDesc = "" +
 	"\x0finventory.proto\x1a\x1bgoogle/protobuf/empty.proto\":\n" +
 	"\fGoodsInvInfo\x12\x18\n" +
 	"\agoodsId\x18\x01 \x01(\x05R\agoodsId\x12\x10\n" +
-	"\x03num\x18\x02 \x01(\x05R\x03num\"=\n" +
+	"\x03num\x18\x02 \x01(\x05R\x03num\"-\n" +
+	"\x0fBatchInvRequest\x12\x1a\n" +
+	"\bgoodsIds\x18\x01 \x03(\x05R\bgoodsIds\"5\n" +
+	"\x10BatchInvResponse\x12!\n" +
+	"\x04data\x18\x01 \x03(\v2\r.GoodsInvInfoR\x04data\"[\n" +
 	"\bSellInfo\x121\n" +
-	"\fgoodsInvInfo\x18\x01 \x03(\v2\r.GoodsInvInfoR\fgoodsInvInfo2\xcf\x01\n" +
+	"\fgoodsInvInfo\x18\x01 \x03(\v2\r.GoodsInvInfoR\fgoodsInvInfo\x12\x1c\n" +
+	"\trebackKey\x18\x02 \x01(\tR\trebackKey2\x89\x02\n" +
 	"\x10InventoryService\x125\n" +
 	"\fSetInventory\x12\r.GoodsInvInfo\x1a\x16.google.protobuf.Empty\x12,\n" +
-	"\fGetInventory\x12\r.GoodsInvInfo\x1a\r.GoodsInvInfo\x12)\n" +
+	"\fGetInventory\x12\r.GoodsInvInfo\x1a\r.GoodsInvInfo\x128\n" +
+	"\x11BatchGetInventory\x12\x10.BatchInvRequest\x1a\x11.BatchInvResponse\x12)\n" +
 	"\x04Sell\x12\t.SellInfo\x1a\x16.google.protobuf.Empty\x12+\n" +
 	"\x06Reback\x12\t.SellInfo\x1a\x16.google.protobuf.EmptyB\tZ\a.;protob\x06proto3"
 
@@ -146,27 +248,32 @@ func file_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_proto_rawDescData
 }
 
-var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_inventory_proto_goTypes = []any{
-	(*GoodsInvInfo)(nil),  // 0: GoodsInvInfo
-	(*SellInfo)(nil),      // 1: SellInfo
-	(*emptypb.Empty)(nil), // 2: google.protobuf.Empty
+	(*GoodsInvInfo)(nil),     // 0: GoodsInvInfo
+	(*BatchInvRequest)(nil),  // 1: BatchInvRequest
+	(*BatchInvResponse)(nil), // 2: BatchInvResponse
+	(*SellInfo)(nil),         // 3: SellInfo
+	(*emptypb.Empty)(nil),    // 4: google.protobuf.Empty
 }
 var file_inventory_proto_depIdxs = []int32{
-	0, // 0: SellInfo.goodsInvInfo:type_name -> GoodsInvInfo
-	0, // 1: InventoryService.SetInventory:input_type -> GoodsInvInfo
-	0, // 2: InventoryService.GetInventory:input_type -> GoodsInvInfo
-	1, // 3: InventoryService.Sell:input_type -> SellInfo
-	1, // 4: InventoryService.Reback:input_type -> SellInfo
-	2, // 5: InventoryService.SetInventory:output_type -> google.protobuf.Empty
-	0, // 6: InventoryService.GetInventory:output_type -> GoodsInvInfo
-	2, // 7: InventoryService.Sell:output_type -> google.protobuf.Empty
-	2, // 8: InventoryService.Reback:output_type -> google.protobuf.Empty
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: BatchInvResponse.data:type_name -> GoodsInvInfo
+	0, // 1: SellInfo.goodsInvInfo:type_name -> GoodsInvInfo
+	0, // 2: InventoryService.SetInventory:input_type -> GoodsInvInfo
+	0, // 3: InventoryService.GetInventory:input_type -> GoodsInvInfo
+	1, // 4: InventoryService.BatchGetInventory:input_type -> BatchInvRequest
+	3, // 5: InventoryService.Sell:input_type -> SellInfo
+	3, // 6: InventoryService.Reback:input_type -> SellInfo
+	4, // 7: InventoryService.SetInventory:output_type -> google.protobuf.Empty
+	0, // 8: InventoryService.GetInventory:output_type -> GoodsInvInfo
+	2, // 9: InventoryService.BatchGetInventory:output_type -> BatchInvResponse
+	4, // 10: InventoryService.Sell:output_type -> google.protobuf.Empty
+	4, // 11: InventoryService.Reback:output_type -> google.protobuf.Empty
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_inventory_proto_init() }
@@ -180,7 +287,7 @@ func file_inventory_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_proto_rawDesc), len(file_inventory_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
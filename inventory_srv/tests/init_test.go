@@ -92,8 +92,8 @@ func initTestEnv(t *testing.T) {
 	global.DB = db
 
 	// 在测试开始前检查表是否存在
-	if !db.Migrator().HasTable(&model.Inventory{}) {
-		if err := db.AutoMigrate(&model.Inventory{}); err != nil {
+	if !db.Migrator().HasTable(&model.Inventory{}) || !db.Migrator().HasTable(&model.InventoryReback{}) {
+		if err := db.AutoMigrate(&model.Inventory{}, &model.InventoryReback{}); err != nil {
 			t.Fatalf("自动迁移表结构失败: %v", err)
 		}
 	}
@@ -10,7 +10,9 @@ package tests
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"inventory_srv/global"
 	"inventory_srv/handler"
@@ -92,6 +94,44 @@ func TestGetInventory(t *testing.T) {
 	})
 }
 
+// TestBatchGetInventory 测试批量获取库存
+func TestBatchGetInventory(t *testing.T) {
+	// 初始化测试环境
+	initTestEnv(t)
+
+	// 创建服务实例
+	server := &handler.InventoryServer{}
+
+	testInv := TestInventories[3] // UNIQLO库存
+	goodsID, stock := testInv.GoodsID, testInv.Stock
+	missingID := int32(999999) // 没有库存记录的商品
+
+	// 先设置库存
+	_, err := server.SetInventory(context.Background(), &proto.GoodsInvInfo{GoodsId: goodsID, Num: stock})
+	assert.NoError(t, err)
+
+	t.Run("BatchGetInventory", func(t *testing.T) {
+		req := &proto.BatchInvRequest{
+			GoodsIds: []int32{missingID, goodsID, missingID},
+		}
+
+		// 调用服务，重复的ID只返回一次，顺序与请求一致
+		resp, err := server.BatchGetInventory(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Len(t, resp.Data, 2)
+		assert.Equal(t, missingID, resp.Data[0].GoodsId)
+		assert.Equal(t, int32(0), resp.Data[0].Num)
+		assert.Equal(t, goodsID, resp.Data[1].GoodsId)
+		assert.Equal(t, stock, resp.Data[1].Num)
+	})
+
+	t.Run("EmptyRequest", func(t *testing.T) {
+		resp, err := server.BatchGetInventory(context.Background(), &proto.BatchInvRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Data)
+	})
+}
+
 // TestSell 测试库存扣减
 func TestSell(t *testing.T) {
 	t.Log(TestScenarios.SellInventory)
@@ -188,6 +228,27 @@ func TestReback(t *testing.T) {
 		t.Logf("归还库存成功 - 商品ID: %d (%s), 原库存: %d, 归还: %d, 新库存: %d", 
 			goodsID, testInv.GoodsName, stock, rebackNum, updatedInv.Stock)
 	})
+
+	// 相同归还键重复归还只生效一次
+	t.Run("RebackKey", func(t *testing.T) {
+		rebackKey := fmt.Sprintf("test_reback:%d", time.Now().UnixNano())
+		defer global.DB.Unscoped().Where("reback_key = ?", rebackKey).Delete(&model.InventoryReback{})
+		req := &proto.SellInfo{
+			GoodsInvInfo: []*proto.GoodsInvInfo{{GoodsId: goodsID, Num: rebackNum}},
+			RebackKey:    rebackKey,
+		}
+
+		var before model.Inventory
+		assert.NoError(t, global.DB.Where("goods_id = ?", goodsID).First(&before).Error)
+		for i := 0; i < 3; i++ {
+			_, err := server.Reback(context.Background(), req)
+			assert.NoError(t, err)
+		}
+
+		var after model.Inventory
+		assert.NoError(t, global.DB.Where("goods_id = ?", goodsID).First(&after).Error)
+		assert.Equal(t, before.Stock+rebackNum, after.Stock)
+	})
 }
 
 // TestConcurrentSellAndReback 测试并发扣减和归还
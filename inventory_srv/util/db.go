@@ -21,6 +21,7 @@ func SetupTestTables() error {
 	zap.S().Info("检查并自动迁移测试数据库表结构...")
 	return global.DB.AutoMigrate(
 		&model.Inventory{},
+		&model.InventoryReback{},
 	)
 }
 
@@ -29,6 +30,7 @@ func CleanTestTables() error {
 	zap.S().Info("清空所有测试表数据...")
 	tables := []interface{}{
 		&model.Inventory{},
+		&model.InventoryReback{},
 	}
 	for _, table := range tables {
 		if err := global.DB.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(table).Error; err != nil {
@@ -57,5 +59,6 @@ func DropAllTables() error {
 	zap.S().Info("删除所有测试表结构...")
 	return global.DB.Migrator().DropTable(
 		&model.Inventory{},
+		&model.InventoryReback{},
 	)
 }
@@ -35,6 +35,96 @@ type ServerConfig struct {
 		Host string `mapstructure:"host"`
 		Port int    `mapstructure:"port"`
 	} `mapstructure:"redis"`
+
+	Payment  PaymentConfig  `mapstructure:"payment"`
+	Shipping ShippingConfig `mapstructure:"shipping"`
+	Cart     CartConfig     `mapstructure:"cart"`
+	Split    SplitConfig    `mapstructure:"split"`
+	Delivery DeliveryConfig `mapstructure:"delivery"`
+	IdGen    IdGenConfig    `mapstructure:"id_gen"`
+	Report   ReportConfig   `mapstructure:"report"`
+	UserSrv  UserSrvConfig  `mapstructure:"user_srv"`
+}
+
+// UserSrvConfig 下单时校验用户的配置
+type UserSrvConfig struct {
+	FailOpen bool `mapstructure:"fail_open"` // 用户服务不可用时是否放行，默认拒绝下单；仅建议在开发环境开启
+	CacheTTL int  `mapstructure:"cache_ttl"` // 校验结果在本地缓存的时长（秒），0表示使用默认值
+	Timeout  int  `mapstructure:"timeout"`   // 调用用户服务的超时时间（毫秒），0表示使用默认值
+}
+
+// ReportConfig 报表汇总配置
+type ReportConfig struct {
+	RefreshInterval int `mapstructure:"refresh_interval"` // 汇总表刷新间隔（秒）
+	RefreshDays     int `mapstructure:"refresh_days"`     // 每次重算最近多少天的汇总，需覆盖订单状态可能变化的时长（如售后期）
+}
+
+// IdGenConfig 订单号生成配置
+type IdGenConfig struct {
+	WorkerSource string `mapstructure:"worker_source"` // 机器号分配方式：redis、consul
+	WorkerTTL    int    `mapstructure:"worker_ttl"`    // 机器号租约时长（秒），实例异常退出后经过该时长机器号才能被重新分配
+}
+
+// DeliveryConfig 发货和收货配置
+type DeliveryConfig struct {
+	AutoConfirmDays int `mapstructure:"auto_confirm_days"` // 全部发货后超过该天数自动确认收货，0表示使用默认值
+}
+
+// SplitConfig 拆单配置，商品服务未提供仓库和商家信息，按配置的映射查找，未配置的归入0号仓库或平台自营
+type SplitConfig struct {
+	Key        string           `mapstructure:"key"`        // 拆单键：none(不拆单)、brand(品牌)、warehouse(仓库)、merchant(商家)
+	Warehouses map[string]int32 `mapstructure:"warehouses"` // 商品ID -> 发货仓库ID
+	Merchants  map[string]int32 `mapstructure:"merchants"`  // 品牌ID -> 商家ID
+}
+
+// CartConfig 购物车配置
+type CartConfig struct {
+	GuestTTL        int    `mapstructure:"guest_ttl"`          // 游客购物车保留时长（秒），每次修改后重新计时
+	MergeStrategy   string `mapstructure:"merge_strategy"`     // 登录合并时同一商品的数量规则：sum(相加)、max(取较大值)、user(保留用户购物车)、guest(以游客购物车为准)
+	MaxNumsPerGoods int32  `mapstructure:"max_nums_per_goods"` // 单个商品最大数量，0表示不限制
+}
+
+// ShippingConfig 运费配置，金额单位为分，商品包邮(ShipFree)时不计入运费
+type ShippingConfig struct {
+	Fee           int64 `mapstructure:"fee"`            // 订单中有不包邮商品时收取的运费
+	FreeThreshold int64 `mapstructure:"free_threshold"` // 不包邮商品优惠后满该金额免运费，0表示不设门槛
+}
+
+// PaymentConfig 支付渠道配置，未启用的渠道不会注册
+type PaymentConfig struct {
+	Mock   MockPayConfig `mapstructure:"mock"`
+	Alipay AlipayConfig  `mapstructure:"alipay"`
+	Wechat WechatConfig  `mapstructure:"wechat"`
+}
+
+// MockPayConfig 本地模拟支付配置，仅用于开发和测试环境
+type MockPayConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Secret  string `mapstructure:"secret"` // 回调签名密钥
+}
+
+// AlipayConfig 支付宝开放平台配置
+type AlipayConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	AppId      string `mapstructure:"app_id"`
+	PrivateKey string `mapstructure:"private_key"` // 应用私钥，PEM或base64格式
+	PublicKey  string `mapstructure:"public_key"`  // 支付宝公钥，PEM或base64格式
+	Gateway    string `mapstructure:"gateway"`     // 为空时使用正式环境网关
+	NotifyUrl  string `mapstructure:"notify_url"`
+	ReturnUrl  string `mapstructure:"return_url"`
+}
+
+// WechatConfig 微信支付APIv3配置
+type WechatConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`
+	AppId        string `mapstructure:"app_id"`
+	MchId        string `mapstructure:"mch_id"`
+	SerialNo     string `mapstructure:"serial_no"`     // 商户API证书序列号
+	PrivateKey   string `mapstructure:"private_key"`   // 商户API私钥，PEM格式
+	ApiV3Key     string `mapstructure:"api_v3_key"`    // APIv3密钥，用于解密回调
+	PlatformCert string `mapstructure:"platform_cert"` // 微信支付平台证书，用于验签
+	BaseUrl      string `mapstructure:"base_url"`      // 为空时使用正式环境地址
+	NotifyUrl    string `mapstructure:"notify_url"`
 }
 
 // NacosConfig 是 Nacos 配置的结构体
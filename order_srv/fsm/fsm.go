@@ -0,0 +1,153 @@
+// Package fsm 有限状态机，订单等业务对象的状态流转统一通过状态机执行
+package fsm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidTransition 当前状态不能响应该事件
+var ErrInvalidTransition = errors.New("无效的状态转换")
+
+// State 状态
+type State string
+
+// Event 触发状态流转的事件
+type Event string
+
+// Transition 状态流转规则，From中的任一状态收到Event后流转到To
+type Transition struct {
+	Event Event
+	From  []State
+	To    State
+}
+
+// Context 一次状态流转的上下文
+type Context struct {
+	Event  Event
+	From   State
+	To     State
+	Actor  string // 操作人，如 user:12、admin、system
+	Reason string // 流转原因
+	// Updates 随状态一起持久化的字段，前置钩子可以追加，如支付时间
+	Updates map[string]interface{}
+	// Payload 业务对象，供守卫和钩子使用
+	Payload interface{}
+}
+
+// Guard 守卫，返回错误时拒绝本次流转
+type Guard func(ctx context.Context, c *Context) error
+
+// Hook 钩子，返回错误时本次流转失败，调用方应回滚事务
+type Hook func(ctx context.Context, c *Context) error
+
+// Machine 状态机，规则和钩子在启动时注册，之后只读，可以并发使用
+type Machine struct {
+	transitions []Transition
+	guards      map[Event][]Guard
+	beforeEnter map[State][]Hook
+	afterEnter  map[State][]Hook
+}
+
+// New 创建状态机，同一状态的同一事件只能有一条规则
+func New(transitions ...Transition) *Machine {
+	m := &Machine{
+		guards:      make(map[Event][]Guard),
+		beforeEnter: make(map[State][]Hook),
+		afterEnter:  make(map[State][]Hook),
+	}
+	for _, t := range transitions {
+		for _, from := range t.From {
+			if _, ok := m.Target(from, t.Event); ok {
+				panic(fmt.Sprintf("fsm: 重复的状态流转规则 %s --%s-->", from, t.Event))
+			}
+		}
+		m.transitions = append(m.transitions, t)
+	}
+	return m
+}
+
+// Target 返回from状态收到event后的目标状态
+func (m *Machine) Target(from State, event Event) (State, bool) {
+	for _, t := range m.transitions {
+		if t.Event != event {
+			continue
+		}
+		for _, s := range t.From {
+			if s == from {
+				return t.To, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Can 判断from状态能否响应event
+func (m *Machine) Can(from State, event Event) bool {
+	_, ok := m.Target(from, event)
+	return ok
+}
+
+// EventTo 查找从from流转到to的事件，有多个事件时返回先注册的
+func (m *Machine) EventTo(from, to State) (Event, bool) {
+	for _, t := range m.transitions {
+		if t.To != to {
+			continue
+		}
+		for _, s := range t.From {
+			if s == from {
+				return t.Event, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Guard 注册事件守卫
+func (m *Machine) Guard(event Event, g Guard) {
+	m.guards[event] = append(m.guards[event], g)
+}
+
+// BeforeEnter 注册进入状态前的钩子，在持久化之前执行，可以修改Updates
+func (m *Machine) BeforeEnter(state State, h Hook) {
+	m.beforeEnter[state] = append(m.beforeEnter[state], h)
+}
+
+// AfterEnter 注册进入状态后的钩子，在持久化之后执行，如归还库存
+func (m *Machine) AfterEnter(state State, h Hook) {
+	m.afterEnter[state] = append(m.afterEnter[state], h)
+}
+
+// Fire 执行一次状态流转：校验规则、执行守卫和前置钩子、调用persist持久化、执行后置钩子
+// 任一步骤失败都直接返回错误，persist和钩子应在同一个事务中执行，由调用方负责回滚
+func (m *Machine) Fire(ctx context.Context, c *Context, persist func(ctx context.Context, c *Context) error) error {
+	to, ok := m.Target(c.From, c.Event)
+	if !ok {
+		return fmt.Errorf("%w: %s --%s-->", ErrInvalidTransition, c.From, c.Event)
+	}
+	c.To = to
+	if c.Updates == nil {
+		c.Updates = make(map[string]interface{})
+	}
+
+	for _, g := range m.guards[c.Event] {
+		if err := g(ctx, c); err != nil {
+			return err
+		}
+	}
+	for _, h := range m.beforeEnter[to] {
+		if err := h(ctx, c); err != nil {
+			return err
+		}
+	}
+	if err := persist(ctx, c); err != nil {
+		return err
+	}
+	for _, h := range m.afterEnter[to] {
+		if err := h(ctx, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// TestOrderTransitions 测试订单状态流转规则
+func TestOrderTransitions(t *testing.T) {
+	m := NewOrderMachine()
+	cases := []struct {
+		from  State
+		event Event
+		to    State
+		ok    bool
+	}{
+		{OrderWaitBuyerPay, EventStartPay, OrderPaying, true},
+		{OrderPaying, EventPaySuccess, OrderTradeSuccess, true},
+		{OrderPaying, EventTimeout, OrderTradeClosed, true},
+		{OrderTradeSuccess, EventFinish, OrderTradeFinished, true},
+		{OrderTradeSuccess, EventShipPart, OrderPartShipped, true},
+		{OrderPartShipped, EventShip, OrderShipped, true},
+		{OrderShipped, EventConfirm, OrderTradeFinished, true},
+		{OrderShipped, EventRefund, OrderTradeRefunded, true},
+		{OrderTradeSuccess, EventConfirm, "", false},
+		{OrderPartShipped, EventShipPart, "", false},
+		{OrderTradeFinished, EventRefund, OrderTradeRefunded, true},
+		{OrderTradeClosed, EventPaySuccess, "", false},
+		{OrderTradeRefunded, EventFinish, "", false},
+		{OrderTradeSuccess, EventCancel, "", false},
+	}
+	for _, c := range cases {
+		to, ok := m.Target(c.from, c.event)
+		if ok != c.ok || to != c.to {
+			t.Errorf("%s --%s--> 期望 %s(%v)，实际 %s(%v)", c.from, c.event, c.to, c.ok, to, ok)
+		}
+	}
+
+	if event, ok := m.EventTo(OrderPaying, OrderTradeClosed); !ok || event != EventCancel {
+		t.Errorf("PAYING -> TRADE_CLOSED 应对应取消事件，实际: %s", event)
+	}
+	if _, ok := m.EventTo(OrderTradeClosed, OrderPaying); ok {
+		t.Error("TRADE_CLOSED -> PAYING 不应存在对应事件")
+	}
+}
+
+// TestFire 测试守卫、钩子和持久化的执行顺序
+func TestFire(t *testing.T) {
+	ctx := context.Background()
+	m := NewOrderMachine()
+	var steps []string
+	errDenied := errors.New("denied")
+
+	m.Guard(EventPaySuccess, func(ctx context.Context, c *Context) error {
+		steps = append(steps, "guard")
+		if c.Actor == "" {
+			return errDenied
+		}
+		return nil
+	})
+	m.BeforeEnter(OrderTradeSuccess, func(ctx context.Context, c *Context) error {
+		steps = append(steps, "before")
+		c.Updates["pay_time"] = "now"
+		return nil
+	})
+	m.AfterEnter(OrderTradeSuccess, func(ctx context.Context, c *Context) error {
+		steps = append(steps, "after")
+		return nil
+	})
+	persist := func(ctx context.Context, c *Context) error {
+		steps = append(steps, "persist")
+		if c.Updates["pay_time"] != "now" {
+			t.Error("前置钩子追加的字段未传给持久化")
+		}
+		return nil
+	}
+
+	c := &Context{Event: EventPaySuccess, From: OrderPaying, Actor: "system"}
+	if err := m.Fire(ctx, c, persist); err != nil {
+		t.Fatalf("状态流转失败: %v", err)
+	}
+	if c.To != OrderTradeSuccess {
+		t.Errorf("目标状态错误: %s", c.To)
+	}
+	if got := len(steps); got != 4 || steps[0] != "guard" || steps[1] != "before" || steps[2] != "persist" || steps[3] != "after" {
+		t.Errorf("执行顺序错误: %v", steps)
+	}
+
+	steps = nil
+	if err := m.Fire(ctx, &Context{Event: EventPaySuccess, From: OrderPaying}, persist); !errors.Is(err, errDenied) {
+		t.Errorf("守卫拒绝时应返回守卫错误，实际: %v", err)
+	}
+	if len(steps) != 1 {
+		t.Errorf("守卫拒绝后不应继续执行: %v", steps)
+	}
+
+	if err := m.Fire(ctx, &Context{Event: EventPaySuccess, From: OrderTradeClosed, Actor: "system"}, persist); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("无效流转应返回ErrInvalidTransition，实际: %v", err)
+	}
+}
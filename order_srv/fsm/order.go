@@ -0,0 +1,43 @@
+package fsm
+
+// 订单状态
+const (
+	OrderWaitBuyerPay  State = "WAIT_BUYER_PAY" // 交易创建
+	OrderPaying        State = "PAYING"         // 待支付
+	OrderTradeSuccess  State = "TRADE_SUCCESS"  // 支付成功
+	OrderPartShipped   State = "PART_SHIPPED"   // 部分发货
+	OrderShipped       State = "SHIPPED"        // 已发货，待收货
+	OrderTradeClosed   State = "TRADE_CLOSED"   // 已关闭
+	OrderTradeFinished State = "TRADE_FINISHED" // 交易结束
+	OrderTradeRefunded State = "TRADE_REFUNDED" // 已全额退款
+)
+
+// 订单事件
+const (
+	EventCreate     Event = "CREATE"      // 创建订单，只用于记录初始状态，不参与流转
+	EventStartPay   Event = "START_PAY"   // 发起支付
+	EventPaySuccess Event = "PAY_SUCCESS" // 支付成功
+	EventCancel     Event = "CANCEL"      // 取消订单
+	EventTimeout    Event = "TIMEOUT"     // 超时未支付
+	EventFinish     Event = "FINISH"      // 交易完成
+	EventRefund     Event = "REFUND"      // 售后全额退款
+	EventShipPart   Event = "SHIP_PART"   // 部分商品发货
+	EventShip       Event = "SHIP"        // 全部商品发货
+	EventConfirm    Event = "CONFIRM"     // 确认收货，超时自动确认也使用该事件
+)
+
+// NewOrderMachine 创建订单状态机，只包含流转规则，守卫和钩子由使用方注册
+// 取消先于超时注册，按目标状态查找事件时关闭订单视为取消
+func NewOrderMachine() *Machine {
+	return New(
+		Transition{Event: EventStartPay, From: []State{OrderWaitBuyerPay}, To: OrderPaying},
+		Transition{Event: EventPaySuccess, From: []State{OrderWaitBuyerPay, OrderPaying}, To: OrderTradeSuccess},
+		Transition{Event: EventCancel, From: []State{OrderWaitBuyerPay, OrderPaying}, To: OrderTradeClosed},
+		Transition{Event: EventTimeout, From: []State{OrderWaitBuyerPay, OrderPaying}, To: OrderTradeClosed},
+		Transition{Event: EventShipPart, From: []State{OrderTradeSuccess}, To: OrderPartShipped},
+		Transition{Event: EventShip, From: []State{OrderTradeSuccess, OrderPartShipped}, To: OrderShipped},
+		Transition{Event: EventConfirm, From: []State{OrderShipped}, To: OrderTradeFinished},
+		Transition{Event: EventFinish, From: []State{OrderTradeSuccess}, To: OrderTradeFinished},
+		Transition{Event: EventRefund, From: []State{OrderTradeSuccess, OrderPartShipped, OrderShipped, OrderTradeFinished}, To: OrderTradeRefunded},
+	)
+}
@@ -26,4 +26,5 @@ var (
 	RedisClient     *redis.Client
 	GoodsClient     *grpc.ClientConn
 	InventoryClient *grpc.ClientConn
+	UserClient      *grpc.ClientConn
 )
@@ -0,0 +1,291 @@
+package handler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/proto"
+	goodsproto "order_srv/proto/goods"
+	"order_srv/util"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 购物车查询商品和库存的超时时间，超时后降级返回快照信息
+const cartLookupTimeout = 2 * time.Second
+
+// enrichCartItems 并发查询商品服务和库存服务，补充当前售价、价格变化、上架状态和可用库存
+// 任一服务不可用时对应字段保持为空并返回降级标记，不影响购物车列表本身
+func enrichCartItems(ctx context.Context, items []*proto.ShopCartInfoResponse) (goodsDegraded, stockDegraded bool) {
+	if len(items) == 0 {
+		return false, false
+	}
+	goodsIds := make([]int32, 0, len(items))
+	for _, item := range items {
+		goodsIds = append(goodsIds, item.GoodsId)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		goodsMap map[int32]*goodsproto.GoodsInfoResponse
+		stocks   map[int32]int32
+		goodsErr error
+		stockErr error
+	)
+	lookupCtx, cancel := context.WithTimeout(ctx, cartLookupTimeout)
+	defer cancel()
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		goodsMap, goodsErr = utils.GetGoodsByIds(lookupCtx, goodsIds)
+	}()
+	go func() {
+		defer wg.Done()
+		stocks, stockErr = utils.GetInventories(lookupCtx, goodsIds)
+	}()
+	wg.Wait()
+
+	if goodsErr != nil {
+		global.Logger.Warnf("商品服务不可用，购物车返回快照信息: %v", goodsErr)
+	}
+	if stockErr != nil {
+		global.Logger.Warnf("库存服务不可用，购物车不返回可用库存: %v", stockErr)
+	}
+	for _, item := range items {
+		if goodsErr == nil {
+			// 商品服务未返回的商品视为已删除，按下架处理
+			if goodsInfo, ok := goodsMap[item.GoodsId]; ok {
+				item.CurrentPrice = int64(shopPrice(goodsInfo))
+				item.PriceDelta = item.CurrentPrice - item.Price
+				item.PriceChanged = item.PriceDelta != 0
+				item.OnSale = goodsInfo.OnSale
+			}
+		}
+		if stockErr == nil {
+			item.Stock = stocks[item.GoodsId]
+		}
+		item.Available = goodsErr == nil && stockErr == nil && item.OnSale && item.Stock >= item.Nums
+	}
+	return goodsErr != nil, stockErr != nil
+}
+
+// CartItemRefresh 按商品服务的当前信息刷新购物车中的价格、名称和图片快照，移除已删除或已下架的商品
+// 商品服务不可用时直接返回错误，避免误删购物车记录
+func (s *OrderServiceServer) CartItemRefresh(ctx context.Context, req *proto.UserInfo) (*proto.CartRefreshResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+
+	var carts []model.ShoppingCart
+	if err := global.DB.Where("user = ?", req.Id).Find(&carts).Error; err != nil {
+		global.Logger.Errorf("查询购物车记录失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+
+	resp := &proto.CartRefreshResponse{}
+	if len(carts) > 0 {
+		goodsIds := make([]int32, 0, len(carts))
+		for _, cart := range carts {
+			goodsIds = append(goodsIds, cart.Goods)
+		}
+		goodsMap, err := utils.GetGoodsByIds(ctx, goodsIds)
+		if err != nil {
+			global.Logger.Errorf("刷新购物车时获取商品信息失败: %v", err)
+			return nil, status.Errorf(codes.Unavailable, "商品服务不可用，请稍后重试")
+		}
+
+		err = global.DB.Transaction(func(tx *gorm.DB) error {
+			for i := range carts {
+				cart := &carts[i]
+				goodsInfo, ok := goodsMap[cart.Goods]
+				if !ok || !goodsInfo.OnSale {
+					if err := tx.Delete(cart).Error; err != nil {
+						return err
+					}
+					resp.RemovedItems = append(resp.RemovedItems, cartToInfo(cart))
+					continue
+				}
+				price := shopPrice(goodsInfo)
+				if cart.GoodsPrice == price && cart.GoodsName == goodsInfo.Name && cart.GoodsImage == goodsInfo.GoodsFrontImage {
+					continue
+				}
+				if err := tx.Model(cart).Updates(map[string]interface{}{
+					"goods_price": price,
+					"goods_name":  goodsInfo.Name,
+					"goods_image": goodsInfo.GoodsFrontImage,
+				}).Error; err != nil {
+					return err
+				}
+				resp.Updated++
+			}
+			return nil
+		})
+		if err != nil {
+			global.Logger.Errorf("刷新购物车失败，用户ID: %d，错误: %v", req.Id, err)
+			return nil, status.Errorf(codes.Internal, "刷新购物车失败")
+		}
+		resp.Removed = int32(len(resp.RemovedItems))
+		global.Logger.Infof("刷新购物车，用户ID: %d，更新: %d，移除: %d", req.Id, resp.Updated, resp.Removed)
+	}
+
+	cart, err := s.CartItemList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Cart = cart
+	return resp, nil
+}
+
+// CartItemBatchCheck 批量设置选中状态，未指定记录ID时设置用户的全部购物车记录
+func (s *OrderServiceServer) CartItemBatchCheck(ctx context.Context, req *proto.CartBatchCheckRequest) (*proto.CartSummaryResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+	query := global.DB.Model(&model.ShoppingCart{}).Where("user = ?", req.UserId)
+	if len(req.Ids) > 0 {
+		query = query.Where("id IN ?", req.Ids)
+	}
+	result := query.Update("checked", req.Checked)
+	if result.Error != nil {
+		global.Logger.Errorf("批量更新购物车选中状态失败，用户ID: %d，错误: %v", req.UserId, result.Error)
+		return nil, status.Errorf(codes.Internal, "更新购物车失败")
+	}
+	global.Logger.Infof("批量更新购物车选中状态，用户ID: %d，选中: %t，影响行数: %d", req.UserId, req.Checked, result.RowsAffected)
+	return cartSummary(req.UserId, result.RowsAffected)
+}
+
+// CartItemBatchDelete 批量删除购物车记录，只删除属于该用户的记录
+func (s *OrderServiceServer) CartItemBatchDelete(ctx context.Context, req *proto.CartBatchRequest) (*proto.CartSummaryResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+	if len(req.Ids) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "购物车记录ID不能为空")
+	}
+	result := global.DB.Where("id IN ? AND user = ?", req.Ids, req.UserId).Delete(&model.ShoppingCart{})
+	if result.Error != nil {
+		global.Logger.Errorf("批量删除购物车失败，用户ID: %d，错误: %v", req.UserId, result.Error)
+		return nil, status.Errorf(codes.Internal, "删除购物车失败")
+	}
+	global.Logger.Infof("批量删除购物车，用户ID: %d，影响行数: %d", req.UserId, result.RowsAffected)
+	return cartSummary(req.UserId, result.RowsAffected)
+}
+
+// CartItemBatchUpdate 在一个事务中批量修改商品数量，任一记录不存在或数量无效时全部不修改
+func (s *OrderServiceServer) CartItemBatchUpdate(ctx context.Context, req *proto.CartBatchUpdateRequest) (*proto.CartSummaryResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "要修改的记录不能为空")
+	}
+	ids := make([]int32, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.Id <= 0 || item.Nums <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "购物车记录ID和数量必须大于0")
+		}
+		if err := checkCartNums(item.Nums); err != nil {
+			return nil, err
+		}
+		ids = append(ids, item.Id)
+	}
+
+	var affected int64
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		var carts []model.ShoppingCart
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND user = ?", ids, req.UserId).Find(&carts).Error; err != nil {
+			return err
+		}
+		current := make(map[int32]int32, len(carts))
+		for _, cart := range carts {
+			current[cart.ID] = cart.Nums
+		}
+		for _, item := range req.Items {
+			nums, ok := current[item.Id]
+			if !ok {
+				return status.Errorf(codes.NotFound, "购物车记录不存在，ID: %d", item.Id)
+			}
+			if nums == item.Nums {
+				continue
+			}
+			if err := tx.Model(&model.ShoppingCart{}).Where("id = ?", item.Id).Update("nums", item.Nums).Error; err != nil {
+				return err
+			}
+			current[item.Id] = item.Nums
+			affected++
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		global.Logger.Errorf("批量修改购物车数量失败，用户ID: %d，错误: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "更新购物车失败")
+	}
+	global.Logger.Infof("批量修改购物车数量，用户ID: %d，影响行数: %d", req.UserId, affected)
+	return cartSummary(req.UserId, affected)
+}
+
+// cartSummary 统计用户购物车的记录数和选中商品的件数、金额
+func cartSummary(userId int32, affected int64) (*proto.CartSummaryResponse, error) {
+	var carts []model.ShoppingCart
+	if err := global.DB.Select("id", "nums", "checked", "goods_price").Where("user = ?", userId).Find(&carts).Error; err != nil {
+		global.Logger.Errorf("统计购物车失败，用户ID: %d，错误: %v", userId, err)
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	resp := &proto.CartSummaryResponse{Affected: int32(affected), Total: int32(len(carts))}
+	for _, cart := range carts {
+		if !cart.Checked {
+			continue
+		}
+		resp.SelectedCount++
+		resp.SelectedNums += cart.Nums
+		resp.SelectedTotal += int64(cart.GoodsPrice) * int64(cart.Nums)
+	}
+	return resp, nil
+}
+
+// loadCartGoods 加购前查询商品详情，商品必须存在且已上架
+func loadCartGoods(ctx context.Context, goodsId int32) (*goodsproto.GoodsInfoResponse, error) {
+	conn := util.ServiceConn(&global.GoodsClient, "goods_srv")
+	if conn == nil {
+		return nil, status.Errorf(codes.Unavailable, "商品服务不可用")
+	}
+	goodsClient := goodsproto.NewGoodsClient(conn)
+	goodsInfo, err := goodsClient.GetGoodsDetail(ctx, &goodsproto.GoodInfoRequest{Id: goodsId})
+	if err != nil {
+		global.Logger.Errorf("查询商品详情失败，商品ID: %d, 错误: %v", goodsId, err)
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "商品不存在")
+		}
+		return nil, status.Errorf(codes.Internal, "查询商品信息失败")
+	}
+	if !goodsInfo.OnSale {
+		global.Logger.Warnf("商品已下架，商品ID: %d", goodsId)
+		return nil, status.Errorf(codes.FailedPrecondition, "商品已下架")
+	}
+	return goodsInfo, nil
+}
+
+// cartToInfo 购物车记录转换为响应格式
+func cartToInfo(cart *model.ShoppingCart) *proto.ShopCartInfoResponse {
+	return &proto.ShopCartInfoResponse{
+		Id:         cart.ID,
+		UserId:     cart.User,
+		GoodsId:    cart.Goods,
+		GoodsName:  cart.GoodsName,
+		GoodsImage: cart.GoodsImage,
+		Price:      int64(cart.GoodsPrice),
+		Nums:       cart.Nums,
+		Checked:    cart.Checked,
+	}
+}
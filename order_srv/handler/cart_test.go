@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"order_srv/global"
+	"order_srv/proto"
+	goodsproto "order_srv/proto/goods"
+	inventoryproto "order_srv/proto/inventory"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeGoodsServer 模拟商品服务，只返回goods中存在的商品
+type fakeGoodsServer struct {
+	goodsproto.UnimplementedGoodsServer
+	goods map[int32]*goodsproto.GoodsInfoResponse
+}
+
+func (f *fakeGoodsServer) BatchGetGoods(ctx context.Context, req *goodsproto.BatchGoodsIdInfo) (*goodsproto.GoodsListResponse, error) {
+	resp := &goodsproto.GoodsListResponse{}
+	for _, id := range req.Id {
+		if g, ok := f.goods[id]; ok {
+			resp.Data = append(resp.Data, g)
+		}
+	}
+	resp.Total = int32(len(resp.Data))
+	return resp, nil
+}
+
+// fakeInventoryServer 模拟库存服务，没有记录的商品库存为0
+type fakeInventoryServer struct {
+	inventoryproto.UnimplementedInventoryServiceServer
+	stocks map[int32]int32
+}
+
+func (f *fakeInventoryServer) BatchGetInventory(ctx context.Context, req *inventoryproto.BatchInvRequest) (*inventoryproto.BatchInvResponse, error) {
+	resp := &inventoryproto.BatchInvResponse{}
+	for _, id := range req.GoodsIds {
+		resp.Data = append(resp.Data, &inventoryproto.GoodsInvInfo{GoodsId: id, Num: f.stocks[id]})
+	}
+	return resp, nil
+}
+
+// dialFakeServer 启动内存中的gRPC服务并返回连接，测试结束后关闭
+func dialFakeServer(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(lis)
+	conn, err := grpc.NewClient("passthrough:///fake",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("连接模拟服务失败: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+	return conn
+}
+
+// setupCartServices 替换商品服务和库存服务的连接，传nil表示服务不可用，测试结束后恢复
+func setupCartServices(t *testing.T, goods *fakeGoodsServer, inventory *fakeInventoryServer) {
+	oldGoods, oldInventory, oldConsul, oldLogger := global.GoodsClient, global.InventoryClient, global.ConsulClient, global.Logger
+	global.GoodsClient, global.InventoryClient, global.ConsulClient = nil, nil, nil
+	global.Logger = zap.NewNop().Sugar()
+	t.Cleanup(func() {
+		global.GoodsClient, global.InventoryClient, global.ConsulClient, global.Logger = oldGoods, oldInventory, oldConsul, oldLogger
+	})
+	if goods != nil {
+		global.GoodsClient = dialFakeServer(t, func(s *grpc.Server) { goodsproto.RegisterGoodsServer(s, goods) })
+	}
+	if inventory != nil {
+		global.InventoryClient = dialFakeServer(t, func(s *grpc.Server) { inventoryproto.RegisterInventoryServiceServer(s, inventory) })
+	}
+}
+
+func newCartFakes() (*fakeGoodsServer, *fakeInventoryServer) {
+	goods := &fakeGoodsServer{goods: map[int32]*goodsproto.GoodsInfoResponse{
+		1: {Id: 1, ShopPrice: 12.5, OnSale: true},
+		2: {Id: 2, ShopPrice: 20, OnSale: true},
+		3: {Id: 3, ShopPrice: 30, OnSale: false},
+	}}
+	inventory := &fakeInventoryServer{stocks: map[int32]int32{1: 10, 2: 1, 3: 5}}
+	return goods, inventory
+}
+
+// newCartItems 购物车记录：降价的商品1，库存不足的商品2，已下架的商品3，已删除的商品4
+func newCartItems() []*proto.ShopCartInfoResponse {
+	return []*proto.ShopCartInfoResponse{
+		{GoodsId: 1, Price: 1300, Nums: 2},
+		{GoodsId: 2, Price: 2000, Nums: 2},
+		{GoodsId: 3, Price: 3000, Nums: 1},
+		{GoodsId: 4, Price: 4000, Nums: 1},
+	}
+}
+
+// TestEnrichCartItems 测试补充当前售价、价格变化、上架状态和库存
+func TestEnrichCartItems(t *testing.T) {
+	goods, inventory := newCartFakes()
+	setupCartServices(t, goods, inventory)
+
+	items := newCartItems()
+	goodsDegraded, stockDegraded := enrichCartItems(context.Background(), items)
+	if goodsDegraded || stockDegraded {
+		t.Fatalf("服务正常时不应降级，商品: %v，库存: %v", goodsDegraded, stockDegraded)
+	}
+
+	want := []struct {
+		currentPrice, delta int64
+		changed, onSale     bool
+		stock               int32
+		available           bool
+	}{
+		{1250, -50, true, true, 10, true},
+		{2000, 0, false, true, 1, false},
+		{3000, 0, false, false, 5, false},
+		{0, 0, false, false, 0, false},
+	}
+	for i, w := range want {
+		item := items[i]
+		if item.CurrentPrice != w.currentPrice || item.PriceDelta != w.delta || item.PriceChanged != w.changed ||
+			item.OnSale != w.onSale || item.Stock != w.stock || item.Available != w.available {
+			t.Errorf("商品%d 补充结果错误: %+v", item.GoodsId, item)
+		}
+	}
+}
+
+// TestEnrichCartItemsDegraded 测试商品服务或库存服务不可用时返回降级标记，商品均不可下单
+func TestEnrichCartItemsDegraded(t *testing.T) {
+	cases := []struct {
+		name                                 string
+		goodsDown, stockDown                 bool
+		wantGoodsDegraded, wantStockDegraded bool
+	}{
+		{"商品服务不可用", true, false, true, false},
+		{"库存服务不可用", false, true, false, true},
+		{"全部不可用", true, true, true, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			goods, inventory := newCartFakes()
+			if c.goodsDown {
+				goods = nil
+			}
+			if c.stockDown {
+				inventory = nil
+			}
+			setupCartServices(t, goods, inventory)
+
+			items := newCartItems()
+			goodsDegraded, stockDegraded := enrichCartItems(context.Background(), items)
+			if goodsDegraded != c.wantGoodsDegraded || stockDegraded != c.wantStockDegraded {
+				t.Errorf("降级标记错误，商品: %v，库存: %v", goodsDegraded, stockDegraded)
+			}
+			for _, item := range items {
+				if item.Available {
+					t.Errorf("服务降级时商品%d不应可下单", item.GoodsId)
+				}
+				if c.goodsDown && (item.CurrentPrice != 0 || item.OnSale) {
+					t.Errorf("商品服务不可用时不应返回当前售价和上架状态: %+v", item)
+				}
+				if c.stockDown && item.Stock != 0 {
+					t.Errorf("库存服务不可用时不应返回库存: %+v", item)
+				}
+			}
+			if !c.stockDown && items[0].Stock != 10 {
+				t.Errorf("库存服务正常时应返回库存，实际 %d", items[0].Stock)
+			}
+			if !c.goodsDown && items[0].CurrentPrice != 1250 {
+				t.Errorf("商品服务正常时应返回当前售价，实际 %d", items[0].CurrentPrice)
+			}
+		})
+	}
+}
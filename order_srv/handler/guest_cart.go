@@ -0,0 +1,490 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 购物车合并时同一商品的数量规则
+const (
+	CartMergeSum   = "sum"   // 数量相加
+	CartMergeMax   = "max"   // 取较大值
+	CartMergeUser  = "user"  // 保留用户购物车的数量
+	CartMergeGuest = "guest" // 以游客购物车的数量为准
+)
+
+// 游客购物车默认保留时长
+const defaultGuestCartTTL = 7 * 24 * time.Hour
+
+// 合并购物车的锁定时间，覆盖读取游客购物车和写入数据库的整个过程
+const guestCartMergeLockTTL = 30 * time.Second
+
+// addGuestCartScript 原子地累加游客购物车中商品的数量并刷新商品信息，已有商品保留选中状态和加购时间
+// KEYS[1] 游客购物车 ARGV[1] 商品ID ARGV[2] 商品信息 ARGV[3] 增加的数量 ARGV[4] 单个商品最大数量 ARGV[5] 过期时间（毫秒）
+// 超过最大数量时不修改并返回-1，否则返回累加后的数量
+var addGuestCartScript = redis.NewScript(`
+local item = cjson.decode(ARGV[2])
+local nums = tonumber(ARGV[3])
+local current = redis.call("HGET", KEYS[1], ARGV[1])
+if current then
+	local ok, existing = pcall(cjson.decode, current)
+	if ok and type(existing) == "table" then
+		item.checked = existing.checked
+		item.add_time = existing.add_time
+		nums = nums + (tonumber(existing.nums) or 0)
+	end
+end
+local max = tonumber(ARGV[4])
+if max > 0 and nums > max then
+	return -1
+end
+item.nums = nums
+redis.call("HSET", KEYS[1], ARGV[1], cjson.encode(item))
+redis.call("PEXPIRE", KEYS[1], ARGV[5])
+return nums
+`)
+
+// guestCartItem 游客购物车中的商品，以商品ID为字段存入Redis哈希
+type guestCartItem struct {
+	GoodsId    int32  `json:"goods_id"`
+	GoodsName  string `json:"goods_name"`
+	GoodsImage string `json:"goods_image"`
+	Price      int64  `json:"price"` // 加购时的单价（分）
+	Nums       int32  `json:"nums"`
+	Checked    bool   `json:"checked"`
+	AddTime    int64  `json:"add_time"`
+}
+
+// GuestCartList 获取游客购物车
+func (s *OrderServiceServer) GuestCartList(ctx context.Context, req *proto.GuestCartRequest) (*proto.GuestCartResponse, error) {
+	if err := validateSessionToken(req.SessionToken); err != nil {
+		return nil, err
+	}
+	return guestCartResponse(ctx, req.SessionToken)
+}
+
+// GuestCartAdd 添加商品到游客购物车，未提供会话令牌或令牌已合并到用户购物车时生成新令牌并在响应中返回
+func (s *OrderServiceServer) GuestCartAdd(ctx context.Context, req *proto.GuestCartItemRequest) (*proto.GuestCartResponse, error) {
+	if req.GoodsId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "商品ID必须大于0")
+	}
+	if req.Nums <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "商品数量必须大于0")
+	}
+	token := req.SessionToken
+	if token == "" {
+		token = newSessionToken()
+	} else if err := validateSessionToken(token); err != nil {
+		return nil, err
+	} else if merged, err := isGuestCartMerged(token); err != nil {
+		return nil, err
+	} else if merged {
+		// 已合并的令牌再次合并时会被跳过，继续加购会丢失商品
+		token = newSessionToken()
+	}
+
+	goodsInfo, err := loadCartGoods(ctx, req.GoodsId)
+	if err != nil {
+		return nil, err
+	}
+	item := &guestCartItem{
+		GoodsId:    req.GoodsId,
+		GoodsName:  goodsInfo.Name,
+		GoodsImage: goodsInfo.GoodsFrontImage,
+		Price:      int64(shopPrice(goodsInfo)),
+		Checked:    req.Checked,
+		AddTime:    time.Now().Unix(),
+	}
+	nums, err := addGuestCartItem(ctx, token, item, req.Nums)
+	if err != nil {
+		return nil, err
+	}
+	global.Logger.Infof("添加游客购物车，商品ID: %d，数量: %d", item.GoodsId, nums)
+	return guestCartResponse(ctx, token)
+}
+
+// GuestCartUpdate 更新游客购物车商品的数量和选中状态，数量为0时只更新选中状态
+func (s *OrderServiceServer) GuestCartUpdate(ctx context.Context, req *proto.GuestCartItemRequest) (*proto.GuestCartResponse, error) {
+	if err := validateSessionToken(req.SessionToken); err != nil {
+		return nil, err
+	}
+	if req.Nums < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "商品数量不能为负数")
+	}
+	item, err := getGuestCartItem(ctx, req.SessionToken, req.GoodsId)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, status.Errorf(codes.NotFound, "购物车记录不存在")
+	}
+	if req.Nums > 0 {
+		if err := checkCartNums(req.Nums); err != nil {
+			return nil, err
+		}
+		item.Nums = req.Nums
+	}
+	item.Checked = req.Checked
+	if err := saveGuestCartItem(ctx, req.SessionToken, item); err != nil {
+		return nil, err
+	}
+	return guestCartResponse(ctx, req.SessionToken)
+}
+
+// GuestCartDelete 删除游客购物车中的商品
+func (s *OrderServiceServer) GuestCartDelete(ctx context.Context, req *proto.GuestCartItemRequest) (*proto.GuestCartResponse, error) {
+	if err := validateSessionToken(req.SessionToken); err != nil {
+		return nil, err
+	}
+	removed, err := global.RedisClient.HDel(ctx, guestCartKey(req.SessionToken), strconv.Itoa(int(req.GoodsId))).Result()
+	if err != nil {
+		global.Logger.Errorf("删除游客购物车失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "删除购物车失败")
+	}
+	if removed == 0 {
+		return nil, status.Errorf(codes.NotFound, "购物车记录不存在")
+	}
+	return guestCartResponse(ctx, req.SessionToken)
+}
+
+// MergeCart 用户登录后将游客购物车合并到用户购物车，同一商品按配置的规则处理数量并受单个商品最大数量限制
+// 同一会话的合并请求通过分布式锁串行执行。合并前先将游客购物车改名，数据库事务失败时恢复游客购物车；
+// 上次合并中断留下的改名后的购物车在下次合并时继续合并。合并记录与购物车在同一事务中写入，已合并的会话令牌不会重复合并
+func (s *OrderServiceServer) MergeCart(ctx context.Context, req *proto.MergeCartRequest) (*proto.MergeCartResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+	if err := validateSessionToken(req.SessionToken); err != nil {
+		return nil, err
+	}
+
+	key := guestCartKey(req.SessionToken)
+	mergingKey := key + ":merging"
+	lock := utils.NewRedisLock(key+":merge_lock", guestCartMergeLockTTL)
+	if locked, err := lock.TryLock(ctx, 10, 200*time.Millisecond); !locked {
+		global.Logger.Warnf("获取购物车合并锁失败，用户ID: %d，错误: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Aborted, "购物车正在合并，请稍后重试")
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			global.Logger.Warnf("释放购物车合并锁失败: %v", err)
+		}
+	}()
+
+	resp := &proto.MergeCartResponse{}
+	// 上次合并在删除改名后的购物车之前中断，继续合并。中断发生在事务提交之后时按合并记录跳过，只删除改名后的购物车
+	exists, err := global.RedisClient.Exists(ctx, mergingKey).Result()
+	if err != nil {
+		global.Logger.Errorf("查询合并中的游客购物车失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "合并购物车失败")
+	}
+	if exists > 0 {
+		global.Logger.Infof("继续合并上次中断的游客购物车，用户ID: %d", req.UserId)
+		if err := mergeGuestCartKey(ctx, req.UserId, req.SessionToken, mergingKey, "", resp); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := global.RedisClient.Rename(ctx, key, mergingKey).Err(); err != nil {
+		// 游客购物车为空或已被之前的请求合并
+		if !strings.Contains(err.Error(), "no such key") {
+			global.Logger.Errorf("锁定游客购物车失败: %v", err)
+			return nil, status.Errorf(codes.Internal, "合并购物车失败")
+		}
+	} else if err := mergeGuestCartKey(ctx, req.UserId, req.SessionToken, mergingKey, key, resp); err != nil {
+		return nil, err
+	}
+	global.Logger.Infof("合并游客购物车，用户ID: %d，新增: %d，合并: %d，截断: %d", req.UserId, resp.Added, resp.Merged, resp.Capped)
+
+	cart, err := s.CartItemList(ctx, &proto.UserInfo{Id: req.UserId})
+	if err != nil {
+		return nil, err
+	}
+	resp.Cart = cart
+	return resp, nil
+}
+
+// mergeGuestCartKey 将改名后的游客购物车合并到用户购物车，成功后删除
+// 事务中先写入会话令牌的合并记录，令牌已合并过时跳过合并；事务失败时restoreKey不为空则改回原来的键，否则保留到下次合并
+func mergeGuestCartKey(ctx context.Context, userId int32, token, mergingKey, restoreKey string, resp *proto.MergeCartResponse) error {
+	items, err := loadGuestCart(ctx, mergingKey)
+	if err != nil {
+		return err
+	}
+	if err := global.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CartMergeRecord{SessionToken: token, User: userId})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			global.Logger.Infof("游客购物车已合并过，跳过，用户ID: %d", userId)
+			return nil
+		}
+		return mergeGuestItems(tx, userId, items, resp)
+	}); err != nil {
+		global.Logger.Errorf("合并购物车失败，用户ID: %d，错误: %v", userId, err)
+		if restoreKey != "" {
+			if err := global.RedisClient.RenameNX(ctx, mergingKey, restoreKey).Err(); err != nil {
+				global.Logger.Warnf("恢复游客购物车失败: %v", err)
+			}
+		}
+		return status.Errorf(codes.Internal, "合并购物车失败")
+	}
+	if err := global.RedisClient.Del(ctx, mergingKey).Err(); err != nil {
+		global.Logger.Warnf("删除已合并的游客购物车失败: %v", err)
+	}
+	return nil
+}
+
+// mergeGuestItems 在事务中锁定用户购物车中的同一商品并写入合并后的数量
+func mergeGuestItems(tx *gorm.DB, userId int32, items []*guestCartItem, resp *proto.MergeCartResponse) error {
+	if len(items) == 0 {
+		return nil
+	}
+	goodsIds := make([]int32, 0, len(items))
+	for _, item := range items {
+		goodsIds = append(goodsIds, item.GoodsId)
+	}
+	var carts []model.ShoppingCart
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user = ? AND goods IN ?", userId, goodsIds).Find(&carts).Error; err != nil {
+		return err
+	}
+	existing := make(map[int32]*model.ShoppingCart, len(carts))
+	for i := range carts {
+		existing[carts[i].Goods] = &carts[i]
+	}
+
+	strategy := global.ServerConfig.Cart.MergeStrategy
+	for _, item := range items {
+		cart, ok := existing[item.GoodsId]
+		if !ok {
+			nums, capped := capCartNums(item.Nums)
+			if capped {
+				resp.Capped++
+			}
+			if err := tx.Create(&model.ShoppingCart{
+				User:       userId,
+				Goods:      item.GoodsId,
+				GoodsName:  item.GoodsName,
+				GoodsImage: item.GoodsImage,
+				GoodsPrice: model.Money(item.Price),
+				Nums:       nums,
+				Checked:    item.Checked,
+			}).Error; err != nil {
+				return err
+			}
+			resp.Added++
+			continue
+		}
+
+		nums, capped := capCartNums(mergeCartNums(strategy, cart.Nums, item.Nums))
+		if capped {
+			resp.Capped++
+		}
+		if err := tx.Model(cart).Updates(map[string]interface{}{
+			"nums":    nums,
+			"checked": cart.Checked || item.Checked,
+		}).Error; err != nil {
+			return err
+		}
+		resp.Merged++
+	}
+	return nil
+}
+
+// mergeCartNums 按合并规则计算同一商品合并后的数量，未配置或无效的规则按相加处理
+func mergeCartNums(strategy string, userNums, guestNums int32) int32 {
+	switch strategy {
+	case CartMergeMax:
+		if guestNums > userNums {
+			return guestNums
+		}
+		return userNums
+	case CartMergeUser:
+		return userNums
+	case CartMergeGuest:
+		return guestNums
+	default:
+		return userNums + guestNums
+	}
+}
+
+// capCartNums 将数量截断到单个商品最大数量
+func capCartNums(nums int32) (int32, bool) {
+	max := global.ServerConfig.Cart.MaxNumsPerGoods
+	if max > 0 && nums > max {
+		return max, true
+	}
+	return nums, false
+}
+
+// checkCartNums 校验购物车中单个商品的数量不超过上限
+func checkCartNums(nums int32) error {
+	if _, capped := capCartNums(nums); capped {
+		return status.Errorf(codes.FailedPrecondition, "单个商品最多购买%d件", global.ServerConfig.Cart.MaxNumsPerGoods)
+	}
+	return nil
+}
+
+// guestCartResponse 查询游客购物车并补充当前价格和库存
+func guestCartResponse(ctx context.Context, token string) (*proto.GuestCartResponse, error) {
+	items, err := loadGuestCart(ctx, guestCartKey(token))
+	if err != nil {
+		return nil, err
+	}
+	cartItems := make([]*proto.ShopCartInfoResponse, 0, len(items))
+	for _, item := range items {
+		cartItems = append(cartItems, &proto.ShopCartInfoResponse{
+			GoodsId:    item.GoodsId,
+			GoodsName:  item.GoodsName,
+			GoodsImage: item.GoodsImage,
+			Price:      item.Price,
+			Nums:       item.Nums,
+			Checked:    item.Checked,
+		})
+	}
+	cart := &proto.CartItemListResponse{Total: int32(len(cartItems)), CartItems: cartItems}
+	cart.GoodsDegraded, cart.StockDegraded = enrichCartItems(ctx, cartItems)
+	return &proto.GuestCartResponse{SessionToken: token, Cart: cart}, nil
+}
+
+// loadGuestCart 读取游客购物车，按加购时间排序
+func loadGuestCart(ctx context.Context, key string) ([]*guestCartItem, error) {
+	fields, err := global.RedisClient.HGetAll(ctx, key).Result()
+	if err != nil {
+		global.Logger.Errorf("查询游客购物车失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	items := make([]*guestCartItem, 0, len(fields))
+	for field, value := range fields {
+		var item guestCartItem
+		if err := json.Unmarshal([]byte(value), &item); err != nil {
+			global.Logger.Warnf("游客购物车数据无效，商品: %s，错误: %v", field, err)
+			continue
+		}
+		items = append(items, &item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].AddTime != items[j].AddTime {
+			return items[i].AddTime < items[j].AddTime
+		}
+		return items[i].GoodsId < items[j].GoodsId
+	})
+	return items, nil
+}
+
+// getGuestCartItem 读取游客购物车中的商品，不存在时返回nil
+func getGuestCartItem(ctx context.Context, token string, goodsId int32) (*guestCartItem, error) {
+	value, err := global.RedisClient.HGet(ctx, guestCartKey(token), strconv.Itoa(int(goodsId))).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		global.Logger.Errorf("查询游客购物车失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	var item guestCartItem
+	if err := json.Unmarshal([]byte(value), &item); err != nil {
+		global.Logger.Warnf("游客购物车数据无效，商品ID: %d，错误: %v", goodsId, err)
+		return nil, nil
+	}
+	return &item, nil
+}
+
+// addGuestCartItem 原子地将商品数量累加到游客购物车，返回累加后的数量
+func addGuestCartItem(ctx context.Context, token string, item *guestCartItem, nums int32) (int32, error) {
+	value, err := json.Marshal(item)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "保存购物车失败")
+	}
+	total, err := addGuestCartScript.Run(ctx, global.RedisClient, []string{guestCartKey(token)},
+		strconv.Itoa(int(item.GoodsId)), value, nums, global.ServerConfig.Cart.MaxNumsPerGoods, guestCartTTL().Milliseconds()).Int()
+	if err != nil {
+		global.Logger.Errorf("保存游客购物车失败: %v", err)
+		return 0, status.Errorf(codes.Internal, "保存购物车失败")
+	}
+	if total < 0 {
+		return 0, status.Errorf(codes.FailedPrecondition, "单个商品最多购买%d件", global.ServerConfig.Cart.MaxNumsPerGoods)
+	}
+	return int32(total), nil
+}
+
+// isGuestCartMerged 会话令牌是否已合并到用户购物车
+func isGuestCartMerged(token string) (bool, error) {
+	var count int64
+	if err := global.DB.Model(&model.CartMergeRecord{}).Where("session_token = ?", token).Count(&count).Error; err != nil {
+		global.Logger.Errorf("查询购物车合并记录失败: %v", err)
+		return false, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	return count > 0, nil
+}
+
+// saveGuestCartItem 写入游客购物车中的商品并重新计算过期时间
+func saveGuestCartItem(ctx context.Context, token string, item *guestCartItem) error {
+	value, err := json.Marshal(item)
+	if err != nil {
+		return status.Errorf(codes.Internal, "保存购物车失败")
+	}
+	key := guestCartKey(token)
+	_, err = global.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, strconv.Itoa(int(item.GoodsId)), value)
+		pipe.Expire(ctx, key, guestCartTTL())
+		return nil
+	})
+	if err != nil {
+		global.Logger.Errorf("保存游客购物车失败: %v", err)
+		return status.Errorf(codes.Internal, "保存购物车失败")
+	}
+	return nil
+}
+
+// guestCartKey 游客购物车的键，令牌作为哈希标签，合并时改名和加锁用到的键在Redis集群中位于同一个槽
+func guestCartKey(token string) string {
+	return fmt.Sprintf("guest_cart:{%s}", token)
+}
+
+func guestCartTTL() time.Duration {
+	if ttl := global.ServerConfig.Cart.GuestTTL; ttl > 0 {
+		return time.Duration(ttl) * time.Second
+	}
+	return defaultGuestCartTTL
+}
+
+// newSessionToken 生成游客会话令牌
+func newSessionToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validateSessionToken 会话令牌只允许字母、数字、下划线和短横线，避免拼接出其他Redis键
+func validateSessionToken(token string) error {
+	if len(token) < 16 || len(token) > 64 {
+		return status.Errorf(codes.InvalidArgument, "无效的会话令牌")
+	}
+	for _, c := range token {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return status.Errorf(codes.InvalidArgument, "无效的会话令牌")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"testing"
+
+	"order_srv/config"
+	"order_srv/global"
+)
+
+// TestMergeCartNums 测试各合并规则下同一商品的数量
+func TestMergeCartNums(t *testing.T) {
+	cases := []struct {
+		strategy string
+		user     int32
+		guest    int32
+		want     int32
+	}{
+		{CartMergeSum, 2, 3, 5},
+		{CartMergeMax, 2, 3, 3},
+		{CartMergeMax, 4, 3, 4},
+		{CartMergeUser, 2, 3, 2},
+		{CartMergeGuest, 2, 3, 3},
+		{"", 2, 3, 5},
+		{"unknown", 2, 3, 5},
+	}
+	for _, c := range cases {
+		if got := mergeCartNums(c.strategy, c.user, c.guest); got != c.want {
+			t.Errorf("mergeCartNums(%q, %d, %d) = %d，期望 %d", c.strategy, c.user, c.guest, got, c.want)
+		}
+	}
+}
+
+// TestCapCartNums 测试单个商品最大数量的截断，0表示不限制
+func TestCapCartNums(t *testing.T) {
+	old := global.ServerConfig
+	defer func() { global.ServerConfig = old }()
+
+	cases := []struct {
+		max        int32
+		nums       int32
+		want       int32
+		wantCapped bool
+	}{
+		{0, 1000, 1000, false},
+		{99, 98, 98, false},
+		{99, 99, 99, false},
+		{99, 100, 99, true},
+	}
+	for _, c := range cases {
+		global.ServerConfig = &config.ServerConfig{Cart: config.CartConfig{MaxNumsPerGoods: c.max}}
+		got, capped := capCartNums(c.nums)
+		if got != c.want || capped != c.wantCapped {
+			t.Errorf("上限 %d 时 capCartNums(%d) = (%d, %v)，期望 (%d, %v)", c.max, c.nums, got, capped, c.want, c.wantCapped)
+		}
+	}
+}
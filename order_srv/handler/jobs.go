@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"order_srv/global"
+	"order_srv/outbox"
+	"order_srv/proto"
+	"order_srv/saga"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// 后台任务租约时长，主实例宕机后最多经过该时长由其他实例接管
+const jobLeaseTTL = 15 * time.Second
+
+var (
+	// jobElector 后台任务选主，多副本部署时只有主实例运行后台任务
+	jobElector *utils.LeaderElector
+	stopJobs   context.CancelFunc
+	jobsDone   chan struct{}
+)
+
+// StartBackgroundJobs 注册并启动后台任务，任务只在竞选成功的实例上运行
+func StartBackgroundJobs() {
+	hostname, _ := os.Hostname()
+	id := fmt.Sprintf("%s:%d/%s/%d", global.ServerConfig.Host, global.ServerConfig.Port, hostname, os.Getpid())
+	jobElector = utils.NewLeaderElector("order_srv:jobs", id, jobLeaseTTL)
+
+	jobElector.Register("order_timeout_queue", runOrderTimeoutQueue)
+	jobElector.Register("order_timeout_scan", runOrderTimeoutScan)
+	jobElector.Register("order_auto_confirm", runOrderAutoConfirm)
+	jobElector.Register("order_stats_refresh", runOrderStatsRefresh)
+	jobElector.Register("refund_sync", runRefundSync)
+	jobElector.Register("outbox_relay", outbox.RunRelay)
+	jobElector.Register("saga_recovery", saga.RunRecovery)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopJobs = cancel
+	jobsDone = make(chan struct{})
+	go func() {
+		defer close(jobsDone)
+		jobElector.Start(ctx)
+	}()
+	global.Logger.Infof("后台任务已注册，等待竞选主实例，实例: %s，任务: %v", id, jobElector.Jobs())
+}
+
+// StopBackgroundJobs 停止后台任务并释放租约，其他实例可以立即接管
+func StopBackgroundJobs() {
+	if stopJobs == nil {
+		return
+	}
+	stopJobs()
+	<-jobsDone
+}
+
+// JobLeader 查询后台任务的主实例，供运维确认任务运行在哪个实例上
+func (s *OrderServiceServer) JobLeader(ctx context.Context, _ *emptypb.Empty) (*proto.JobLeaderResponse, error) {
+	if jobElector == nil {
+		return nil, status.Errorf(codes.Unavailable, "后台任务未启动")
+	}
+	leader, ttl, err := jobElector.Leader(ctx)
+	if err != nil {
+		global.Logger.Errorf("查询后台任务主实例失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询主实例失败")
+	}
+	return &proto.JobLeaderResponse{
+		Leader:   leader,
+		LeaseTtl: ttl.Milliseconds(),
+		Instance: jobElector.ID(),
+		IsLeader: jobElector.IsLeader(),
+		Jobs:     jobElector.Jobs(),
+	}, nil
+}
@@ -3,11 +3,11 @@ package handler
 import (
 	context "context"
 	"fmt"
+	"order_srv/fsm"
 	"order_srv/global"
 	"order_srv/model"
 	"order_srv/proto"
-	goodsproto "order_srv/proto/goods"
-	inventorypb "order_srv/proto/inventory"
+	"order_srv/saga"
 	"order_srv/utils"
 	"time"
 
@@ -48,23 +48,16 @@ func (s *OrderServiceServer) CartItemList(ctx context.Context, req *proto.UserIn
 
 	// 转换为响应格式
 	cartItems := make([]*proto.ShopCartInfoResponse, 0, len(shoppingCarts))
-	for _, cart := range shoppingCarts {
-		cartItems = append(cartItems, &proto.ShopCartInfoResponse{
-			Id:         cart.ID,
-			UserId:     cart.User,
-			GoodsId:    cart.Goods,
-			GoodsName:  cart.GoodsName,
-			GoodsImage: cart.GoodsImage,
-			GoodsPrice: cart.GoodsPrice,
-			Nums:       cart.Nums,
-			Checked:    cart.Checked,
-		})
+	for i := range shoppingCarts {
+		cartItems = append(cartItems, cartToInfo(&shoppingCarts[i]))
 	}
 
 	response := &proto.CartItemListResponse{
 		Total:     int32(total),
 		CartItems: cartItems,
 	}
+	// 补充当前价格、上架状态和库存，依赖服务不可用时降级返回快照
+	response.GoodsDegraded, response.StockDegraded = enrichCartItems(ctx, cartItems)
 
 	global.Logger.Infof("成功获取用户购物车列表，用户ID: %d，总数: %d", req.Id, total)
 	return response, nil
@@ -87,33 +80,16 @@ func (s *OrderServiceServer) CartItemAdd(ctx context.Context, req *proto.CartIte
 		return nil, status.Errorf(codes.InvalidArgument, "商品数量必须大于0")
 	}
 	
-	// 验证商品是否存在（通过调用商品服务）
-	goodsClient := goodsproto.NewGoodsClient(global.GoodsClient)
-	goodsDetailReq := &goodsproto.GoodInfoRequest{
-		Id: req.GoodsId,
-	}
-	
-	goodsInfo, err := goodsClient.GetGoodsDetail(ctx, goodsDetailReq)
+	// 验证商品是否存在且已上架（通过调用商品服务）
+	goodsInfo, err := loadCartGoods(ctx, req.GoodsId)
 	if err != nil {
-		global.Logger.Errorf("查询商品详情失败，商品ID: %d, 错误: %v", req.GoodsId, err)
-		if statusErr, ok := status.FromError(err); ok {
-			if statusErr.Code() == codes.NotFound {
-				return nil, status.Errorf(codes.NotFound, "商品不存在")
-			}
-		}
-		return nil, status.Errorf(codes.Internal, "查询商品信息失败")
-	}
-	
-	// 检查商品是否上架
-	if !goodsInfo.OnSale {
-		global.Logger.Warnf("商品已下架，商品ID: %d", req.GoodsId)
-		return nil, status.Errorf(codes.FailedPrecondition, "商品已下架")
+		return nil, err
 	}
-	
+
 	// 使用商品服务返回的实际信息，而不是用户传入的信息
 	// 这样可以确保购物车中的商品信息是最新的
 	req.GoodsName = goodsInfo.Name
-	req.GoodsPrice = goodsInfo.ShopPrice
+	goodsPrice := shopPrice(goodsInfo)
 	// 如果有商品图片，使用第一张图片
 	if len(goodsInfo.Images) > 0 {
 		req.GoodsImage = goodsInfo.Images[0]
@@ -127,10 +103,13 @@ func (s *OrderServiceServer) CartItemAdd(ctx context.Context, req *proto.CartIte
 	if result.Error == nil {
 		// 商品已存在，更新数量和商品信息（价格可能有变化）
 		global.Logger.Infof("购物车中已存在该商品，更新数量: 原数量 %d，新增数量 %d", shoppingCart.Nums, req.Nums)
+		if err := checkCartNums(shoppingCart.Nums + req.Nums); err != nil {
+			return nil, err
+		}
 		shoppingCart.Nums += req.Nums
 		shoppingCart.GoodsName = req.GoodsName
 		shoppingCart.GoodsImage = req.GoodsImage
-		shoppingCart.GoodsPrice = req.GoodsPrice
+		shoppingCart.GoodsPrice = goodsPrice
 
 		if err := global.DB.Save(&shoppingCart).Error; err != nil {
 			global.Logger.Errorf("更新购物车商品数量失败: %v", err)
@@ -141,12 +120,15 @@ func (s *OrderServiceServer) CartItemAdd(ctx context.Context, req *proto.CartIte
 	} else {
 		// 商品不存在，创建新记录
 		global.Logger.Infof("购物车中不存在该商品，创建新记录")
+		if err := checkCartNums(req.Nums); err != nil {
+			return nil, err
+		}
 		shoppingCart = model.ShoppingCart{
 			User:       req.UserId,
 			Goods:      req.GoodsId,
 			GoodsName:  req.GoodsName,
 			GoodsImage: req.GoodsImage,
-			GoodsPrice: req.GoodsPrice,
+			GoodsPrice: goodsPrice,
 			Nums:       req.Nums,
 			Checked:    false,
 		}
@@ -203,6 +185,9 @@ func (s *OrderServiceServer) CartItemUpdate(ctx context.Context, req *proto.Cart
 	// 更新字段
 	updated := false
 	if req.Nums > 0 && req.Nums != shoppingCart.Nums {
+		if err := checkCartNums(req.Nums); err != nil {
+			return nil, err
+		}
 		global.Logger.Infof("更新购物车数量: %d -> %d", shoppingCart.Nums, req.Nums)
 		shoppingCart.Nums = req.Nums
 		updated = true
@@ -267,14 +252,13 @@ func (s *OrderServiceServer) CartItemDelete(ctx context.Context, req *proto.Cart
 // 架构设计说明：
 // 1. 分布式锁：基于Redis的分布式锁，防止用户重复下单
 // 2. 跨服务调用：批量调用商品服务和库存服务，提高性能
-// 3. 数据库事务：保证订单和订单商品的数据一致性
+// 3. Saga编排：各步骤进度持久化，失败或进程崩溃后由恢复任务补偿已扣减的库存
 // 4. 批量插入：使用GORM的CreateInBatches进行高效批量插入
-// 5. 异常回滚：任何环节失败都会回滚事务和库存
+// 5. 本地事务：订单、订单商品、状态日志和购物车清理与Saga进度在同一事务中提交
 //
 // 执行流程：
-// 分布式锁获取 -> 查询购物车 -> 数据库事务开启 -> 创建订单基础信息 
-// -> 批量获取商品信息 -> 验证商品可用性 -> 批量扣减库存 
-// -> 批量插入订单商品 -> 更新订单总额 -> 清空购物车 -> 提交事务 -> 释放锁
+// 分布式锁获取 -> 查询购物车（立即购买时使用请求中的商品） -> [Saga] 批量获取商品信息并验证可用性
+// -> 批量扣减库存 -> 事务内创建订单、订单商品并清空购物车（立即购买不修改购物车） -> 加入超时队列 -> 释放锁
 //
 // 性能优化：
 // - 批量获取商品信息（减少网络调用）
@@ -291,11 +275,10 @@ func (s *OrderServiceServer) OrderCreate(ctx context.Context, req *proto.OrderRe
 		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
 	}
 
-	// 验证用户是否存在（通过查询用户相关的购物车或其他方式间接验证）
-	// 在微服务架构中，我们可以通过查询该用户是否有相关数据来验证用户存在性
+	// 通过用户服务验证用户是否存在，用户服务不可用时默认拒绝下单
 	if valid, err := s.validateUserExists(ctx, req.UserId); err != nil {
 		global.Logger.Errorf("验证用户存在性失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "验证用户信息失败")
+		return nil, status.Errorf(codes.Unavailable, "用户服务不可用，请稍后重试")
 	} else if !valid {
 		global.Logger.Warnf("用户不存在或无效，用户ID: %d", req.UserId)
 		return nil, status.Errorf(codes.NotFound, "用户不存在")
@@ -345,219 +328,88 @@ func (s *OrderServiceServer) OrderCreate(ctx context.Context, req *proto.OrderRe
 		}
 	}()
 
-	// 查询用户购物车中选中的商品
+	// 立即购买时使用指定的商品，否则查询用户购物车中选中的商品
+	var buyNowItems []*proto.OrderGoodsItem
 	var shoppingCarts []model.ShoppingCart
-	if err := global.DB.Where("user = ? AND checked = ?", req.UserId, true).Find(&shoppingCarts).Error; err != nil {
-		global.Logger.Errorf("查询购物车失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "查询购物车失败")
-	}
-
-	if len(shoppingCarts) == 0 {
-		global.Logger.Warn("购物车中没有选中的商品")
-		return nil, status.Errorf(codes.FailedPrecondition, "购物车中没有选中的商品")
-	}
-
-	// 开启数据库事务
-	tx := global.DB.Begin()
-	if tx.Error != nil {
-		global.Logger.Errorf("开启事务失败: %v", tx.Error)
-		return nil, status.Errorf(codes.Internal, "开启事务失败")
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+	if len(req.Items) > 0 {
+		if buyNowItems, err = mergeOrderItems(req.Items); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := global.DB.Where("user = ? AND checked = ?", req.UserId, true).Find(&shoppingCarts).Error; err != nil {
+			global.Logger.Errorf("查询购物车失败: %v", err)
+			return nil, status.Errorf(codes.Internal, "查询购物车失败")
 		}
-	}()
-
-	// 生成订单号
-	orderSn := utils.GenerateOrderSn(req.UserId)
-	
-	// 创建订单基本信息
-	// 设置支付截止时间为30分钟后
-	payDeadline := time.Now().Add(30 * time.Minute)
-	orderInfo := model.OrderInfo{
-		User:         req.UserId,
-		OrderSn:      orderSn,
-		PayType:      "alipay", // 默认支付宝，后续可从请求中获取
-		Status:       "PAYING",  // 初始状态改为待支付
-		OrderMount:   0, // 先设为0，后面计算
-		PayDeadline:  &payDeadline, // 设置支付截止时间
-		Address:      req.Address,
-		SignerName:   req.Name,
-		SingerMobile: req.Mobile,
-		Post:         req.Post,
-	}
-
-	// 保存订单基本信息
-	if err := tx.Create(&orderInfo).Error; err != nil {
-		tx.Rollback()
-		global.Logger.Errorf("创建订单失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "创建订单失败")
-	}
 
-	// ===========================================
-	// 跨服务调用阶段：获取商品信息和扣减库存
-	// ===========================================
-	//
-	// 调用顺序和原因：
-	// 1. 先调用商品服务获取商品详情（价格、库存、上架状态等）
-	// 2. 再调用库存服务扣减库存（确保有足够库存后才扣减）
-	// 3. 在分布式锁保护下进行，确保操作的原子性
-	//
-	// 异常处理策略：
-	// - 任何跨服务调用失败都会回滚数据库事务
-	// - 库存扣减失败会在后续数据库操作失败时自动回滚
-	
-	// 收集购物车中所有商品ID，用于批量查询商品信息
-	goodsIds := make([]int32, len(shoppingCarts))
-	for i, cart := range shoppingCarts {
-		goodsIds[i] = cart.Goods
+		if len(shoppingCarts) == 0 {
+			global.Logger.Warn("购物车中没有选中的商品")
+			return nil, status.Errorf(codes.FailedPrecondition, "购物车中没有选中的商品")
+		}
 	}
-	global.Logger.Infof("准备查询商品信息，商品ID列表: %v", goodsIds)
 
-	// 批量调用商品服务获取商品详情
-	// 使用批量接口提高性能，减少网络调用次数
-	goodsMap, err := utils.GetGoodsByIds(ctx, goodsIds)
+	orderSn, err := utils.GenerateOrderSn()
 	if err != nil {
-		tx.Rollback()
-		global.Logger.Errorf("批量获取商品信息失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "获取商品信息失败")
+		global.Logger.Errorf("生成订单号失败: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "系统忙，请稍后重试")
 	}
-	global.Logger.Infof("成功获取商品信息，数量: %d", len(goodsMap))
-
-	// 验证商品可用性并准备库存扣减数据
-	// 在扣减库存前先验证所有商品的可用性，避免部分扣减后发现问题
-	var sellItems []*inventorypb.GoodsInvInfo
-	var totalAmount float32 = 0
-	
-	global.Logger.Info("开始验证商品可用性并计算订单总额")
-	for _, cart := range shoppingCarts {
-		goodsInfo, exists := goodsMap[cart.Goods]
-		if !exists {
-			tx.Rollback()
-			global.Logger.Errorf("商品不存在，商品ID: %d", cart.Goods)
-			return nil, status.Errorf(codes.NotFound, "商品不存在")
-		}
 
-		// 验证商品是否可用（上架状态、库存充足等）
-		if err := utils.ValidateGoodsAvailability(goodsInfo, cart.Nums); err != nil {
-			tx.Rollback()
-			global.Logger.Errorf("商品验证失败: %v", err)
-			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
-		}
-
-		// 准备库存扣减数据，用于批量调用库存服务
-		sellItems = append(sellItems, &inventorypb.GoodsInvInfo{
-			GoodsId: cart.Goods,
-			Num:     cart.Nums,
-		})
-
-		// 计算订单总金额
-		itemTotal := goodsInfo.ShopPrice * float32(cart.Nums)
-		totalAmount += itemTotal
-		
-		global.Logger.Infof("商品验证通过: %s，单价: %.2f，数量: %d，小计: %.2f", 
-			goodsInfo.Name, goodsInfo.ShopPrice, cart.Nums, itemTotal)
+	// 按Saga执行下单流程，进度持久化，失败或崩溃后补偿已扣减的库存
+	data := &orderCreateData{
+		UserId:   req.UserId,
+		OrderSn:  orderSn,
+		Address:  req.Address,
+		Name:     req.Name,
+		Mobile:   req.Mobile,
+		Post:     req.Post,
+		CouponId: req.CouponId,
 	}
-
-	// 调用库存服务批量扣减库存
-	// 重要：此操作在数据库事务外进行，如果后续数据库操作失败需要手动回滚库存
-	global.Logger.Infof("开始扣减库存，扣减项目数: %d", len(sellItems))
-	if err := utils.SellInventory(ctx, sellItems); err != nil {
-		tx.Rollback()
-		global.Logger.Errorf("库存扣减失败: %v", err)
-		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	for _, cart := range shoppingCarts {
+		data.CartIds = append(data.CartIds, cart.ID)
+		data.Items = append(data.Items, orderCreateItem{GoodsId: cart.Goods, Nums: cart.Nums})
 	}
-	global.Logger.Info("库存扣减成功")
-
-	// 批量创建订单商品记录
-	// 使用批量插入提高性能，减少数据库交互次数
-	// 在分布式锁和事务保护下，确保数据一致性
-	global.Logger.Infof("开始批量创建订单商品记录，商品数量: %d", len(shoppingCarts))
-	
-	// 构建订单商品批量数据
-	orderGoodsList := make([]model.OrderGoods, 0, len(shoppingCarts))
-	for i, cart := range shoppingCarts {
-		goodsInfo := goodsMap[cart.Goods]
-		
-		orderGoods := model.OrderGoods{
-			Order:      int32(orderInfo.ID),
-			Goods:      cart.Goods,
-			GoodsName:  goodsInfo.Name,
-			GoodsImage: goodsInfo.GoodsFrontImage,
-			GoodsPrice: goodsInfo.ShopPrice,
-			Nums:       cart.Nums,
-		}
-		
-		orderGoodsList = append(orderGoodsList, orderGoods)
-		global.Logger.Debugf("准备订单商品[%d]: 商品ID=%d, 商品名=%s, 数量=%d, 单价=%.2f", 
-			i+1, cart.Goods, goodsInfo.Name, cart.Nums, goodsInfo.ShopPrice)
+	for _, item := range buyNowItems {
+		data.Items = append(data.Items, orderCreateItem{GoodsId: item.GoodsId, Nums: item.Nums})
 	}
-	
-	// 执行批量插入
-	// 使用CreateInBatches避免单次插入过多数据导致的性能问题
-	// 批量大小设为100，平衡性能和内存使用
-	const batchSize = 100
-	if err := tx.CreateInBatches(&orderGoodsList, batchSize).Error; err != nil {
-		tx.Rollback()
-		// 批量插入失败时，需要回滚之前成功扣减的库存
-		// 确保库存和订单数据的一致性
-		if rebackErr := utils.RebackInventory(ctx, sellItems); rebackErr != nil {
-			global.Logger.Errorf("批量插入失败后回滚库存失败: %v", rebackErr)
+	if err := saga.Run(ctx, orderCreateSaga, "order_create:"+data.OrderSn, data); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
 		}
-		global.Logger.Errorf("批量创建订单商品失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "批量创建订单商品失败")
+		global.Logger.Errorf("创建订单失败，订单号: %s，错误: %v", data.OrderSn, err)
+		return nil, status.Errorf(codes.Internal, "创建订单失败")
 	}
-	
-	global.Logger.Infof("成功批量创建订单商品记录，总数: %d，批量大小: %d", len(orderGoodsList), batchSize)
 
-	// 更新订单总金额
-	if err := tx.Model(&orderInfo).Update("order_mount", totalAmount).Error; err != nil {
-		tx.Rollback()
-		// 回滚库存
-		if rebackErr := utils.RebackInventory(ctx, sellItems); rebackErr != nil {
-			global.Logger.Errorf("回滚库存失败: %v", rebackErr)
-		}
-		global.Logger.Errorf("更新订单总金额失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "更新订单总金额失败")
+	if len(data.Children) > 0 {
+		return splitOrderResponse(ctx, data)
 	}
 
-	// 清空购物车中的选中商品
-	if err := tx.Where("user = ? AND checked = ?", req.UserId, true).Delete(&model.ShoppingCart{}).Error; err != nil {
-		tx.Rollback()
-		// 回滚库存
-		if rebackErr := utils.RebackInventory(ctx, sellItems); rebackErr != nil {
-			global.Logger.Errorf("回滚库存失败: %v", rebackErr)
-		}
-		global.Logger.Errorf("清空购物车失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "清空购物车失败")
+	var orderInfo model.OrderInfo
+	if err := global.DB.First(&orderInfo, data.OrderId).Error; err != nil {
+		global.Logger.Errorf("查询新建订单失败，订单号: %s，错误: %v", data.OrderSn, err)
+		return nil, status.Errorf(codes.Internal, "查询订单失败")
 	}
 
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		// 回滚库存
-		if rebackErr := utils.RebackInventory(ctx, sellItems); rebackErr != nil {
-			global.Logger.Errorf("回滚库存失败: %v", rebackErr)
-		}
-		global.Logger.Errorf("提交事务失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "提交事务失败")
-	}
+	// 加入超时关闭队列，到达支付截止时间后自动关闭
+	scheduleOrderTimeout(ctx, &orderInfo)
 
 	// 构造返回数据
 	response := &proto.OrderInfoResponse{
-		Id:      int32(orderInfo.ID),
-		UserId:  orderInfo.User,
-		OrderSn: orderInfo.OrderSn,
-		PayType: orderInfo.PayType,
-		Status:  orderInfo.Status,
-		Post:    orderInfo.Post,
-		Total:   totalAmount,
-		Address: orderInfo.Address,
-		Name:    orderInfo.SignerName,
-		Mobile:  orderInfo.SingerMobile,
-	}
-
-	global.Logger.Infof("成功创建订单，订单ID: %d，订单号: %s，总金额: %.2f", orderInfo.ID, orderInfo.OrderSn, totalAmount)
+		Id:             int32(orderInfo.ID),
+		UserId:         orderInfo.User,
+		OrderSn:        orderInfo.OrderSn,
+		PayType:        orderInfo.PayType,
+		Status:         orderInfo.Status,
+		Post:           orderInfo.Post,
+		Address:        orderInfo.Address,
+		Name:           orderInfo.SignerName,
+		Mobile:         orderInfo.SingerMobile,
+		CouponId:       orderInfo.Coupon,
+		GoodsAmount:    int64(orderInfo.GoodsAmount),
+		ShippingFee:    int64(orderInfo.ShippingFee),
+		DiscountAmount: int64(orderInfo.DiscountAmount),
+		PayAmount:      int64(orderInfo.OrderMount),
+	}
+
+	global.Logger.Infof("成功创建订单，订单ID: %d，订单号: %s，应付金额: %s", orderInfo.ID, orderInfo.OrderSn, orderInfo.OrderMount)
 	return response, nil
 }
 
@@ -610,19 +462,8 @@ func (s *OrderServiceServer) OrderList(ctx context.Context, req *proto.OrderFilt
 
 	// 转换为响应格式
 	orderInfos := make([]*proto.OrderInfoResponse, 0, len(orders))
-	for _, order := range orders {
-		orderInfos = append(orderInfos, &proto.OrderInfoResponse{
-			Id:      int32(order.ID),
-			UserId:  order.User,
-			OrderSn: order.OrderSn,
-			PayType: order.PayType,
-			Status:  order.Status,
-			Post:    order.Post,
-			Total:   order.OrderMount,
-			Address: order.Address,
-			Name:    order.SignerName,
-			Mobile:  order.SingerMobile,
-		})
+	for i := range orders {
+		orderInfos = append(orderInfos, orderToInfo(&orders[i]))
 	}
 
 	response := &proto.OrderListResponse{
@@ -670,36 +511,35 @@ func (s *OrderServiceServer) OrderDetail(ctx context.Context, req *proto.OrderRe
 	}
 
 	// 转换为响应格式
-	orderInfoResponse := &proto.OrderInfoResponse{
-		Id:      int32(orderInfo.ID),
-		UserId:  orderInfo.User,
-		OrderSn: orderInfo.OrderSn,
-		PayType: orderInfo.PayType,
-		Status:  orderInfo.Status,
-		Post:    orderInfo.Post,
-		Total:   orderInfo.OrderMount,
-		Address: orderInfo.Address,
-		Name:    orderInfo.SignerName,
-		Mobile:  orderInfo.SingerMobile,
-	}
+	orderInfoResponse := orderToInfo(&orderInfo)
 
 	// 转换订单商品列表
-	orderGoodsResponse := make([]*proto.OrderItemResponse, 0, len(orderGoods))
-	for _, goods := range orderGoods {
-		orderGoodsResponse = append(orderGoodsResponse, &proto.OrderItemResponse{
-			Id:         int32(goods.ID),
-			OrderId:    int32(goods.Order),
-			GoodsId:    goods.Goods,
-			GoodsName:  goods.GoodsName,
-			GoodsImage: goods.GoodsImage,
-			GoodsPrice: goods.GoodsPrice,
-			Nums:       goods.Nums,
+	orderGoodsResponse := orderGoodsToItems(orderGoods)
+
+	// 拆单的优惠明细记在父订单上
+	discountQuery := global.DB.Where("`order` = ?", orderInfo.ID)
+	if orderInfo.ParentSn != "" {
+		discountQuery = global.DB.Where("`order` = 0 AND order_sn = ?", orderInfo.ParentSn)
+	}
+	var discounts []model.OrderDiscount
+	if err := discountQuery.Order("id").Find(&discounts).Error; err != nil {
+		global.Logger.Errorf("查询订单优惠明细失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询订单优惠明细失败")
+	}
+	discountInfos := make([]*proto.DiscountInfo, 0, len(discounts))
+	for _, d := range discounts {
+		discountInfos = append(discountInfos, &proto.DiscountInfo{
+			Source:   d.Source,
+			SourceId: d.SourceId,
+			Name:     d.Name,
+			Amount:   d.Amount,
 		})
 	}
 
 	response := &proto.OrderInfoDetailResponse{
 		OrderInfo: orderInfoResponse,
 		Goods:     orderGoodsResponse,
+		Discounts: discountInfos,
 	}
 
 	global.Logger.Infof("成功获取订单详情，订单ID: %d，商品数量: %d", req.Id, len(orderGoods))
@@ -714,6 +554,9 @@ func (s *OrderServiceServer) OrderUpdate(ctx context.Context, req *proto.OrderSt
 		global.Logger.Error("订单ID或订单号必须提供")
 		return nil, status.Errorf(codes.InvalidArgument, "订单ID或订单号必须提供")
 	}
+	if req.Id <= 0 && !utils.ValidOrderSn(req.OrderSn) {
+		return nil, status.Errorf(codes.InvalidArgument, "订单号无效")
+	}
 	if req.Status == "" {
 		global.Logger.Error("订单状态不能为空")
 		return nil, status.Errorf(codes.InvalidArgument, "订单状态不能为空")
@@ -726,6 +569,8 @@ func (s *OrderServiceServer) OrderUpdate(ctx context.Context, req *proto.OrderSt
 		"TRADE_CLOSED":   true, // 超时关闭
 		"WAIT_BUYER_PAY": true, // 交易创建
 		"TRADE_FINISHED": true, // 交易结束
+		// TRADE_REFUNDED(已退款) 只能由售后流程在全额退款后设置
+		// PART_SHIPPED(部分发货)、SHIPPED(已发货) 只能通过发货接口设置，需要记录物流信息
 	}
 	if !validStatuses[req.Status] {
 		global.Logger.Errorf("无效的订单状态: %s", req.Status)
@@ -783,11 +628,16 @@ func (s *OrderServiceServer) OrderUpdate(ctx context.Context, req *proto.OrderSt
 		return &emptypb.Empty{}, nil
 	}
 
-	// 验证状态转换是否合法
-	if !isValidStatusTransition(oldStatus, req.Status) {
+	// 按目标状态查找状态机事件，找不到说明状态转换不合法
+	event, ok := orderMachine.EventTo(fsm.State(oldStatus), fsm.State(req.Status))
+	if !ok {
 		global.Logger.Warnf("无效的状态转换: %s -> %s", oldStatus, req.Status)
 		return nil, status.Errorf(codes.FailedPrecondition, "无效的状态转换")
 	}
+	actor := req.Actor
+	if actor == "" {
+		actor = actorAdmin
+	}
 
 	// 开启事务更新订单状态
 	tx := global.DB.Begin()
@@ -801,20 +651,11 @@ func (s *OrderServiceServer) OrderUpdate(ctx context.Context, req *proto.OrderSt
 		}
 	}()
 
-	// 更新订单状态
-	updateData := map[string]interface{}{
-		"status": req.Status,
-	}
-	
-	// 如果是支付成功，更新支付时间
-	if req.Status == "TRADE_SUCCESS" {
-		updateData["pay_time"] = time.Now()
-	}
-
-	if err := tx.Model(&model.OrderInfo{}).Where("id = ?", orderInfo.ID).Updates(updateData).Error; err != nil {
+	// 更新订单状态，支付时间和关闭时归还库存由状态机钩子处理
+	if err := transitOrder(ctx, tx, &orderInfo, event, actor, req.Reason, nil); err != nil {
 		tx.Rollback()
 		global.Logger.Errorf("更新订单状态失败: %v", err)
-		return nil, status.Errorf(codes.Internal, "更新订单状态失败")
+		return nil, orderTransitError(err)
 	}
 
 	// 提交事务
@@ -827,31 +668,6 @@ func (s *OrderServiceServer) OrderUpdate(ctx context.Context, req *proto.OrderSt
 	return &emptypb.Empty{}, nil
 }
 
-// isValidStatusTransition 验证订单状态转换是否合法
-func isValidStatusTransition(oldStatus, newStatus string) bool {
-	// 定义合法的状态转换规则
-	transitions := map[string][]string{
-		"WAIT_BUYER_PAY": {"PAYING", "TRADE_CLOSED"},
-		"PAYING":         {"TRADE_SUCCESS", "TRADE_CLOSED"},
-		"TRADE_SUCCESS":  {"TRADE_FINISHED"},
-		"TRADE_CLOSED":   {}, // 已关闭的订单不能再转换
-		"TRADE_FINISHED": {}, // 已完成的订单不能再转换
-	}
-	
-	validNextStates, exists := transitions[oldStatus]
-	if !exists {
-		return false
-	}
-	
-	for _, validState := range validNextStates {
-		if validState == newStatus {
-			return true
-		}
-	}
-	
-	return false
-}
-
 func (s *OrderServiceServer) OrderDelete(ctx context.Context, req *proto.OrderDelRequest) (*emptypb.Empty, error) {
 	global.Logger.Infof("删除订单，订单ID: %d，用户ID: %d", req.Id, req.UserId)
 
@@ -914,8 +730,19 @@ func (s *OrderServiceServer) OrderDelete(ctx context.Context, req *proto.OrderDe
 		return nil, status.Errorf(codes.Internal, "查询订单失败")
 	}
 
-	// 检查订单状态是否允许删除
-	if !isDeletableOrderStatus(orderInfo.Status) {
+	// 未支付的订单先取消（归还库存），只有已关闭的订单才允许删除
+	if orderMachine.Can(fsm.State(orderInfo.Status), fsm.EventCancel) {
+		actor := actorAdmin
+		if req.UserId > 0 {
+			actor = userActor(req.UserId)
+		}
+		if err := transitOrder(ctx, tx, &orderInfo, fsm.EventCancel, actor, "删除订单", nil); err != nil {
+			tx.Rollback()
+			global.Logger.Errorf("取消待删除订单失败，订单ID: %d，错误: %v", req.Id, err)
+			return nil, orderTransitError(err)
+		}
+	}
+	if orderInfo.Status != string(fsm.OrderTradeClosed) {
 		tx.Rollback()
 		global.Logger.Warnf("订单状态不允许删除，当前状态: %s", orderInfo.Status)
 		return nil, status.Errorf(codes.FailedPrecondition, "当前状态的订单不允许删除")
@@ -949,60 +776,20 @@ func (s *OrderServiceServer) OrderDelete(ctx context.Context, req *proto.OrderDe
 		return nil, status.Errorf(codes.Internal, "提交事务失败")
 	}
 
-	global.Logger.Infof("成功删除订单，订单ID: %d，订单号: %s，原状态: %s", req.Id, orderInfo.OrderSn, orderInfo.Status)
+	global.Logger.Infof("成功删除订单，订单ID: %d，订单号: %s", req.Id, orderInfo.OrderSn)
 	return &emptypb.Empty{}, nil
 }
 
-// isDeletableOrderStatus 检查订单状态是否允许删除
-func isDeletableOrderStatus(status string) bool {
-	// 只有特定状态的订单才允许删除
-	deletableStatuses := map[string]bool{
-		"WAIT_BUYER_PAY": true, // 待支付的订单可以删除
-		"TRADE_CLOSED":   true, // 已关闭的订单可以删除
-		"PAYING":         true, // 支付中的订单可以删除（取消支付）
-	}
-	
-	return deletableStatuses[status]
-}
-
-// validateUserExists 验证用户是否存在
-// 在微服务架构中，我们通过以下方式验证用户存在性：
-// 1. 查询用户是否有历史购物车记录
-// 2. 查询用户是否有历史订单记录  
-// 3. 如果都没有，则认为可能是新用户（允许创建订单）
+// validateUserExists 通过用户服务验证用户是否存在，已删除的用户视为不存在
+// 用户服务不可用时默认拒绝下单，配置fail_open后放行并记录告警
 func (s *OrderServiceServer) validateUserExists(ctx context.Context, userID int32) (bool, error) {
-	global.Logger.Infof("验证用户存在性，用户ID: %d", userID)
-
-	// 策略1: 查询用户是否有购物车记录（不管是否删除）
-	var cartCount int64
-	if err := global.DB.Unscoped().Model(&model.ShoppingCart{}).Where("user = ?", userID).Count(&cartCount).Error; err != nil {
-		global.Logger.Errorf("查询用户购物车记录失败: %v", err)
-		return false, err
-	}
-	if cartCount > 0 {
-		global.Logger.Infof("用户存在购物车记录，用户ID: %d", userID)
-		return true, nil
-	}
-
-	// 策略2: 查询用户是否有历史订单记录  
-	var orderCount int64
-	if err := global.DB.Unscoped().Model(&model.OrderInfo{}).Where("user = ?", userID).Count(&orderCount).Error; err != nil {
-		global.Logger.Errorf("查询用户订单记录失败: %v", err)
+	exists, err := utils.CheckUserExists(ctx, userID)
+	if err != nil {
+		if global.ServerConfig.UserSrv.FailOpen {
+			global.Logger.Warnf("用户服务不可用，按配置放行，用户ID: %d，错误: %v", userID, err)
+			return true, nil
+		}
 		return false, err
 	}
-	if orderCount > 0 {
-		global.Logger.Infof("用户存在订单记录，用户ID: %d", userID)
-		return true, nil
-	}
-
-	// 策略3: 对于全新用户，我们通过用户ID的合理性判断
-	// 在实际生产环境中，这里应该调用用户服务验证用户存在性
-	// 为了测试方便，我们假设用户ID在合理范围内（1-1000）就是有效的
-	if userID > 0 && userID <= 1000 {
-		global.Logger.Infof("新用户允许创建订单，用户ID: %d", userID)
-		return true, nil
-	}
-
-	global.Logger.Warnf("用户ID超出合理范围或无效，用户ID: %d", userID)
-	return false, nil
+	return exists, nil
 }
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/promotion"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderPreview 下单前预览，按商品服务的当前售价、库存服务的实时库存、进行中的活动、选择的优惠券和运费规则计算应付金额
+// 只读接口，不扣减库存、不核销优惠券、不写入任何数据
+func (s *OrderServiceServer) OrderPreview(ctx context.Context, req *proto.OrderPreviewRequest) (*proto.OrderPreviewResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+	items, err := previewItems(req)
+	if err != nil {
+		return nil, err
+	}
+
+	goodsIds := make([]int32, 0, len(items))
+	for _, item := range items {
+		goodsIds = append(goodsIds, item.GoodsId)
+	}
+	goodsMap, err := utils.GetGoodsByIds(ctx, goodsIds)
+	if err != nil {
+		global.Logger.Errorf("批量获取商品信息失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "获取商品信息失败")
+	}
+	stocks, err := utils.GetInventories(ctx, goodsIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "查询库存失败")
+	}
+
+	resp := &proto.OrderPreviewResponse{Available: true}
+	priceItems := make([]promotion.Item, 0, len(items))
+	// 参与计价的商品在resp.Items中的下标
+	priced := make([]int, 0, len(items))
+	for _, item := range items {
+		info := &proto.PreviewItemInfo{GoodsId: item.GoodsId, Nums: item.Nums, Stock: stocks[item.GoodsId]}
+		resp.Items = append(resp.Items, info)
+		goodsInfo, ok := goodsMap[item.GoodsId]
+		if !ok {
+			info.Reason = "商品不存在"
+			resp.Available = false
+			continue
+		}
+		info.GoodsName = goodsInfo.Name
+		info.GoodsImage = goodsInfo.GoodsFrontImage
+		info.Price = int64(shopPrice(goodsInfo))
+		info.OnSale = goodsInfo.OnSale
+		switch {
+		case !goodsInfo.OnSale:
+			info.Reason = "商品已下架"
+		case info.Stock < item.Nums:
+			info.Reason = "库存不足"
+		default:
+			info.Available = true
+		}
+		if !info.Available {
+			resp.Available = false
+			continue
+		}
+		priceItems = append(priceItems, promotionItem(goodsInfo, item.Nums))
+		priced = append(priced, len(resp.Items)-1)
+	}
+	if len(priceItems) == 0 {
+		return resp, nil
+	}
+
+	promotions, err := loadActivePromotions(global.DB)
+	if err != nil {
+		global.Logger.Errorf("查询促销活动失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "计算优惠失败")
+	}
+	var coupon *promotion.Coupon
+	if req.CouponId > 0 {
+		if coupon, err = loadUsableCoupon(global.DB, req.UserId, req.CouponId); err != nil {
+			return nil, err
+		}
+	}
+	result, err := calculatePrice(priceItems, promotions, coupon)
+	if err != nil {
+		return nil, err
+	}
+	for i, idx := range priced {
+		resp.Items[idx].Discount = result.ItemDiscounts[i]
+	}
+	resp.GoodsTotal = result.GoodsTotal
+	resp.ShippingFee = result.ShippingFee
+	resp.PromotionDiscount = result.PromotionDiscount
+	resp.CouponDiscount = result.CouponDiscount
+	resp.Payable = result.Payable
+	resp.Discounts = discountsToInfo(result.Discounts)
+	return resp, nil
+}
+
+// previewItems 预览的商品：请求中指定的商品，同一商品合并数量；未指定时取购物车中选中的商品
+func previewItems(req *proto.OrderPreviewRequest) ([]*proto.OrderGoodsItem, error) {
+	if len(req.Items) > 0 {
+		return mergeOrderItems(req.Items)
+	}
+
+	var carts []model.ShoppingCart
+	if err := global.DB.Where("user = ? AND checked = ?", req.UserId, true).Find(&carts).Error; err != nil {
+		global.Logger.Errorf("查询购物车失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	if len(carts) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "购物车中没有选中的商品")
+	}
+	items := make([]*proto.OrderGoodsItem, 0, len(carts))
+	for _, cart := range carts {
+		items = append(items, &proto.OrderGoodsItem{GoodsId: cart.Goods, Nums: cart.Nums})
+	}
+	return items, nil
+}
+
+// mergeOrderItems 校验指定的商品列表，同一商品的数量合并
+func mergeOrderItems(items []*proto.OrderGoodsItem) ([]*proto.OrderGoodsItem, error) {
+	merged := make([]*proto.OrderGoodsItem, 0, len(items))
+	index := make(map[int32]int, len(items))
+	for _, item := range items {
+		if item.GoodsId <= 0 || item.Nums <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "商品ID和数量必须大于0")
+		}
+		if i, ok := index[item.GoodsId]; ok {
+			merged[i].Nums += item.Nums
+			continue
+		}
+		index[item.GoodsId] = len(merged)
+		merged = append(merged, &proto.OrderGoodsItem{GoodsId: item.GoodsId, Nums: item.Nums})
+	}
+	return merged, nil
+}
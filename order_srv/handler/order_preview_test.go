@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"testing"
+
+	"order_srv/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestMergeOrderItems 测试立即购买和预览指定的商品按商品合并数量并保持顺序
+func TestMergeOrderItems(t *testing.T) {
+	items, err := mergeOrderItems([]*proto.OrderGoodsItem{
+		{GoodsId: 3, Nums: 1},
+		{GoodsId: 1, Nums: 2},
+		{GoodsId: 3, Nums: 4},
+	})
+	if err != nil {
+		t.Fatalf("合并商品失败: %v", err)
+	}
+	if len(items) != 2 || items[0].GoodsId != 3 || items[0].Nums != 5 || items[1].GoodsId != 1 || items[1].Nums != 2 {
+		t.Errorf("合并结果不正确: %v", items)
+	}
+
+	for _, invalid := range [][]*proto.OrderGoodsItem{
+		{{GoodsId: 0, Nums: 1}},
+		{{GoodsId: 1, Nums: 0}},
+		{{GoodsId: 1, Nums: 1}, {GoodsId: 2, Nums: -1}},
+	} {
+		if _, err := mergeOrderItems(invalid); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: 期望参数错误，实际 %v", invalid, err)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"order_srv/fsm"
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/promotion"
+	inventorypb "order_srv/proto/inventory"
+	"order_srv/saga"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 订单支付时限
+const orderPayTimeout = 30 * time.Minute
+
+// orderCreateData 下单Saga的业务数据，随每一步的进度持久化
+type orderCreateData struct {
+	UserId   int32             `json:"user_id"`
+	OrderSn  string            `json:"order_sn"`
+	Address  string            `json:"address"`
+	Name     string            `json:"name"`
+	Mobile   string            `json:"mobile"`
+	Post     string            `json:"post"`
+	CouponId int32             `json:"coupon_id"`
+	CartIds  []int32           `json:"cart_ids"` // 立即购买时为空
+	Items    []orderCreateItem `json:"items"`
+	// 金额单位均为分
+	GoodsAmount    int64                `json:"goods_amount"`
+	ShippingFee    int64                `json:"shipping_fee"`
+	DiscountAmount int64                `json:"discount_amount"`
+	PayAmount      int64                `json:"pay_amount"`
+	Discounts      []promotion.Discount `json:"discounts"`
+	OrderId        int32                `json:"order_id"`
+	// Children 拆单后的子订单，不拆单时为空；拆单时OrderSn为父订单号
+	Children []orderCreateChild `json:"children"`
+}
+
+// orderCreateChild 拆单后的子订单，金额单位为分
+type orderCreateChild struct {
+	OrderSn        string `json:"order_sn"`
+	Items          []int  `json:"items"` // 商品在orderCreateData.Items中的下标
+	GoodsAmount    int64  `json:"goods_amount"`
+	ShippingFee    int64  `json:"shipping_fee"`
+	DiscountAmount int64  `json:"discount_amount"`
+	PayAmount      int64  `json:"pay_amount"`
+	OrderId        int32  `json:"order_id"`
+}
+
+type orderCreateItem struct {
+	GoodsId int32  `json:"goods_id"`
+	Name    string `json:"name"`
+	Image   string `json:"image"`
+	// Price 单价（分）
+	Price int64 `json:"unit_price"`
+	Nums  int32 `json:"nums"`
+	// Discount 分摊的优惠金额（分）
+	Discount int64 `json:"discount"`
+	BrandId  int32 `json:"brand_id"`
+	ShipFree bool  `json:"ship_free"`
+}
+
+// orderCreateSaga 下单流程：查询商品并拆单 -> 扣减库存 -> 创建订单并清空购物车
+// 创建订单失败或进程崩溃时逆序补偿，已扣减的库存通过发件箱归还
+var orderCreateSaga = &saga.Definition{
+	Name: "order_create",
+	Steps: []saga.Step{
+		{Name: "load_goods", Action: loadOrderGoods},
+		// 扣减中途崩溃时无法确定库存是否已扣减，不自动归还，由恢复任务告警后人工核对
+		{Name: "sell_stock", Action: sellOrderStock, Compensate: rebackOrderStock},
+		{Name: "create_order", LocalAction: createOrderRecords},
+	},
+	NewData: func() interface{} { return &orderCreateData{} },
+}
+
+func init() {
+	saga.Register(orderCreateSaga)
+}
+
+// loadOrderGoods 批量获取商品信息，校验可用性，按进行中的活动、选择的优惠券和运费规则计算应付金额，再按拆单键拆分子订单
+func loadOrderGoods(ctx context.Context, data interface{}) error {
+	d := data.(*orderCreateData)
+	goodsIds := make([]int32, len(d.Items))
+	for i, item := range d.Items {
+		goodsIds[i] = item.GoodsId
+	}
+	goodsMap, err := utils.GetGoodsByIds(ctx, goodsIds)
+	if err != nil {
+		global.Logger.Errorf("批量获取商品信息失败: %v", err)
+		return status.Errorf(codes.Internal, "获取商品信息失败")
+	}
+
+	priceItems := make([]promotion.Item, 0, len(d.Items))
+	for i := range d.Items {
+		item := &d.Items[i]
+		goodsInfo, exists := goodsMap[item.GoodsId]
+		if !exists {
+			global.Logger.Errorf("商品不存在，商品ID: %d", item.GoodsId)
+			return status.Errorf(codes.NotFound, "商品不存在")
+		}
+		if err := utils.ValidateGoodsAvailability(goodsInfo, item.Nums); err != nil {
+			global.Logger.Errorf("商品验证失败: %v", err)
+			return status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		item.Name = goodsInfo.Name
+		item.Image = goodsInfo.GoodsFrontImage
+		item.Price = int64(shopPrice(goodsInfo))
+		item.BrandId = goodsInfo.BrandId
+		item.ShipFree = goodsInfo.ShipFree
+		priceItems = append(priceItems, promotionItem(goodsInfo, item.Nums))
+	}
+
+	promotions, err := loadActivePromotions(global.DB)
+	if err != nil {
+		global.Logger.Errorf("查询促销活动失败: %v", err)
+		return status.Errorf(codes.Internal, "计算优惠失败")
+	}
+	var coupon *promotion.Coupon
+	if d.CouponId > 0 {
+		if coupon, err = loadUsableCoupon(global.DB, d.UserId, d.CouponId); err != nil {
+			return err
+		}
+	}
+	result, err := calculatePrice(priceItems, promotions, coupon)
+	if err != nil {
+		return err
+	}
+	for i := range d.Items {
+		d.Items[i].Discount = result.ItemDiscounts[i]
+	}
+	d.GoodsAmount = result.GoodsTotal
+	d.ShippingFee = result.ShippingFee
+	d.DiscountAmount = result.PromotionDiscount + result.CouponDiscount
+	d.PayAmount = result.Payable
+	d.Discounts = result.Discounts
+	children, err := splitOrder(d)
+	if err != nil {
+		global.Logger.Errorf("拆单失败: %v", err)
+		return status.Errorf(codes.Internal, "拆单失败")
+	}
+	d.Children = children
+	return nil
+}
+
+// sellOrderStock 调用库存服务批量扣减库存
+func sellOrderStock(ctx context.Context, data interface{}) error {
+	d := data.(*orderCreateData)
+	sellItems := make([]*inventorypb.GoodsInvInfo, 0, len(d.Items))
+	for _, item := range d.Items {
+		sellItems = append(sellItems, &inventorypb.GoodsInvInfo{GoodsId: item.GoodsId, Num: item.Nums})
+	}
+	if err := utils.SellInventory(ctx, sellItems); err != nil {
+		global.Logger.Errorf("库存扣减失败: %v", err)
+		return status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
+// rebackOrderStock 补偿库存扣减，写入发件箱由投递任务归还
+func rebackOrderStock(ctx context.Context, data interface{}) error {
+	d := data.(*orderCreateData)
+	items := make([]stockItem, 0, len(d.Items))
+	for _, item := range d.Items {
+		items = append(items, stockItem{GoodsId: item.GoodsId, Nums: item.Nums})
+	}
+	return compensateStockSell(d.OrderSn, items)
+}
+
+// createOrderRecords 核销优惠券，创建订单、订单商品、优惠明细和状态日志并删除已下单的购物车记录
+// 拆单时先创建父订单，再逐个创建子订单，优惠券和优惠明细记在父订单上
+// 与Saga进度在同一事务中提交，优惠券核销失败时整个事务回滚并补偿已扣减的库存
+func createOrderRecords(ctx context.Context, tx *gorm.DB, data interface{}) error {
+	d := data.(*orderCreateData)
+	if d.CouponId > 0 {
+		if err := redeemCoupon(tx, d.UserId, d.CouponId, d.OrderSn); err != nil {
+			return err
+		}
+	}
+	payDeadline := time.Now().Add(orderPayTimeout)
+
+	discountOrder := int32(0)
+	if len(d.Children) == 0 {
+		allItems := make([]int, len(d.Items))
+		for i := range allItems {
+			allItems[i] = i
+		}
+		orderInfo, err := createChildOrder(tx, d, &orderCreateChild{
+			OrderSn:        d.OrderSn,
+			Items:          allItems,
+			GoodsAmount:    d.GoodsAmount,
+			ShippingFee:    d.ShippingFee,
+			DiscountAmount: d.DiscountAmount,
+			PayAmount:      d.PayAmount,
+		}, "", payDeadline)
+		if err != nil {
+			return err
+		}
+		d.OrderId = int32(orderInfo.ID)
+		discountOrder = d.OrderId
+	} else {
+		parent := model.ParentOrder{
+			ParentSn:       d.OrderSn,
+			User:           d.UserId,
+			Status:         string(fsm.OrderPaying),
+			GoodsAmount:    model.Money(d.GoodsAmount),
+			ShippingFee:    model.Money(d.ShippingFee),
+			DiscountAmount: model.Money(d.DiscountAmount),
+			PayAmount:      model.Money(d.PayAmount),
+			PayDeadline:    &payDeadline,
+			Coupon:         d.CouponId,
+		}
+		if err := tx.Create(&parent).Error; err != nil {
+			return fmt.Errorf("创建父订单失败: %w", err)
+		}
+		for i := range d.Children {
+			orderInfo, err := createChildOrder(tx, d, &d.Children[i], d.OrderSn, payDeadline)
+			if err != nil {
+				return err
+			}
+			d.Children[i].OrderId = int32(orderInfo.ID)
+		}
+		d.OrderId = d.Children[0].OrderId
+	}
+
+	if len(d.Discounts) > 0 {
+		discounts := make([]model.OrderDiscount, 0, len(d.Discounts))
+		for _, discount := range d.Discounts {
+			discounts = append(discounts, model.OrderDiscount{
+				Order:    discountOrder,
+				OrderSn:  d.OrderSn,
+				Source:   discount.Source,
+				SourceId: discount.SourceId,
+				Name:     discount.Name,
+				Amount:   discount.Amount,
+			})
+		}
+		if err := tx.Create(&discounts).Error; err != nil {
+			return fmt.Errorf("保存订单优惠明细失败: %w", err)
+		}
+	}
+
+	// 只删除参与下单的购物车记录，下单期间新勾选的商品保留；立即购买不修改购物车
+	if len(d.CartIds) == 0 {
+		return nil
+	}
+	if err := tx.Where("id IN ? AND user = ?", d.CartIds, d.UserId).Delete(&model.ShoppingCart{}).Error; err != nil {
+		return fmt.Errorf("清空购物车失败: %w", err)
+	}
+	return nil
+}
+
+// createChildOrder 创建一个订单及其商品和状态日志，parentSn为空表示未拆单
+func createChildOrder(tx *gorm.DB, d *orderCreateData, child *orderCreateChild, parentSn string, payDeadline time.Time) (*model.OrderInfo, error) {
+	orderInfo := model.OrderInfo{
+		User:           d.UserId,
+		OrderSn:        child.OrderSn,
+		PayType:        "alipay", // 默认支付宝，后续可从请求中获取
+		Status:         string(fsm.OrderPaying),
+		GoodsAmount:    model.Money(child.GoodsAmount),
+		ShippingFee:    model.Money(child.ShippingFee),
+		DiscountAmount: model.Money(child.DiscountAmount),
+		OrderMount:     model.Money(child.PayAmount),
+		PayDeadline:    &payDeadline,
+		Address:        d.Address,
+		SignerName:     d.Name,
+		SingerMobile:   d.Mobile,
+		Post:           d.Post,
+		Coupon:         d.CouponId,
+		ParentSn:       parentSn,
+	}
+	if err := tx.Create(&orderInfo).Error; err != nil {
+		return nil, fmt.Errorf("创建订单失败: %w", err)
+	}
+	if err := createOrderStatusLog(tx, &orderInfo, "", orderInfo.Status, fsm.EventCreate, userActor(d.UserId), "创建订单"); err != nil {
+		return nil, fmt.Errorf("记录订单状态失败: %w", err)
+	}
+
+	orderGoodsList := make([]model.OrderGoods, 0, len(child.Items))
+	for _, idx := range child.Items {
+		item := d.Items[idx]
+		orderGoodsList = append(orderGoodsList, model.OrderGoods{
+			Order:          int32(orderInfo.ID),
+			Goods:          item.GoodsId,
+			GoodsName:      item.Name,
+			GoodsImage:     item.Image,
+			GoodsPrice:     model.Money(item.Price),
+			Nums:           item.Nums,
+			DiscountAmount: model.Money(item.Discount),
+		})
+	}
+	if err := tx.CreateInBatches(&orderGoodsList, 100).Error; err != nil {
+		return nil, fmt.Errorf("批量创建订单商品失败: %w", err)
+	}
+	return &orderInfo, nil
+}
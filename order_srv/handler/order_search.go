@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	orderSearchDefaultLimit = 20
+	orderSearchMaxLimit     = 100
+	orderExportBatchSize    = 200
+	// 导出必须指定下单时间范围，单次最多导出该时长内的订单
+	orderExportMaxRange = 93 * 24 * time.Hour
+)
+
+// orderSortColumns 支持的排序字段，排序值相同时再按ID排序保证游标稳定
+var orderSortColumns = map[string]string{
+	"id":         "id",
+	"add_time":   "created_at",
+	"pay_time":   "pay_time",
+	"pay_amount": "order_mount",
+}
+
+// orderCursor 游标记录上一页最后一条的排序值和ID，排序条件变化后游标失效
+type orderCursor struct {
+	SortBy    string `json:"s"`
+	Ascending bool   `json:"a"`
+	Value     string `json:"v"`
+	Id        int32  `json:"i"`
+}
+
+// OrderSearch 后台跨用户搜索订单，按排序字段和ID做游标分页，深翻页不会变慢
+func (s *OrderServiceServer) OrderSearch(ctx context.Context, req *proto.OrderSearchRequest) (*proto.OrderSearchResponse, error) {
+	global.Logger.Infof("后台搜索订单，条件: %+v", req)
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = orderSearchDefaultLimit
+	}
+	if limit > orderSearchMaxLimit {
+		limit = orderSearchMaxLimit
+	}
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = "id"
+	}
+	if _, ok := orderSortColumns[sortBy]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的排序字段: %s", sortBy)
+	}
+
+	query, err := orderSearchQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	var after *orderCursor
+	if req.Cursor != "" {
+		if after, err = decodeOrderCursor(req.Cursor, sortBy, req.Ascending); err != nil {
+			return nil, err
+		}
+	}
+	orders, err := searchOrderPage(query, sortBy, req.Ascending, after, limit+1)
+	if err != nil {
+		global.Logger.Errorf("搜索订单失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "搜索订单失败")
+	}
+
+	resp := &proto.OrderSearchResponse{}
+	if len(orders) > limit {
+		orders = orders[:limit]
+		resp.NextCursor = encodeOrderCursor(newOrderCursor(&orders[limit-1], sortBy, req.Ascending))
+	}
+	for i := range orders {
+		resp.Data = append(resp.Data, orderToInfo(&orders[i]))
+	}
+	return resp, nil
+}
+
+// OrderExport 按搜索条件流式导出订单及商品明细，按ID升序分批查询，避免一次加载全部数据
+// 拆单子订单的优惠明细记在父订单上，导出不包含优惠明细，对账时以订单金额字段为准
+func (s *OrderServiceServer) OrderExport(req *proto.OrderSearchRequest, stream proto.OrderService_OrderExportServer) error {
+	global.Logger.Infof("导出订单，条件: %+v", req)
+
+	if req.StartTime <= 0 || req.EndTime <= req.StartTime {
+		return status.Errorf(codes.InvalidArgument, "导出必须指定下单时间范围")
+	}
+	if time.Duration(req.EndTime-req.StartTime)*time.Second > orderExportMaxRange {
+		return status.Errorf(codes.InvalidArgument, "单次导出的时间范围不能超过%d天", int(orderExportMaxRange.Hours()/24))
+	}
+	query, err := orderSearchQuery(req)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	var after *orderCursor
+	exported := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		orders, err := searchOrderPage(query, "id", true, after, orderExportBatchSize)
+		if err != nil {
+			global.Logger.Errorf("导出订单查询失败: %v", err)
+			return status.Errorf(codes.Internal, "导出订单失败")
+		}
+		if len(orders) == 0 {
+			break
+		}
+
+		ids := make([]int32, len(orders))
+		for i := range orders {
+			ids[i] = orders[i].ID
+		}
+		var goods []model.OrderGoods
+		if err := global.DB.Where("`order` IN ?", ids).Order("id").Find(&goods).Error; err != nil {
+			global.Logger.Errorf("导出订单商品查询失败: %v", err)
+			return status.Errorf(codes.Internal, "导出订单失败")
+		}
+		goodsByOrder := make(map[int32][]model.OrderGoods, len(orders))
+		for _, g := range goods {
+			goodsByOrder[g.Order] = append(goodsByOrder[g.Order], g)
+		}
+
+		for i := range orders {
+			if err := stream.Send(&proto.OrderInfoDetailResponse{
+				OrderInfo: orderToInfo(&orders[i]),
+				Goods:     orderGoodsToItems(goodsByOrder[orders[i].ID]),
+			}); err != nil {
+				global.Logger.Warnf("发送导出数据失败，已导出: %d，错误: %v", exported, err)
+				return err
+			}
+			exported++
+		}
+		if len(orders) < orderExportBatchSize {
+			break
+		}
+		after = newOrderCursor(&orders[len(orders)-1], "id", true)
+	}
+
+	global.Logger.Infof("导出订单完成，共 %d 个订单", exported)
+	return nil
+}
+
+// orderSearchQuery 按搜索条件构造查询，不包含排序和分页
+func orderSearchQuery(req *proto.OrderSearchRequest) (*gorm.DB, error) {
+	if req.MinAmount < 0 || req.MaxAmount < 0 || (req.MaxAmount > 0 && req.MinAmount > req.MaxAmount) {
+		return nil, status.Errorf(codes.InvalidArgument, "金额范围无效")
+	}
+	if req.StartTime > 0 && req.EndTime > 0 && req.StartTime >= req.EndTime {
+		return nil, status.Errorf(codes.InvalidArgument, "时间范围无效")
+	}
+
+	if req.OrderSn != "" && !utils.ValidOrderSn(req.OrderSn) {
+		return nil, status.Errorf(codes.InvalidArgument, "订单号无效")
+	}
+
+	query := global.DB.Model(&model.OrderInfo{})
+	if req.OrderSn != "" {
+		query = query.Where("order_sn = ? OR parent_sn = ?", req.OrderSn, req.OrderSn)
+	}
+	if req.TradeNo != "" {
+		query = query.Where("trade_no = ?", req.TradeNo)
+	}
+	if req.Mobile != "" {
+		query = query.Where("singer_mobile = ?", req.Mobile)
+	}
+	if req.Name != "" {
+		query = query.Where("signer_name LIKE ?", escapeLike(req.Name)+"%")
+	}
+	if req.UserId > 0 {
+		query = query.Where("user = ?", req.UserId)
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+	if req.StartTime > 0 {
+		query = query.Where("created_at >= ?", time.Unix(req.StartTime, 0))
+	}
+	if req.EndTime > 0 {
+		query = query.Where("created_at < ?", time.Unix(req.EndTime, 0))
+	}
+	if req.MinAmount > 0 {
+		query = query.Where("order_mount >= ?", model.Money(req.MinAmount))
+	}
+	if req.MaxAmount > 0 {
+		query = query.Where("order_mount <= ?", model.Money(req.MaxAmount))
+	}
+	if req.GoodsId > 0 {
+		query = query.Where("id IN (?)", global.DB.Model(&model.OrderGoods{}).Select("`order`").Where("goods = ?", req.GoodsId))
+	}
+	return query, nil
+}
+
+// searchOrderPage 在查询条件上按游标取下一页，after为空时从第一条开始
+func searchOrderPage(query *gorm.DB, sortBy string, ascending bool, after *orderCursor, limit int) ([]model.OrderInfo, error) {
+	column := orderSortColumns[sortBy]
+	dir, cmp := "DESC", "<"
+	if ascending {
+		dir, cmp = "ASC", ">"
+	}
+
+	q := query.Session(&gorm.Session{})
+	if after != nil {
+		if column == "id" {
+			q = q.Where("id "+cmp+" ?", after.Id)
+		} else {
+			value, err := after.value(sortBy)
+			if err != nil {
+				return nil, err
+			}
+			// 未支付订单的支付时间为NULL，NULL排在最小的一端：降序时在最后，升序时在最前
+			if value == nil {
+				if ascending {
+					q = q.Where(fmt.Sprintf("(%s IS NULL AND id > ?) OR %s IS NOT NULL", column, column), after.Id)
+				} else {
+					q = q.Where(fmt.Sprintf("%s IS NULL AND id < ?", column), after.Id)
+				}
+			} else if ascending {
+				q = q.Where(fmt.Sprintf("%s > ? OR (%s = ? AND id > ?)", column, column), value, value, after.Id)
+			} else {
+				q = q.Where(fmt.Sprintf("%s < ? OR (%s = ? AND id < ?) OR %s IS NULL", column, column, column), value, value, after.Id)
+			}
+		}
+	}
+	if column != "id" {
+		q = q.Order(column + " " + dir)
+	}
+
+	var orders []model.OrderInfo
+	err := q.Order("id " + dir).Limit(limit).Find(&orders).Error
+	return orders, err
+}
+
+func newOrderCursor(order *model.OrderInfo, sortBy string, ascending bool) *orderCursor {
+	c := &orderCursor{SortBy: sortBy, Ascending: ascending, Id: order.ID}
+	switch sortBy {
+	case "add_time":
+		c.Value = order.CreatedAt.Format(time.RFC3339Nano)
+	case "pay_time":
+		if order.PayTime != nil {
+			c.Value = order.PayTime.Format(time.RFC3339Nano)
+		}
+	case "pay_amount":
+		c.Value = strconv.FormatInt(int64(order.OrderMount), 10)
+	}
+	return c
+}
+
+// value 游标中的排序值还原为查询参数，pay_time为空串表示NULL
+func (c *orderCursor) value(sortBy string) (interface{}, error) {
+	switch sortBy {
+	case "add_time", "pay_time":
+		if c.Value == "" {
+			return nil, nil
+		}
+		return time.Parse(time.RFC3339Nano, c.Value)
+	case "pay_amount":
+		cents, err := strconv.ParseInt(c.Value, 10, 64)
+		return model.Money(cents), err
+	}
+	return nil, fmt.Errorf("不支持的排序字段: %s", sortBy)
+}
+
+func encodeOrderCursor(c *orderCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeOrderCursor(s, sortBy string, ascending bool) (*orderCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "无效的分页游标")
+	}
+	var c orderCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "无效的分页游标")
+	}
+	if c.SortBy != sortBy || c.Ascending != ascending {
+		return nil, status.Errorf(codes.InvalidArgument, "排序条件已变化，请从第一页重新查询")
+	}
+	if _, err := c.value(sortBy); err != nil && sortBy != "id" {
+		return nil, status.Errorf(codes.InvalidArgument, "无效的分页游标")
+	}
+	return &c, nil
+}
+
+// escapeLike 转义LIKE通配符，避免用户输入的%和_匹配任意字符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
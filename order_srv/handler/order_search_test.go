@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"order_srv/model"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestOrderCursorRoundTrip 测试各排序字段的游标编码后能还原出相同的排序值
+func TestOrderCursorRoundTrip(t *testing.T) {
+	payTime := time.Date(2025, 6, 1, 12, 30, 45, 123456000, time.Local)
+	order := &model.OrderInfo{
+		BaseModel:  model.BaseModel{ID: 42, CreatedAt: payTime.Add(-time.Hour)},
+		OrderMount: model.Money(12345),
+		PayTime:    &payTime,
+	}
+	unpaid := &model.OrderInfo{BaseModel: model.BaseModel{ID: 43, CreatedAt: payTime}}
+
+	cases := []struct {
+		name  string
+		order *model.OrderInfo
+		sort  string
+		want  interface{}
+	}{
+		{"id", order, "id", nil},
+		{"add_time", order, "add_time", order.CreatedAt},
+		{"pay_time", order, "pay_time", payTime},
+		{"pay_time为空", unpaid, "pay_time", nil},
+		{"pay_amount", order, "pay_amount", model.Money(12345)},
+	}
+	for _, c := range cases {
+		for _, asc := range []bool{true, false} {
+			got, err := decodeOrderCursor(encodeOrderCursor(newOrderCursor(c.order, c.sort, asc)), c.sort, asc)
+			if err != nil {
+				t.Fatalf("%s: 解析游标失败: %v", c.name, err)
+			}
+			if got.Id != c.order.ID || got.SortBy != c.sort || got.Ascending != asc {
+				t.Errorf("%s: 游标不一致: %+v", c.name, got)
+			}
+			if c.sort == "id" {
+				continue
+			}
+			value, err := got.value(c.sort)
+			if err != nil {
+				t.Fatalf("%s: 还原排序值失败: %v", c.name, err)
+			}
+			switch want := c.want.(type) {
+			case time.Time:
+				if v, ok := value.(time.Time); !ok || !v.Equal(want) {
+					t.Errorf("%s: 排序值 = %v，期望 %v", c.name, value, want)
+				}
+			default:
+				if value != c.want {
+					t.Errorf("%s: 排序值 = %v，期望 %v", c.name, value, c.want)
+				}
+			}
+		}
+	}
+}
+
+// TestDecodeOrderCursorInvalid 测试无效的游标和排序条件变化后的游标返回参数错误
+func TestDecodeOrderCursorInvalid(t *testing.T) {
+	order := &model.OrderInfo{BaseModel: model.BaseModel{ID: 1}, OrderMount: model.Money(100)}
+	valid := encodeOrderCursor(newOrderCursor(order, "pay_amount", false))
+
+	cases := []struct {
+		name   string
+		cursor string
+		sort   string
+		asc    bool
+	}{
+		{"非base64", "!!!", "pay_amount", false},
+		{"非JSON", "bm90IGpzb24", "pay_amount", false},
+		{"排序字段变化", valid, "add_time", false},
+		{"排序方向变化", valid, "pay_amount", true},
+		{"排序值无效", encodeOrderCursor(&orderCursor{SortBy: "pay_amount", Value: "abc", Id: 1}), "pay_amount", false},
+		{"时间无效", encodeOrderCursor(&orderCursor{SortBy: "add_time", Value: "yesterday", Id: 1}), "add_time", false},
+	}
+	for _, c := range cases {
+		_, err := decodeOrderCursor(c.cursor, c.sort, c.asc)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: 期望参数错误，实际 %v", c.name, err)
+		}
+	}
+}
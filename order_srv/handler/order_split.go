@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"order_srv/fsm"
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/payment"
+	"order_srv/proto"
+	"order_srv/split"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 拆单的子订单关闭时一并关闭父订单和其余未支付的子订单
+// 关闭其余子订单需要使用订单状态机，在状态机初始化后注册避免初始化循环
+func init() {
+	orderMachine.AfterEnter(fsm.OrderTradeClosed, func(ctx context.Context, c *fsm.Context) error {
+		t := c.Payload.(*orderTransition)
+		if err := closeParentOrder(ctx, t.tx, t.order, c.Event); err != nil {
+			return fmt.Errorf("关闭父订单失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// splitOrder 按配置的拆单键将商品分组，只有一组时不拆单
+// 每组的优惠为组内商品分摊优惠之和，运费按组内不包邮商品优惠后的金额分摊
+func splitOrder(d *orderCreateData) ([]orderCreateChild, error) {
+	cfg := global.ServerConfig.Split
+	key, err := split.Get(cfg.Key)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]split.Item, len(d.Items))
+	for i, item := range d.Items {
+		items[i] = split.Item{
+			GoodsId:     item.GoodsId,
+			BrandId:     item.BrandId,
+			WarehouseId: cfg.Warehouses[strconv.Itoa(int(item.GoodsId))],
+			MerchantId:  cfg.Merchants[strconv.Itoa(int(item.BrandId))],
+		}
+	}
+	groups := split.GroupItems(items, key)
+	if len(groups) <= 1 {
+		return nil, nil
+	}
+
+	children := make([]orderCreateChild, len(groups))
+	shippingWeights := make([]int64, len(groups))
+	for g, group := range groups {
+		child := &children[g]
+		// 子订单号单独生成，与父订单号一样带校验码，通过parent_sn关联父订单
+		orderSn, err := utils.GenerateOrderSn()
+		if err != nil {
+			return nil, err
+		}
+		child.OrderSn = orderSn
+		child.Items = group.Indexes
+		for _, idx := range group.Indexes {
+			item := d.Items[idx]
+			total := item.Price * int64(item.Nums)
+			child.GoodsAmount += total
+			child.DiscountAmount += item.Discount
+			if !item.ShipFree {
+				shippingWeights[g] += total - item.Discount
+			}
+		}
+	}
+	for g, fee := range split.Allocate(d.ShippingFee, shippingWeights) {
+		children[g].ShippingFee = fee
+		children[g].PayAmount = children[g].GoodsAmount - children[g].DiscountAmount + fee
+	}
+	return children, nil
+}
+
+// paymentOrderSn 订单支付使用的商户订单号，拆单的子订单使用父订单号
+func paymentOrderSn(order *model.OrderInfo) string {
+	if order.ParentSn != "" {
+		return order.ParentSn
+	}
+	return order.OrderSn
+}
+
+// loadParentOrder 按父订单号查询父订单
+func loadParentOrder(db *gorm.DB, parentSn string) (*model.ParentOrder, error) {
+	var parent model.ParentOrder
+	if err := db.Where("parent_sn = ?", parentSn).First(&parent).Error; err != nil {
+		return nil, err
+	}
+	return &parent, nil
+}
+
+// markParentPaid 父订单支付成功，在同一事务中将所有待支付的子订单置为已支付
+// 父订单状态更新带有状态条件，重复回调时只补记支付单
+func markParentPaid(ctx context.Context, payType string, n *payment.Notification, parent *model.ParentOrder, paidAt time.Time) error {
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		record := model.PaymentRecord{
+			OrderSn:    parent.ParentSn,
+			PayType:    payType,
+			TradeNo:    n.TradeNo,
+			Amount:     n.AmountCents,
+			Status:     model.PaymentStatusSuccess,
+			PaidAt:     &paidAt,
+			NotifyData: n.Raw,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "order_sn"}, {Name: "pay_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"trade_no", "amount", "status", "paid_at", "notify_data", "updated_at"}),
+		}).Create(&record).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.ParentOrder{}).
+			Where("id = ? AND status = ?", parent.ID, string(fsm.OrderPaying)).
+			Updates(map[string]interface{}{
+				"status":   string(fsm.OrderTradeSuccess),
+				"pay_type": payType,
+				"trade_no": n.TradeNo,
+				"pay_time": paidAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var current model.ParentOrder
+			if err := tx.First(&current, parent.ID).Error; err != nil {
+				return err
+			}
+			if current.Status != string(fsm.OrderTradeSuccess) || current.TradeNo != n.TradeNo {
+				global.Logger.Errorf("父订单已处于%s状态但收到支付成功回调，需要人工退款，父订单号: %s，交易号: %s",
+					current.Status, parent.ParentSn, n.TradeNo)
+			}
+			return nil
+		}
+
+		var children []model.OrderInfo
+		if err := tx.Where("parent_sn = ?", parent.ParentSn).Order("id").Find(&children).Error; err != nil {
+			return err
+		}
+		for i := range children {
+			child := &children[i]
+			if !orderMachine.Can(fsm.State(child.Status), fsm.EventPaySuccess) {
+				global.Logger.Errorf("子订单处于%s状态，无法同步父订单支付结果，需要人工处理，订单号: %s", child.Status, child.OrderSn)
+				continue
+			}
+			if err := transitOrder(ctx, tx, child, fsm.EventPaySuccess, "payment:"+payType, "父订单支付成功，渠道交易号: "+n.TradeNo, map[string]interface{}{
+				"pay_type": payType,
+				"trade_no": n.TradeNo,
+				"pay_time": paidAt,
+			}); err != nil {
+				return fmt.Errorf("同步子订单%s支付状态失败: %w", child.OrderSn, err)
+			}
+		}
+		global.Logger.Infof("父订单支付成功，父订单号: %s，子订单数: %d，渠道交易号: %s", parent.ParentSn, len(children), n.TradeNo)
+		return nil
+	})
+}
+
+// closeParentOrder 未支付的子订单关闭时关闭父订单、退回优惠券并关闭其余子订单
+// 父订单只整体支付，不允许部分子订单关闭后再支付；父订单的条件更新保证只处理一次
+func closeParentOrder(ctx context.Context, tx *gorm.DB, order *model.OrderInfo, event fsm.Event) error {
+	if order.ParentSn == "" {
+		return nil
+	}
+	result := tx.Model(&model.ParentOrder{}).
+		Where("parent_sn = ? AND status = ?", order.ParentSn, string(fsm.OrderPaying)).
+		Update("status", string(fsm.OrderTradeClosed))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil
+	}
+	parent, err := loadParentOrder(tx, order.ParentSn)
+	if err != nil {
+		return err
+	}
+	if err := releaseParentCoupon(tx, parent); err != nil {
+		return err
+	}
+
+	var siblings []model.OrderInfo
+	if err := tx.Where("parent_sn = ? AND id <> ?", order.ParentSn, order.ID).Find(&siblings).Error; err != nil {
+		return err
+	}
+	for i := range siblings {
+		sibling := &siblings[i]
+		if !orderMachine.Can(fsm.State(sibling.Status), event) {
+			continue
+		}
+		if err := transitOrder(ctx, tx, sibling, event, actorSystem, "同一父订单的子订单"+order.OrderSn+"已关闭", map[string]interface{}{}); err != nil {
+			if errors.Is(err, errOrderStatusChanged) {
+				continue
+			}
+			return fmt.Errorf("关闭子订单%s失败: %w", sibling.OrderSn, err)
+		}
+	}
+	return nil
+}
+
+// releaseParentCoupon 父订单关闭后退回核销的优惠券
+func releaseParentCoupon(tx *gorm.DB, parent *model.ParentOrder) error {
+	if parent.Coupon == 0 {
+		return nil
+	}
+	return tx.Model(&model.UserCoupon{}).
+		Where("id = ? AND status = ? AND order_sn = ?", parent.Coupon, model.CouponStatusUsed, parent.ParentSn).
+		Updates(map[string]interface{}{"status": model.CouponStatusUnused, "order_sn": "", "used_at": nil}).Error
+}
+
+// splitOrderResponse 拆单下单成功后查询父订单和子订单，子订单分别加入超时关闭队列
+func splitOrderResponse(ctx context.Context, data *orderCreateData) (*proto.OrderInfoResponse, error) {
+	parent, err := loadParentOrder(global.DB, data.OrderSn)
+	if err != nil {
+		global.Logger.Errorf("查询新建父订单失败，父订单号: %s，错误: %v", data.OrderSn, err)
+		return nil, status.Errorf(codes.Internal, "查询订单失败")
+	}
+	var children []model.OrderInfo
+	if err := global.DB.Where("parent_sn = ?", parent.ParentSn).Order("id").Find(&children).Error; err != nil {
+		global.Logger.Errorf("查询新建子订单失败，父订单号: %s，错误: %v", data.OrderSn, err)
+		return nil, status.Errorf(codes.Internal, "查询订单失败")
+	}
+	for i := range children {
+		scheduleOrderTimeout(ctx, &children[i])
+	}
+	global.Logger.Infof("成功创建拆单订单，父订单号: %s，子订单数: %d，应付金额: %s", parent.ParentSn, len(children), parent.PayAmount)
+	return parentOrderToInfo(parent, children), nil
+}
+
+// orderToInfo 订单转换为响应格式
+func orderToInfo(order *model.OrderInfo) *proto.OrderInfoResponse {
+	return &proto.OrderInfoResponse{
+		Id:             int32(order.ID),
+		UserId:         order.User,
+		OrderSn:        order.OrderSn,
+		PayType:        order.PayType,
+		Status:         order.Status,
+		Post:           order.Post,
+		Address:        order.Address,
+		Name:           order.SignerName,
+		Mobile:         order.SingerMobile,
+		CouponId:       order.Coupon,
+		GoodsAmount:    int64(order.GoodsAmount),
+		ShippingFee:    int64(order.ShippingFee),
+		DiscountAmount: int64(order.DiscountAmount),
+		PayAmount:      int64(order.OrderMount),
+		ParentSn:       order.ParentSn,
+		TradeNo:        order.TradeNo,
+		AddTime:        order.CreatedAt.Unix(),
+		PayTime:        unixOrZero(order.PayTime),
+	}
+}
+
+// orderGoodsToItems 订单商品转换为响应格式
+func orderGoodsToItems(orderGoods []model.OrderGoods) []*proto.OrderItemResponse {
+	items := make([]*proto.OrderItemResponse, 0, len(orderGoods))
+	for _, goods := range orderGoods {
+		items = append(items, &proto.OrderItemResponse{
+			Id:             goods.ID,
+			OrderId:        goods.Order,
+			GoodsId:        goods.Goods,
+			GoodsName:      goods.GoodsName,
+			GoodsImage:     goods.GoodsImage,
+			Nums:           goods.Nums,
+			Price:          int64(goods.GoodsPrice),
+			DiscountAmount: int64(goods.DiscountAmount),
+		})
+	}
+	return items
+}
+
+// unixOrZero 可空时间转为秒级时间戳，为空时返回0
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// parentOrderToInfo 拆单下单的返回数据，订单号和金额为父订单，子订单在children中
+func parentOrderToInfo(parent *model.ParentOrder, children []model.OrderInfo) *proto.OrderInfoResponse {
+	resp := &proto.OrderInfoResponse{
+		UserId:         parent.User,
+		OrderSn:        parent.ParentSn,
+		PayType:        parent.PayType,
+		Status:         parent.Status,
+		CouponId:       parent.Coupon,
+		GoodsAmount:    int64(parent.GoodsAmount),
+		ShippingFee:    int64(parent.ShippingFee),
+		DiscountAmount: int64(parent.DiscountAmount),
+		PayAmount:      int64(parent.PayAmount),
+		ParentSn:       parent.ParentSn,
+	}
+	for i := range children {
+		resp.Children = append(resp.Children, orderToInfo(&children[i]))
+	}
+	if len(children) > 0 {
+		resp.Post = children[0].Post
+		resp.Address = children[0].Address
+		resp.Name = children[0].SignerName
+		resp.Mobile = children[0].SingerMobile
+	}
+	return resp
+}
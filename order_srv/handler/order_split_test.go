@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"testing"
+
+	"order_srv/config"
+	"order_srv/global"
+	"order_srv/idgen"
+	"order_srv/split"
+	"order_srv/utils"
+)
+
+// TestSplitOrderChildSn 测试拆单的子订单号单独生成且可以通过校验
+func TestSplitOrderChildSn(t *testing.T) {
+	old := global.ServerConfig
+	defer func() { global.ServerConfig = old }()
+	global.ServerConfig = &config.ServerConfig{Split: config.SplitConfig{Key: split.KeyBrand}}
+
+	g, err := idgen.NewSnowflake(1)
+	if err != nil {
+		t.Fatalf("创建订单号生成器失败: %v", err)
+	}
+	idgen.SetDefault(g)
+	defer idgen.SetDefault(nil)
+
+	parentSn, _ := idgen.Next()
+	children, err := splitOrder(&orderCreateData{
+		OrderSn: parentSn,
+		Items: []orderCreateItem{
+			{GoodsId: 1, BrandId: 10, Price: 100, Nums: 1},
+			{GoodsId: 2, BrandId: 20, Price: 200, Nums: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("拆单失败: %v", err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("期望2个子订单，实际 %d", len(children))
+	}
+	seen := map[string]bool{parentSn: true}
+	for _, child := range children {
+		if !utils.ValidOrderSn(child.OrderSn) || len(child.OrderSn) != 20 {
+			t.Errorf("子订单号 %s 校验失败", child.OrderSn)
+		}
+		if seen[child.OrderSn] {
+			t.Errorf("子订单号 %s 重复", child.OrderSn)
+		}
+		seen[child.OrderSn] = true
+	}
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"order_srv/fsm"
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 状态流转日志中的操作人
+const (
+	actorSystem = "system"
+	actorAdmin  = "admin"
+)
+
+var (
+	errOrderStatusChanged    = errors.New("订单状态已变化")
+	errOrderNotFullyRefunded = errors.New("订单金额尚未全部退回")
+)
+
+// orderMachine 订单状态机，订单状态只能通过transitOrder修改
+var orderMachine = newOrderMachine()
+
+// orderTransition 订单状态流转的业务上下文，作为状态机的Payload
+type orderTransition struct {
+	tx    *gorm.DB
+	order *model.OrderInfo
+}
+
+func newOrderMachine() *fsm.Machine {
+	m := fsm.NewOrderMachine()
+
+	// 全额退款前确认已退金额覆盖订单金额
+	m.Guard(fsm.EventRefund, func(ctx context.Context, c *fsm.Context) error {
+		t := c.Payload.(*orderTransition)
+		var refunded int64
+		if err := t.tx.Model(&model.RefundOrder{}).
+			Select("COALESCE(SUM(amount), 0)").
+			Where("`order` = ? AND status = ?", t.order.ID, model.RefundStatusRefunded).
+			Scan(&refunded).Error; err != nil {
+			return err
+		}
+		if refunded < int64(t.order.OrderMount) {
+			return errOrderNotFullyRefunded
+		}
+		return nil
+	})
+
+	// 支付成功记录支付时间，支付回调会带上渠道返回的支付时间
+	m.BeforeEnter(fsm.OrderTradeSuccess, func(ctx context.Context, c *fsm.Context) error {
+		if _, ok := c.Updates["pay_time"]; !ok {
+			c.Updates["pay_time"] = time.Now()
+		}
+		return nil
+	})
+
+	// 订单关闭（取消或超时）后归还库存并退回优惠券，归还意图与状态变更在同一事务写入发件箱，由投递任务重试直到成功
+	m.AfterEnter(fsm.OrderTradeClosed, func(ctx context.Context, c *fsm.Context) error {
+		t := c.Payload.(*orderTransition)
+		if err := enqueueOrderStockReback(t.tx, t.order, "close"); err != nil {
+			return fmt.Errorf("写入归还库存消息失败: %w", err)
+		}
+		if err := releaseOrderCoupon(t.tx, t.order); err != nil {
+			return fmt.Errorf("退回优惠券失败: %w", err)
+		}
+		return nil
+	})
+
+	return m
+}
+
+// transitOrder 通过订单状态机变更订单状态并记录流转日志，需在事务中调用
+// 状态更新带有原状态条件，订单被并发修改时返回errOrderStatusChanged
+func transitOrder(ctx context.Context, tx *gorm.DB, order *model.OrderInfo, event fsm.Event, actor, reason string, updates map[string]interface{}) error {
+	c := &fsm.Context{
+		Event:   event,
+		From:    fsm.State(order.Status),
+		Actor:   actor,
+		Reason:  reason,
+		Updates: updates,
+		Payload: &orderTransition{tx: tx, order: order},
+	}
+	return orderMachine.Fire(ctx, c, func(ctx context.Context, c *fsm.Context) error {
+		c.Updates["status"] = string(c.To)
+		result := tx.Model(&model.OrderInfo{}).Where("id = ? AND status = ?", order.ID, order.Status).Updates(c.Updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errOrderStatusChanged
+		}
+		if err := createOrderStatusLog(tx, order, string(c.From), string(c.To), c.Event, c.Actor, c.Reason); err != nil {
+			return err
+		}
+		order.Status = string(c.To)
+		return nil
+	})
+}
+
+// createOrderStatusLog 写入订单状态流转日志
+func createOrderStatusLog(tx *gorm.DB, order *model.OrderInfo, from, to string, event fsm.Event, actor, reason string) error {
+	if len([]rune(reason)) > 200 {
+		reason = string([]rune(reason)[:200])
+	}
+	return tx.Create(&model.OrderStatusLog{
+		Order:      order.ID,
+		OrderSn:    order.OrderSn,
+		FromStatus: from,
+		ToStatus:   to,
+		Event:      string(event),
+		Actor:      actor,
+		Reason:     reason,
+	}).Error
+}
+
+// orderTransitError 将状态流转错误转换为gRPC错误
+func orderTransitError(err error) error {
+	switch {
+	case errors.Is(err, fsm.ErrInvalidTransition):
+		return status.Errorf(codes.FailedPrecondition, "无效的状态转换")
+	case errors.Is(err, errOrderStatusChanged):
+		return status.Errorf(codes.Aborted, "订单状态已变化，请刷新后重试")
+	default:
+		return status.Errorf(codes.Internal, "更新订单状态失败")
+	}
+}
+
+func userActor(userId int32) string {
+	return fmt.Sprintf("user:%d", userId)
+}
+
+// OrderTimeline 订单状态时间线，按时间先后返回订单的所有状态流转记录
+func (s *OrderServiceServer) OrderTimeline(ctx context.Context, req *proto.OrderRequest) (*proto.OrderTimelineResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "订单ID必须大于0")
+	}
+
+	// 已删除的订单仍可查询时间线，便于售后和客服追溯
+	query := global.DB.Unscoped().Where("id = ?", req.Id)
+	if req.UserId > 0 {
+		query = query.Where("user = ?", req.UserId)
+	}
+	var orderInfo model.OrderInfo
+	if err := query.First(&orderInfo).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "订单不存在")
+		}
+		global.Logger.Errorf("查询订单失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询订单失败")
+	}
+
+	var logs []model.OrderStatusLog
+	if err := global.DB.Where("`order` = ?", orderInfo.ID).Order("id").Find(&logs).Error; err != nil {
+		global.Logger.Errorf("查询订单状态流转记录失败，订单ID: %d，错误: %v", orderInfo.ID, err)
+		return nil, status.Errorf(codes.Internal, "查询订单时间线失败")
+	}
+
+	resp := &proto.OrderTimelineResponse{
+		OrderId: orderInfo.ID,
+		OrderSn: orderInfo.OrderSn,
+		Status:  orderInfo.Status,
+	}
+	for _, log := range logs {
+		resp.Logs = append(resp.Logs, &proto.OrderStatusLogInfo{
+			FromStatus: log.FromStatus,
+			ToStatus:   log.ToStatus,
+			Event:      log.Event,
+			Actor:      log.Actor,
+			Reason:     log.Reason,
+			AddTime:    log.CreatedAt.Unix(),
+		})
+	}
+	return resp, nil
+}
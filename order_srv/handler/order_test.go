@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"order_srv/config"
+	"order_srv/global"
+	"order_srv/utils"
+
+	"go.uber.org/zap"
+)
+
+// TestValidateUserExistsFailOpen 测试用户服务不可用时默认拒绝，配置fail_open后放行
+func TestValidateUserExistsFailOpen(t *testing.T) {
+	oldClient, oldConfig, oldLogger := global.UserClient, global.ServerConfig, global.Logger
+	defer func() { global.UserClient, global.ServerConfig, global.Logger = oldClient, oldConfig, oldLogger }()
+	global.UserClient = nil
+	global.Logger = zap.NewNop().Sugar()
+	srv := &OrderServiceServer{}
+	// 使用不会被其他测试缓存的用户ID
+	userId := int32(123456)
+
+	global.ServerConfig = &config.ServerConfig{}
+	valid, err := srv.validateUserExists(context.Background(), userId)
+	if valid || !errors.Is(err, utils.ErrUserSrvUnavailable) {
+		t.Errorf("默认应拒绝，实际 %v, %v", valid, err)
+	}
+
+	global.ServerConfig = &config.ServerConfig{UserSrv: config.UserSrvConfig{FailOpen: true}}
+	valid, err = srv.validateUserExists(context.Background(), userId)
+	if !valid || err != nil {
+		t.Errorf("配置fail_open后应放行，实际 %v, %v", valid, err)
+	}
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/outbox"
+	"order_srv/proto"
+	inventorypb "order_srv/proto/inventory"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// topicStockReback 归还库存
+const topicStockReback = "inventory.reback"
+
+// stockRebackPayload 归还库存消息
+type stockRebackPayload struct {
+	OrderSn string      `json:"order_sn"`
+	Items   []stockItem `json:"items"`
+}
+
+type stockItem struct {
+	GoodsId int32 `json:"goods_id"`
+	Nums    int32 `json:"nums"`
+}
+
+func init() {
+	outbox.Register(topicStockReback, handleStockReback)
+}
+
+// handleStockReback 归还库存，消息业务键作为库存服务的归还幂等键，重复投递时库存服务只归还一次
+func handleStockReback(ctx context.Context, key string, payload []byte) error {
+	var p stockRebackPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("解析归还库存消息失败: %w", err)
+	}
+	rebackItems := make([]*inventorypb.GoodsInvInfo, 0, len(p.Items))
+	for _, item := range p.Items {
+		rebackItems = append(rebackItems, &inventorypb.GoodsInvInfo{GoodsId: item.GoodsId, Num: item.Nums})
+	}
+	if len(rebackItems) == 0 {
+		return nil
+	}
+	if err := utils.RebackInventory(ctx, key, rebackItems); err != nil {
+		return err
+	}
+	global.Logger.Infof("订单库存已归还，订单号: %s", p.OrderSn)
+	return nil
+}
+
+// enqueueOrderStockReback 在订单变更的事务中写入归还订单全部商品库存的消息，scene区分归还场景
+func enqueueOrderStockReback(tx *gorm.DB, order *model.OrderInfo, scene string) error {
+	var orderGoods []model.OrderGoods
+	if err := tx.Where("`order` = ?", order.ID).Find(&orderGoods).Error; err != nil {
+		return err
+	}
+	items := make([]stockItem, 0, len(orderGoods))
+	for _, g := range orderGoods {
+		items = append(items, stockItem{GoodsId: g.Goods, Nums: g.Nums})
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return outbox.Enqueue(tx, topicStockReback, "stock_reback:"+scene+":"+order.OrderSn, stockRebackPayload{
+		OrderSn: order.OrderSn,
+		Items:   items,
+	})
+}
+
+// enqueueRefundStockReback 在商家确认收到退货的事务中写入归还退货商品库存的消息，相同售后单只写入一次
+func enqueueRefundStockReback(tx *gorm.DB, refund *model.RefundOrder) error {
+	var goods []model.RefundGoods
+	if err := tx.Where("refund = ?", refund.ID).Find(&goods).Error; err != nil {
+		return err
+	}
+	items := make([]stockItem, 0, len(goods))
+	for _, g := range goods {
+		items = append(items, stockItem{GoodsId: g.Goods, Nums: g.Nums})
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return outbox.EnqueueOnce(tx, topicStockReback, "stock_reback:refund:"+refund.RefundSn, stockRebackPayload{
+		OrderSn: refund.OrderSn,
+		Items:   items,
+	})
+}
+
+// compensateStockSell 下单失败时写入归还已扣减库存的消息，由投递任务重试直到成功
+// 相同订单号只写入一次，Saga补偿重试时不会重复归还
+func compensateStockSell(orderSn string, items []stockItem) error {
+	return outbox.EnqueueOnce(global.DB, topicStockReback, "stock_reback:create_failed:"+orderSn, stockRebackPayload{
+		OrderSn: orderSn,
+		Items:   items,
+	})
+}
+
+// OutboxDeadLetterList 查询投递失败次数过多的发件箱消息，默认不包含已重放的
+func (s *OrderServiceServer) OutboxDeadLetterList(ctx context.Context, req *proto.OutboxFilterRequest) (*proto.OutboxDeadLetterListResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	query := global.DB.Model(&model.OutboxDeadLetter{})
+	if req.Topic != "" {
+		query = query.Where("topic = ?", req.Topic)
+	}
+	if req.MsgKey != "" {
+		query = query.Where("msg_key = ?", req.MsgKey)
+	}
+	if !req.IncludeReplayed {
+		query = query.Where("replayed = ?", false)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		global.Logger.Errorf("查询死信总数失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询死信列表失败")
+	}
+	var letters []model.OutboxDeadLetter
+	offset := (req.Page - 1) * req.PageSize
+	if err := query.Offset(int(offset)).Limit(int(req.PageSize)).Order("id DESC").Find(&letters).Error; err != nil {
+		global.Logger.Errorf("查询死信列表失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询死信列表失败")
+	}
+
+	resp := &proto.OutboxDeadLetterListResponse{Total: int32(total)}
+	for _, letter := range letters {
+		info := &proto.OutboxDeadLetterInfo{
+			Id:        letter.ID,
+			MessageId: letter.Message,
+			Topic:     letter.Topic,
+			MsgKey:    letter.MsgKey,
+			Payload:   letter.Payload,
+			Attempts:  letter.Attempts,
+			LastError: letter.LastError,
+			Replayed:  letter.Replayed,
+			AddTime:   letter.CreatedAt.Unix(),
+		}
+		if letter.ReplayedAt != nil {
+			info.ReplayedTime = letter.ReplayedAt.Unix()
+		}
+		resp.Data = append(resp.Data, info)
+	}
+	return resp, nil
+}
+
+// OutboxReplay 将死信重新放回发件箱投递，应在排查并修复失败原因后调用
+func (s *OrderServiceServer) OutboxReplay(ctx context.Context, req *proto.OutboxReplayRequest) (*emptypb.Empty, error) {
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "死信ID必须大于0")
+	}
+	if err := outbox.Replay(ctx, req.Id); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Errorf(codes.NotFound, "死信不存在")
+		case errors.Is(err, outbox.ErrAlreadyReplayed):
+			return nil, status.Errorf(codes.FailedPrecondition, "死信已经重放")
+		case errors.Is(err, outbox.ErrKeyInFlight):
+			return nil, status.Errorf(codes.AlreadyExists, "相同业务键的消息仍在投递中")
+		}
+		global.Logger.Errorf("重放死信失败，死信ID: %d，错误: %v", req.Id, err)
+		return nil, status.Errorf(codes.Internal, "重放死信失败")
+	}
+	global.Logger.Infof("死信已重放，死信ID: %d", req.Id)
+	return &emptypb.Empty{}, nil
+}
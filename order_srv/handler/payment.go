@@ -0,0 +1,393 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"order_srv/fsm"
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/payment"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// payTarget 发起支付的对象，未拆单时为订单本身，拆单时为父订单
+type payTarget struct {
+	orderId  int32 // 未拆单的订单ID，父订单为0
+	orderSn  string
+	parentSn string
+	status   string
+	amount   int64
+	deadline *time.Time
+}
+
+// PaymentCreate 发起支付，订单必须处于待支付状态且未超过支付截止时间
+// 拆单的子订单统一按父订单支付，可传入任一子订单ID或父订单号
+func (s *OrderServiceServer) PaymentCreate(ctx context.Context, req *proto.PaymentRequest) (*proto.PaymentResponse, error) {
+	global.Logger.Infof("发起支付，订单ID: %d，父订单号: %s，用户ID: %d，支付方式: %s", req.OrderId, req.ParentSn, req.UserId, req.PayType)
+
+	if req.OrderId <= 0 && req.ParentSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "订单ID必须大于0")
+	}
+	if req.ParentSn != "" && !utils.ValidOrderSn(req.ParentSn) {
+		return nil, status.Errorf(codes.InvalidArgument, "订单号无效")
+	}
+	provider, err := payment.Get(req.PayType)
+	if err != nil {
+		global.Logger.Warnf("支付方式不可用: %s", req.PayType)
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的支付方式")
+	}
+
+	target, err := loadPayTarget(req)
+	if err != nil {
+		return nil, err
+	}
+	if !isPayableOrderStatus(target.status) {
+		global.Logger.Warnf("订单状态不允许支付，订单号: %s，状态: %s", target.orderSn, target.status)
+		return nil, status.Errorf(codes.FailedPrecondition, "订单当前状态不能支付")
+	}
+	var expireAt time.Time
+	if target.deadline != nil {
+		if time.Now().After(*target.deadline) {
+			return nil, status.Errorf(codes.FailedPrecondition, "订单已超过支付时间")
+		}
+		expireAt = *target.deadline
+	}
+	amount := target.amount
+	if amount <= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单金额无效")
+	}
+
+	result, err := provider.CreatePayment(ctx, &payment.CreateRequest{
+		OutTradeNo:  target.orderSn,
+		Subject:     fmt.Sprintf("joyshop订单-%s", target.orderSn),
+		AmountCents: amount,
+		ExpireAt:    expireAt,
+		ClientIP:    req.ClientIp,
+	})
+	if err != nil {
+		global.Logger.Errorf("调用支付渠道创建支付失败，订单号: %s，支付方式: %s，错误: %v", target.orderSn, req.PayType, err)
+		return nil, status.Errorf(codes.Unavailable, "创建支付失败")
+	}
+
+	// 记录支付单，重复发起时更新金额
+	err = global.DB.Transaction(func(tx *gorm.DB) error {
+		record := model.PaymentRecord{
+			Order:   target.orderId,
+			OrderSn: target.orderSn,
+			PayType: req.PayType,
+			Amount:  amount,
+			Status:  model.PaymentStatusPending,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "order_sn"}, {Name: "pay_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"amount", "updated_at"}),
+		}).Create(&record).Error; err != nil {
+			return err
+		}
+		if target.parentSn == "" {
+			return tx.Model(&model.OrderInfo{}).Where("id = ?", target.orderId).Update("pay_type", req.PayType).Error
+		}
+		if err := tx.Model(&model.ParentOrder{}).Where("parent_sn = ?", target.parentSn).Update("pay_type", req.PayType).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.OrderInfo{}).Where("parent_sn = ?", target.parentSn).Update("pay_type", req.PayType).Error
+	})
+	if err != nil {
+		global.Logger.Errorf("保存支付单失败，订单号: %s，错误: %v", target.orderSn, err)
+		return nil, status.Errorf(codes.Internal, "创建支付失败")
+	}
+
+	response := &proto.PaymentResponse{
+		OrderSn:   target.orderSn,
+		PayType:   req.PayType,
+		PayAmount: amount,
+		PayUrl:    result.PayUrl,
+		PayParams: result.PayParams,
+	}
+	if !expireAt.IsZero() {
+		response.ExpireTime = expireAt.Unix()
+	}
+
+	global.Logger.Infof("成功发起支付，订单号: %s，支付方式: %s，金额: %s", target.orderSn, req.PayType, payment.CentsToYuan(amount))
+	return response, nil
+}
+
+// loadPayTarget 查询要支付的订单，子订单转为其父订单
+func loadPayTarget(req *proto.PaymentRequest) (*payTarget, error) {
+	parentSn := req.ParentSn
+	if parentSn == "" {
+		var orderInfo model.OrderInfo
+		query := global.DB.Where("id = ?", req.OrderId)
+		if req.UserId > 0 {
+			query = query.Where("user = ?", req.UserId)
+		}
+		if err := query.First(&orderInfo).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "订单不存在")
+			}
+			global.Logger.Errorf("查询订单失败: %v", err)
+			return nil, status.Errorf(codes.Internal, "查询订单失败")
+		}
+		if orderInfo.ParentSn == "" {
+			return &payTarget{
+				orderId:  int32(orderInfo.ID),
+				orderSn:  orderInfo.OrderSn,
+				status:   orderInfo.Status,
+				amount:   int64(orderInfo.OrderMount),
+				deadline: orderInfo.PayDeadline,
+			}, nil
+		}
+		parentSn = orderInfo.ParentSn
+	}
+
+	parent, err := loadParentOrder(global.DB, parentSn)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "订单不存在")
+		}
+		global.Logger.Errorf("查询父订单失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询订单失败")
+	}
+	if req.UserId > 0 && parent.User != req.UserId {
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	return &payTarget{
+		orderSn:  parent.ParentSn,
+		parentSn: parent.ParentSn,
+		status:   parent.Status,
+		amount:   int64(parent.PayAmount),
+		deadline: parent.PayDeadline,
+	}, nil
+}
+
+// PaymentNotify 处理支付渠道回调，验签通过且支付成功时将订单置为已支付，重复回调不会重复处理
+func (s *OrderServiceServer) PaymentNotify(ctx context.Context, req *proto.PaymentNotifyRequest) (*proto.PaymentNotifyResponse, error) {
+	provider, err := payment.Get(req.PayType)
+	if err != nil {
+		global.Logger.Warnf("收到未启用渠道的支付回调: %s", req.PayType)
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的支付方式")
+	}
+
+	notification, err := provider.VerifyNotify(ctx, req.Headers, req.Body)
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) {
+			global.Logger.Warnf("支付回调验签失败，支付方式: %s", req.PayType)
+			return nil, status.Errorf(codes.PermissionDenied, "支付回调签名校验失败")
+		}
+		global.Logger.Errorf("解析支付回调失败，支付方式: %s，错误: %v", req.PayType, err)
+		return nil, status.Errorf(codes.InvalidArgument, "解析支付回调失败")
+	}
+	global.Logger.Infof("收到支付回调，订单号: %s，渠道交易号: %s，状态: %s", notification.OutTradeNo, notification.TradeNo, notification.Status)
+	if !utils.ValidOrderSn(notification.OutTradeNo) {
+		global.Logger.Warnf("支付回调订单号校验失败，订单号: %s", notification.OutTradeNo)
+		return nil, status.Errorf(codes.InvalidArgument, "订单号无效")
+	}
+
+	switch notification.Status {
+	case payment.TradeStatusSuccess:
+		if err := markOrderPaid(ctx, req.PayType, notification); err != nil {
+			return nil, err
+		}
+	case payment.TradeStatusClosed:
+		// 渠道侧交易关闭不影响订单状态，订单由超时任务关闭
+		if err := global.DB.Model(&model.PaymentRecord{}).
+			Where("order_sn = ? AND pay_type = ? AND status = ?", notification.OutTradeNo, req.PayType, model.PaymentStatusPending).
+			Update("status", model.PaymentStatusClosed).Error; err != nil {
+			global.Logger.Errorf("更新支付单状态失败: %v", err)
+			return nil, status.Errorf(codes.Internal, "处理支付回调失败")
+		}
+	}
+
+	return &proto.PaymentNotifyResponse{
+		Success: true,
+		OrderSn: notification.OutTradeNo,
+		Reply:   provider.NotifyReply(true),
+	}, nil
+}
+
+// markOrderPaid 根据已确认的支付结果将订单置为已支付，商户订单号为父订单号时同步所有子订单
+// 订单状态更新带有状态条件，已支付的订单再次处理时直接返回成功，保证回调和主动查询重复处理时幂等
+func markOrderPaid(ctx context.Context, payType string, n *payment.Notification) error {
+	var orderInfo model.OrderInfo
+	if err := global.DB.Where("order_sn = ?", n.OutTradeNo).First(&orderInfo).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return markParentOrderPaid(ctx, payType, n)
+		}
+		global.Logger.Errorf("查询订单失败: %v", err)
+		return status.Errorf(codes.Internal, "查询订单失败")
+	}
+
+	if amount := int64(orderInfo.OrderMount); amount != n.AmountCents {
+		global.Logger.Errorf("支付金额与订单金额不一致，订单号: %s，订单金额: %s，支付金额: %s",
+			orderInfo.OrderSn, payment.CentsToYuan(amount), payment.CentsToYuan(n.AmountCents))
+		return status.Errorf(codes.FailedPrecondition, "支付金额与订单金额不一致")
+	}
+
+	// 与OrderUpdate使用同一把锁，避免与手动更新状态并发
+	lock := utils.NewRedisLock(fmt.Sprintf("order_update_lock:id:%d", orderInfo.ID), 10*time.Second)
+	locked, err := lock.TryLock(ctx, 3, 50*time.Millisecond)
+	if err != nil || !locked {
+		global.Logger.Warnf("获取订单更新锁失败，订单号: %s，错误: %v", orderInfo.OrderSn, err)
+		return status.Errorf(codes.Aborted, "订单正在处理中，请稍后重试")
+	}
+	defer func() {
+		if unlockErr := lock.Unlock(ctx); unlockErr != nil {
+			global.Logger.Errorf("释放订单更新锁失败: %v", unlockErr)
+		}
+	}()
+
+	paidAt := n.PaidAt
+	if paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+
+	err = global.DB.Transaction(func(tx *gorm.DB) error {
+		record := model.PaymentRecord{
+			Order:      orderInfo.ID,
+			OrderSn:    orderInfo.OrderSn,
+			PayType:    payType,
+			TradeNo:    n.TradeNo,
+			Amount:     n.AmountCents,
+			Status:     model.PaymentStatusSuccess,
+			PaidAt:     &paidAt,
+			NotifyData: n.Raw,
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "order_sn"}, {Name: "pay_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"trade_no", "amount", "status", "paid_at", "notify_data", "updated_at"}),
+		}).Create(&record).Error; err != nil {
+			return err
+		}
+
+		// 在事务内重新读取订单状态，已不是待支付时判断是否为重复回调
+		var current model.OrderInfo
+		if err := tx.First(&current, orderInfo.ID).Error; err != nil {
+			return err
+		}
+		if orderMachine.Can(fsm.State(current.Status), fsm.EventPaySuccess) {
+			err := transitOrder(ctx, tx, &current, fsm.EventPaySuccess, "payment:"+payType, "支付成功，渠道交易号: "+n.TradeNo, map[string]interface{}{
+				"pay_type": payType,
+				"trade_no": n.TradeNo,
+				"pay_time": paidAt,
+			})
+			if err == nil {
+				global.Logger.Infof("订单支付成功，订单号: %s，渠道交易号: %s", orderInfo.OrderSn, n.TradeNo)
+				return nil
+			}
+			if !errors.Is(err, errOrderStatusChanged) {
+				return err
+			}
+			if err := tx.First(&current, orderInfo.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		switch {
+		case current.Status == "TRADE_SUCCESS" || current.Status == "TRADE_FINISHED":
+			if current.TradeNo != n.TradeNo {
+				global.Logger.Errorf("订单重复支付，需要人工退款，订单号: %s，原交易号: %s，新交易号: %s",
+					orderInfo.OrderSn, current.TradeNo, n.TradeNo)
+			}
+		default:
+			global.Logger.Errorf("订单已处于%s状态但收到支付成功回调，需要人工退款，订单号: %s，交易号: %s",
+				current.Status, orderInfo.OrderSn, n.TradeNo)
+		}
+		return nil
+	})
+	if err != nil {
+		global.Logger.Errorf("更新订单支付状态失败，订单号: %s，错误: %v", orderInfo.OrderSn, err)
+		return status.Errorf(codes.Internal, "更新订单支付状态失败")
+	}
+	return nil
+}
+
+// syncPaymentStatus 向支付渠道查询订单的支付结果，已支付时更新订单并返回true
+// 用于关闭超时订单前确认订单确实未支付，避免回调丢失导致已支付订单被关闭；拆单的子订单查询父订单的支付结果
+func syncPaymentStatus(ctx context.Context, order *model.OrderInfo) (bool, error) {
+	paySn := paymentOrderSn(order)
+	var records []model.PaymentRecord
+	if err := global.DB.Where("order_sn = ? AND status = ?", paySn, model.PaymentStatusPending).Find(&records).Error; err != nil {
+		return false, err
+	}
+	for _, record := range records {
+		provider, err := payment.Get(record.PayType)
+		if err != nil {
+			continue
+		}
+		result, err := provider.QueryPayment(ctx, paySn)
+		if err != nil {
+			if errors.Is(err, payment.ErrTradeNotFound) {
+				continue
+			}
+			return false, fmt.Errorf("查询%s支付结果失败: %w", record.PayType, err)
+		}
+		if result.Status != payment.TradeStatusSuccess {
+			continue
+		}
+		if err := markOrderPaid(ctx, record.PayType, &payment.Notification{
+			OutTradeNo:  paySn,
+			TradeNo:     result.TradeNo,
+			AmountCents: result.AmountCents,
+			Status:      result.Status,
+			PaidAt:      result.PaidAt,
+		}); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// markParentOrderPaid 父订单支付成功，校验金额后同步到所有子订单
+func markParentOrderPaid(ctx context.Context, payType string, n *payment.Notification) error {
+	parent, err := loadParentOrder(global.DB, n.OutTradeNo)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			global.Logger.Warnf("支付回调对应的订单不存在，订单号: %s", n.OutTradeNo)
+			return status.Errorf(codes.NotFound, "订单不存在")
+		}
+		global.Logger.Errorf("查询父订单失败: %v", err)
+		return status.Errorf(codes.Internal, "查询订单失败")
+	}
+	if amount := int64(parent.PayAmount); amount != n.AmountCents {
+		global.Logger.Errorf("支付金额与订单金额不一致，父订单号: %s，订单金额: %s，支付金额: %s",
+			parent.ParentSn, payment.CentsToYuan(amount), payment.CentsToYuan(n.AmountCents))
+		return status.Errorf(codes.FailedPrecondition, "支付金额与订单金额不一致")
+	}
+
+	lock := utils.NewRedisLock("order_update_lock:parent:"+parent.ParentSn, 10*time.Second)
+	locked, err := lock.TryLock(ctx, 3, 50*time.Millisecond)
+	if err != nil || !locked {
+		global.Logger.Warnf("获取父订单更新锁失败，父订单号: %s，错误: %v", parent.ParentSn, err)
+		return status.Errorf(codes.Aborted, "订单正在处理中，请稍后重试")
+	}
+	defer func() {
+		if unlockErr := lock.Unlock(ctx); unlockErr != nil {
+			global.Logger.Errorf("释放父订单更新锁失败: %v", unlockErr)
+		}
+	}()
+
+	paidAt := n.PaidAt
+	if paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+	if err := markParentPaid(ctx, payType, n, parent, paidAt); err != nil {
+		global.Logger.Errorf("更新父订单支付状态失败，父订单号: %s，错误: %v", parent.ParentSn, err)
+		return status.Errorf(codes.Internal, "更新订单支付状态失败")
+	}
+	return nil
+}
+
+// isPayableOrderStatus 订单能响应支付成功事件时才允许发起支付
+func isPayableOrderStatus(s string) bool {
+	return orderMachine.Can(fsm.State(s), fsm.EventPaySuccess)
+}
@@ -0,0 +1,601 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/payment"
+	"order_srv/promotion"
+	"order_srv/proto"
+	goodsproto "order_srv/proto/goods"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CouponTemplateCreate 创建优惠券模板
+func (s *OrderServiceServer) CouponTemplateCreate(ctx context.Context, req *proto.CouponTemplateRequest) (*proto.CouponTemplateInfo, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "优惠券名称不能为空")
+	}
+	rule := discountRule(req.Type, req.Threshold, req.Amount, req.Percent, req.MaxDiscount, req.Scope, req.ScopeId)
+	if err := promotionRule(rule).Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.Total < 0 || req.PerUserLimit < 0 || req.ValidDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "发放数量和有效天数不能为负数")
+	}
+	if req.ValidDays == 0 && req.EndTime <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "有效天数和结束时间至少设置一个")
+	}
+	if req.StartTime > 0 && req.EndTime > 0 && req.StartTime >= req.EndTime {
+		return nil, status.Errorf(codes.InvalidArgument, "结束时间必须晚于开始时间")
+	}
+
+	template := model.CouponTemplate{
+		Name:         req.Name,
+		DiscountRule: rule,
+		Total:        req.Total,
+		PerUserLimit: req.PerUserLimit,
+		ValidDays:    req.ValidDays,
+		StartTime:    unixTime(req.StartTime),
+		EndTime:      unixTime(req.EndTime),
+		Enabled:      true,
+	}
+	if err := global.DB.Create(&template).Error; err != nil {
+		global.Logger.Errorf("创建优惠券模板失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "创建优惠券模板失败")
+	}
+	global.Logger.Infof("创建优惠券模板，ID: %d，名称: %s", template.ID, template.Name)
+	return couponTemplateToInfo(&template), nil
+}
+
+// CouponTemplateList 优惠券模板列表，active_only只返回可发放的模板
+func (s *OrderServiceServer) CouponTemplateList(ctx context.Context, req *proto.PromotionFilterRequest) (*proto.CouponTemplateListResponse, error) {
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	query := global.DB.Model(&model.CouponTemplate{})
+	if req.ActiveOnly {
+		now := time.Now()
+		query = query.Where("enabled = ? AND (start_time IS NULL OR start_time <= ?) AND (end_time IS NULL OR end_time > ?) AND (total = 0 OR issued < total)",
+			true, now, now)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		global.Logger.Errorf("查询优惠券模板总数失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询优惠券模板失败")
+	}
+	var templates []model.CouponTemplate
+	if err := query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id DESC").Find(&templates).Error; err != nil {
+		global.Logger.Errorf("查询优惠券模板失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询优惠券模板失败")
+	}
+
+	resp := &proto.CouponTemplateListResponse{Total: int32(total)}
+	for i := range templates {
+		resp.Data = append(resp.Data, couponTemplateToInfo(&templates[i]))
+	}
+	return resp, nil
+}
+
+// CouponIssue 向用户发放优惠券，锁定模板行保证发放总量和每人限领数量不超发
+func (s *OrderServiceServer) CouponIssue(ctx context.Context, req *proto.CouponIssueRequest) (*proto.UserCouponInfo, error) {
+	if req.TemplateId <= 0 || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "模板ID和用户ID必须大于0")
+	}
+
+	var template model.CouponTemplate
+	var coupon model.UserCoupon
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&template, req.TemplateId).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "优惠券模板不存在")
+			}
+			return err
+		}
+		now := time.Now()
+		if !template.Enabled {
+			return status.Errorf(codes.FailedPrecondition, "优惠券已停用")
+		}
+		if template.StartTime != nil && now.Before(*template.StartTime) {
+			return status.Errorf(codes.FailedPrecondition, "优惠券尚未开始发放")
+		}
+		if template.EndTime != nil && !now.Before(*template.EndTime) {
+			return status.Errorf(codes.FailedPrecondition, "优惠券已结束发放")
+		}
+		if template.Total > 0 && template.Issued >= template.Total {
+			return status.Errorf(codes.ResourceExhausted, "优惠券已发完")
+		}
+		if template.PerUserLimit > 0 {
+			var owned int64
+			if err := tx.Model(&model.UserCoupon{}).Where("user = ? AND template = ?", req.UserId, template.ID).Count(&owned).Error; err != nil {
+				return err
+			}
+			if owned >= int64(template.PerUserLimit) {
+				return status.Errorf(codes.FailedPrecondition, "已达到领取上限")
+			}
+		}
+
+		expireAt := now.AddDate(0, 0, int(template.ValidDays))
+		if template.ValidDays == 0 || (template.EndTime != nil && template.EndTime.Before(expireAt)) {
+			expireAt = *template.EndTime
+		}
+		coupon = model.UserCoupon{
+			User:     req.UserId,
+			Template: template.ID,
+			Status:   model.CouponStatusUnused,
+			ExpireAt: expireAt,
+		}
+		if err := tx.Create(&coupon).Error; err != nil {
+			return err
+		}
+		return tx.Model(&template).Update("issued", gorm.Expr("issued + 1")).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		global.Logger.Errorf("发放优惠券失败，模板ID: %d，用户ID: %d，错误: %v", req.TemplateId, req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "发放优惠券失败")
+	}
+	global.Logger.Infof("发放优惠券，模板ID: %d，用户ID: %d，优惠券ID: %d", template.ID, req.UserId, coupon.ID)
+	return userCouponToInfo(&coupon, &template), nil
+}
+
+// UserCouponList 用户优惠券列表
+func (s *OrderServiceServer) UserCouponList(ctx context.Context, req *proto.UserCouponFilterRequest) (*proto.UserCouponListResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	now := time.Now()
+	query := global.DB.Model(&model.UserCoupon{}).Where("user = ?", req.UserId)
+	switch req.Status {
+	case "":
+	case model.CouponStatusUnused:
+		query = query.Where("status = ? AND expire_at > ?", model.CouponStatusUnused, now)
+	case model.CouponStatusExpired:
+		query = query.Where("status = ? AND expire_at <= ?", model.CouponStatusUnused, now)
+	case model.CouponStatusUsed:
+		query = query.Where("status = ?", model.CouponStatusUsed)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "无效的优惠券状态")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		global.Logger.Errorf("查询用户优惠券总数失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+	}
+	var coupons []model.UserCoupon
+	if err := query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id DESC").Find(&coupons).Error; err != nil {
+		global.Logger.Errorf("查询用户优惠券失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+	}
+	templates, err := loadCouponTemplates(global.DB, coupons)
+	if err != nil {
+		global.Logger.Errorf("查询优惠券模板失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+	}
+
+	resp := &proto.UserCouponListResponse{Total: int32(total)}
+	for i := range coupons {
+		resp.Data = append(resp.Data, userCouponToInfo(&coupons[i], templates[coupons[i].Template]))
+	}
+	return resp, nil
+}
+
+// PromotionCreate 创建促销活动
+func (s *OrderServiceServer) PromotionCreate(ctx context.Context, req *proto.PromotionRequest) (*proto.PromotionInfo, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "活动名称不能为空")
+	}
+	rule := discountRule(req.Type, req.Threshold, req.Amount, req.Percent, req.MaxDiscount, req.Scope, req.ScopeId)
+	if err := promotionRule(rule).Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.StartTime <= 0 || req.EndTime <= req.StartTime {
+		return nil, status.Errorf(codes.InvalidArgument, "活动时间无效")
+	}
+
+	p := model.Promotion{
+		Name:         req.Name,
+		DiscountRule: rule,
+		StartTime:    time.Unix(req.StartTime, 0),
+		EndTime:      time.Unix(req.EndTime, 0),
+		Enabled:      true,
+	}
+	if err := global.DB.Create(&p).Error; err != nil {
+		global.Logger.Errorf("创建促销活动失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "创建促销活动失败")
+	}
+	global.Logger.Infof("创建促销活动，ID: %d，名称: %s", p.ID, p.Name)
+	return promotionToInfo(&p), nil
+}
+
+// PromotionList 促销活动列表，active_only只返回进行中的活动
+func (s *OrderServiceServer) PromotionList(ctx context.Context, req *proto.PromotionFilterRequest) (*proto.PromotionListResponse, error) {
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	query := global.DB.Model(&model.Promotion{})
+	if req.ActiveOnly {
+		query = activePromotionQuery(query, time.Now())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		global.Logger.Errorf("查询促销活动总数失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询促销活动失败")
+	}
+	var promotions []model.Promotion
+	if err := query.Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Order("id DESC").Find(&promotions).Error; err != nil {
+		global.Logger.Errorf("查询促销活动失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询促销活动失败")
+	}
+
+	resp := &proto.PromotionListResponse{Total: int32(total)}
+	for i := range promotions {
+		resp.Data = append(resp.Data, promotionToInfo(&promotions[i]))
+	}
+	return resp, nil
+}
+
+// PriceCalculate 预览购物车选中商品的优惠，同时返回用户每张可用优惠券能优惠的金额
+func (s *OrderServiceServer) PriceCalculate(ctx context.Context, req *proto.PriceCalculateRequest) (*proto.PriceCalculateResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户ID必须大于0")
+	}
+
+	var carts []model.ShoppingCart
+	if err := global.DB.Where("user = ? AND checked = ?", req.UserId, true).Find(&carts).Error; err != nil {
+		global.Logger.Errorf("查询购物车失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询购物车失败")
+	}
+	if len(carts) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "购物车中没有选中的商品")
+	}
+	goodsIds := make([]int32, 0, len(carts))
+	for _, cart := range carts {
+		goodsIds = append(goodsIds, cart.Goods)
+	}
+	goodsMap, err := utils.GetGoodsByIds(ctx, goodsIds)
+	if err != nil {
+		global.Logger.Errorf("批量获取商品信息失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "获取商品信息失败")
+	}
+
+	items := make([]promotion.Item, 0, len(carts))
+	names := make([]string, 0, len(carts))
+	for _, cart := range carts {
+		goodsInfo, ok := goodsMap[cart.Goods]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "商品不存在")
+		}
+		items = append(items, promotionItem(goodsInfo, cart.Nums))
+		names = append(names, goodsInfo.Name)
+	}
+
+	promotions, err := loadActivePromotions(global.DB)
+	if err != nil {
+		global.Logger.Errorf("查询促销活动失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "计算优惠失败")
+	}
+	var coupon *promotion.Coupon
+	if req.CouponId > 0 {
+		if coupon, err = loadUsableCoupon(global.DB, req.UserId, req.CouponId); err != nil {
+			return nil, err
+		}
+	}
+	result, err := calculatePrice(items, promotions, coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.PriceCalculateResponse{
+		GoodsTotal:        result.GoodsTotal,
+		PromotionDiscount: result.PromotionDiscount,
+		CouponDiscount:    result.CouponDiscount,
+		ShippingFee:       result.ShippingFee,
+		Payable:           result.Payable,
+		Discounts:         discountsToInfo(result.Discounts),
+	}
+	for i, item := range items {
+		resp.Items = append(resp.Items, &proto.PriceItemInfo{
+			GoodsId:   item.GoodsId,
+			GoodsName: names[i],
+			Price:     item.Price,
+			Nums:      item.Nums,
+			Discount:  result.ItemDiscounts[i],
+		})
+	}
+
+	// 逐张试算用户未使用的优惠券，供前端选择
+	var owned []model.UserCoupon
+	if err := global.DB.Where("user = ? AND status = ? AND expire_at > ?", req.UserId, model.CouponStatusUnused, time.Now()).
+		Order("expire_at").Find(&owned).Error; err != nil {
+		global.Logger.Errorf("查询用户优惠券失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "计算优惠失败")
+	}
+	templates, err := loadCouponTemplates(global.DB, owned)
+	if err != nil {
+		global.Logger.Errorf("查询优惠券模板失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "计算优惠失败")
+	}
+	for _, c := range owned {
+		template, ok := templates[c.Template]
+		if !ok {
+			continue
+		}
+		option := &proto.CouponOption{CouponId: c.ID, Name: template.Name}
+		if r, err := promotion.Calculate(items, promotions, couponFromTemplate(c.ID, template), shippingRule()); err == nil {
+			option.Usable = true
+			option.Discount = r.CouponDiscount
+		}
+		resp.Coupons = append(resp.Coupons, option)
+	}
+	return resp, nil
+}
+
+// loadActivePromotions 查询进行中的促销活动
+func loadActivePromotions(db *gorm.DB) ([]promotion.Promotion, error) {
+	var rows []model.Promotion
+	if err := activePromotionQuery(db.Model(&model.Promotion{}), time.Now()).Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	promotions := make([]promotion.Promotion, 0, len(rows))
+	for _, p := range rows {
+		promotions = append(promotions, promotion.Promotion{Id: p.ID, Name: p.Name, Rule: promotionRule(p.DiscountRule)})
+	}
+	return promotions, nil
+}
+
+func activePromotionQuery(query *gorm.DB, now time.Time) *gorm.DB {
+	return query.Where("enabled = ? AND start_time <= ? AND end_time > ?", true, now, now)
+}
+
+// loadUsableCoupon 查询用户可用的优惠券，返回gRPC错误
+func loadUsableCoupon(db *gorm.DB, userId, couponId int32) (*promotion.Coupon, error) {
+	var c model.UserCoupon
+	if err := db.Where("id = ? AND user = ?", couponId, userId).First(&c).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "优惠券不存在")
+		}
+		global.Logger.Errorf("查询优惠券失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+	}
+	if c.Status != model.CouponStatusUnused {
+		return nil, status.Errorf(codes.FailedPrecondition, "优惠券已使用")
+	}
+	if !c.ExpireAt.After(time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "优惠券已过期")
+	}
+	var template model.CouponTemplate
+	if err := db.First(&template, c.Template).Error; err != nil {
+		global.Logger.Errorf("查询优惠券模板失败，模板ID: %d，错误: %v", c.Template, err)
+		return nil, status.Errorf(codes.Internal, "查询优惠券失败")
+	}
+	return couponFromTemplate(c.ID, &template), nil
+}
+
+// shippingRule 配置中的运费规则
+func shippingRule() promotion.ShippingRule {
+	return promotion.ShippingRule{
+		Fee:           global.ServerConfig.Shipping.Fee,
+		FreeThreshold: global.ServerConfig.Shipping.FreeThreshold,
+	}
+}
+
+// calculatePrice 按当前运费规则计算优惠和应付金额，返回gRPC错误
+func calculatePrice(items []promotion.Item, promotions []promotion.Promotion, coupon *promotion.Coupon) (*promotion.Result, error) {
+	result, err := promotion.Calculate(items, promotions, coupon, shippingRule())
+	if err != nil {
+		if errors.Is(err, promotion.ErrCouponNotApplicable) {
+			return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+		}
+		global.Logger.Errorf("计算优惠失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "计算优惠失败")
+	}
+	return result, nil
+}
+
+// redeemCoupon 在创建订单的事务中核销优惠券，优惠券已被使用或过期时返回gRPC错误
+func redeemCoupon(tx *gorm.DB, userId, couponId int32, orderSn string) error {
+	now := time.Now()
+	result := tx.Model(&model.UserCoupon{}).
+		Where("id = ? AND user = ? AND status = ? AND expire_at > ?", couponId, userId, model.CouponStatusUnused, now).
+		Updates(map[string]interface{}{"status": model.CouponStatusUsed, "order_sn": orderSn, "used_at": now})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return status.Errorf(codes.FailedPrecondition, "优惠券已使用或已过期")
+	}
+	return nil
+}
+
+// releaseOrderCoupon 订单关闭后退回核销的优惠券，已过期的优惠券退回后仍不可用
+func releaseOrderCoupon(tx *gorm.DB, order *model.OrderInfo) error {
+	if order.Coupon == 0 {
+		return nil
+	}
+	return tx.Model(&model.UserCoupon{}).
+		Where("id = ? AND status = ? AND order_sn = ?", order.Coupon, model.CouponStatusUsed, order.OrderSn).
+		Updates(map[string]interface{}{"status": model.CouponStatusUnused, "order_sn": "", "used_at": nil}).Error
+}
+
+func loadCouponTemplates(db *gorm.DB, coupons []model.UserCoupon) (map[int32]*model.CouponTemplate, error) {
+	templates := make(map[int32]*model.CouponTemplate)
+	ids := make([]int32, 0, len(coupons))
+	for _, c := range coupons {
+		ids = append(ids, c.Template)
+	}
+	if len(ids) == 0 {
+		return templates, nil
+	}
+	var rows []model.CouponTemplate
+	if err := db.Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		templates[rows[i].ID] = &rows[i]
+	}
+	return templates, nil
+}
+
+func promotionItem(goodsInfo *goodsproto.GoodsInfoResponse, nums int32) promotion.Item {
+	return promotion.Item{
+		GoodsId:     goodsInfo.Id,
+		BrandId:     goodsInfo.BrandId,
+		CategoryIds: goodsInfo.CategoryIds,
+		Price:       int64(shopPrice(goodsInfo)),
+		Nums:        nums,
+		ShipFree:    goodsInfo.ShipFree,
+	}
+}
+
+// shopPrice 商品服务返回的售价（元）转换为金额
+func shopPrice(goodsInfo *goodsproto.GoodsInfoResponse) model.Money {
+	return model.Money(payment.YuanToCents(float64(goodsInfo.ShopPrice)))
+}
+
+func couponFromTemplate(couponId int32, template *model.CouponTemplate) *promotion.Coupon {
+	return &promotion.Coupon{Id: couponId, Name: template.Name, Rule: promotionRule(template.DiscountRule)}
+}
+
+func discountRule(typ string, threshold, amount int64, percent int32, maxDiscount int64, scope string, scopeId int32) model.DiscountRule {
+	if scope == "" {
+		scope = promotion.ScopeAll
+	}
+	return model.DiscountRule{
+		Type:        typ,
+		Threshold:   threshold,
+		Amount:      amount,
+		Percent:     percent,
+		MaxDiscount: maxDiscount,
+		Scope:       scope,
+		ScopeId:     scopeId,
+	}
+}
+
+func promotionRule(r model.DiscountRule) promotion.Rule {
+	return promotion.Rule{
+		Type:        r.Type,
+		Threshold:   r.Threshold,
+		Amount:      r.Amount,
+		Percent:     r.Percent,
+		MaxDiscount: r.MaxDiscount,
+		Scope:       r.Scope,
+		ScopeId:     r.ScopeId,
+	}
+}
+
+func discountsToInfo(discounts []promotion.Discount) []*proto.DiscountInfo {
+	infos := make([]*proto.DiscountInfo, 0, len(discounts))
+	for _, d := range discounts {
+		infos = append(infos, &proto.DiscountInfo{
+			Source:   d.Source,
+			SourceId: d.SourceId,
+			Name:     d.Name,
+			Amount:   d.Amount,
+		})
+	}
+	return infos
+}
+
+func couponTemplateToInfo(t *model.CouponTemplate) *proto.CouponTemplateInfo {
+	info := &proto.CouponTemplateInfo{
+		Id:           t.ID,
+		Name:         t.Name,
+		Type:         t.Type,
+		Threshold:    t.Threshold,
+		Amount:       t.Amount,
+		Percent:      t.Percent,
+		MaxDiscount:  t.MaxDiscount,
+		Scope:        t.Scope,
+		ScopeId:      t.ScopeId,
+		Total:        t.Total,
+		Issued:       t.Issued,
+		PerUserLimit: t.PerUserLimit,
+		ValidDays:    t.ValidDays,
+		Enabled:      t.Enabled,
+	}
+	if t.StartTime != nil {
+		info.StartTime = t.StartTime.Unix()
+	}
+	if t.EndTime != nil {
+		info.EndTime = t.EndTime.Unix()
+	}
+	return info
+}
+
+func userCouponToInfo(c *model.UserCoupon, t *model.CouponTemplate) *proto.UserCouponInfo {
+	info := &proto.UserCouponInfo{
+		Id:         c.ID,
+		UserId:     c.User,
+		TemplateId: c.Template,
+		Status:     c.Status,
+		ExpireTime: c.ExpireAt.Unix(),
+		OrderSn:    c.OrderSn,
+	}
+	if c.Status == model.CouponStatusUnused && !c.ExpireAt.After(time.Now()) {
+		info.Status = model.CouponStatusExpired
+	}
+	if c.UsedAt != nil {
+		info.UsedTime = c.UsedAt.Unix()
+	}
+	if t != nil {
+		info.Name = t.Name
+		info.Type = t.Type
+		info.Threshold = t.Threshold
+		info.Amount = t.Amount
+		info.Percent = t.Percent
+		info.MaxDiscount = t.MaxDiscount
+		info.Scope = t.Scope
+		info.ScopeId = t.ScopeId
+	}
+	return info
+}
+
+func promotionToInfo(p *model.Promotion) *proto.PromotionInfo {
+	return &proto.PromotionInfo{
+		Id:          p.ID,
+		Name:        p.Name,
+		Type:        p.Type,
+		Threshold:   p.Threshold,
+		Amount:      p.Amount,
+		Percent:     p.Percent,
+		MaxDiscount: p.MaxDiscount,
+		Scope:       p.Scope,
+		ScopeId:     p.ScopeId,
+		StartTime:   p.StartTime.Unix(),
+		EndTime:     p.EndTime.Unix(),
+		Enabled:     p.Enabled,
+	}
+}
+
+func normalizePage(page, pageSize int32) (int32, int32) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	return page, pageSize
+}
+
+func unixTime(sec int64) *time.Time {
+	if sec <= 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
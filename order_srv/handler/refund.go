@@ -0,0 +1,704 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"order_srv/fsm"
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/payment"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// refundTransitions 售后单状态流转规则
+var refundTransitions = map[string][]string{
+	model.RefundStatusPending:    {model.RefundStatusWaitReturn, model.RefundStatusRefunding, model.RefundStatusRejected, model.RefundStatusCancelled},
+	model.RefundStatusWaitReturn: {model.RefundStatusRefunding, model.RefundStatusCancelled},
+	model.RefundStatusRefunding:  {model.RefundStatusRefunded, model.RefundStatusFailed},
+	model.RefundStatusFailed:     {model.RefundStatusRefunding, model.RefundStatusRejected},
+}
+
+// 可以申请售后的订单状态
+var refundableOrderStatus = map[string]bool{
+	"TRADE_SUCCESS":  true,
+	"PART_SHIPPED":   true,
+	"SHIPPED":        true,
+	"TRADE_FINISHED": true,
+}
+
+// 不计入已退金额和已退数量的售后单状态
+var closedRefundStatus = []string{model.RefundStatusRejected, model.RefundStatusCancelled}
+
+// 退款查询任务：退款中的售后单超过refundSyncAfter未更新时向渠道确认退款结果
+const (
+	refundSyncScanEvery = time.Minute
+	refundSyncAfter     = time.Minute
+	refundSyncBatch     = 100
+)
+
+var (
+	errRefundStatusChanged     = errors.New("售后单状态已变化")
+	errInvalidRefundTransition = errors.New("售后单当前状态不允许该操作")
+)
+
+// RefundCreate 申请售后，同一订单同时只能有一个处理中的售后单
+// 退款金额不能超过订单（或订单商品）剩余可退金额，退货数量不能超过剩余可退数量
+func (s *OrderServiceServer) RefundCreate(ctx context.Context, req *proto.RefundRequest) (*proto.RefundInfoResponse, error) {
+	global.Logger.Infof("申请售后，订单ID: %d，用户ID: %d，订单商品ID: %d，类型: %s", req.OrderId, req.UserId, req.OrderGoodsId, req.Type)
+
+	if req.OrderId <= 0 || req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "订单ID和用户ID必须大于0")
+	}
+	if req.Type != model.RefundTypeRefundOnly && req.Type != model.RefundTypeReturnGoods {
+		return nil, status.Errorf(codes.InvalidArgument, "无效的售后类型")
+	}
+	if req.Reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "售后原因不能为空")
+	}
+	if req.RefundAmount < 0 || req.Nums < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "退款金额和退货数量不能为负数")
+	}
+
+	lock := utils.NewRedisLock(fmt.Sprintf("order_refund_lock:%d", req.OrderId), 10*time.Second)
+	locked, err := lock.TryLock(ctx, 3, 50*time.Millisecond)
+	if err != nil || !locked {
+		global.Logger.Warnf("获取售后锁失败，订单ID: %d，错误: %v", req.OrderId, err)
+		return nil, status.Errorf(codes.ResourceExhausted, "订单正在处理中，请稍后重试")
+	}
+	defer func() {
+		if unlockErr := lock.Unlock(ctx); unlockErr != nil {
+			global.Logger.Errorf("释放售后锁失败: %v", unlockErr)
+		}
+	}()
+
+	refundSn, err := utils.GenerateOrderSn()
+	if err != nil {
+		global.Logger.Errorf("生成售后单号失败: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "系统忙，请稍后重试")
+	}
+
+	var refund model.RefundOrder
+	var items []model.RefundGoods
+	err = global.DB.Transaction(func(tx *gorm.DB) error {
+		var orderInfo model.OrderInfo
+		if err := tx.Where("id = ? AND user = ?", req.OrderId, req.UserId).First(&orderInfo).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "订单不存在")
+			}
+			return err
+		}
+		if !refundableOrderStatus[orderInfo.Status] {
+			return status.Errorf(codes.FailedPrecondition, "订单当前状态不能申请售后")
+		}
+
+		var activeCount int64
+		if err := tx.Model(&model.RefundOrder{}).
+			Where("`order` = ? AND status IN ?", orderInfo.ID, activeRefundStatus()).
+			Count(&activeCount).Error; err != nil {
+			return err
+		}
+		if activeCount > 0 {
+			return status.Errorf(codes.FailedPrecondition, "该订单有正在处理的售后，请等待处理完成")
+		}
+
+		var orderGoods []model.OrderGoods
+		if err := tx.Where("`order` = ?", orderInfo.ID).Find(&orderGoods).Error; err != nil {
+			return err
+		}
+		returned, err := returnedGoodsNums(tx, orderInfo.ID)
+		if err != nil {
+			return err
+		}
+		orderRefunded, err := refundedAmount(tx, "`order` = ?", orderInfo.ID)
+		if err != nil {
+			return err
+		}
+		maxCents := int64(orderInfo.OrderMount) - orderRefunded
+
+		if req.OrderGoodsId > 0 {
+			var line *model.OrderGoods
+			for i := range orderGoods {
+				if orderGoods[i].ID == req.OrderGoodsId {
+					line = &orderGoods[i]
+					break
+				}
+			}
+			if line == nil {
+				return status.Errorf(codes.NotFound, "订单商品不存在")
+			}
+			lineRefunded, err := refundedAmount(tx, "`order` = ? AND order_goods = ?", orderInfo.ID, line.ID)
+			if err != nil {
+				return err
+			}
+			maxCents = minInt64(maxCents, lineCents(line, line.Nums)-lineRefunded)
+
+			if req.Type == model.RefundTypeReturnGoods {
+				remaining := line.Nums - returned[line.ID]
+				nums := req.Nums
+				if nums == 0 {
+					nums = remaining
+				}
+				if nums <= 0 || nums > remaining {
+					return status.Errorf(codes.FailedPrecondition, "退货数量超出可退数量，最多可退%d件", remaining)
+				}
+				// 部分退货时可退金额按退货数量折算
+				maxCents = minInt64(maxCents, lineCents(line, nums))
+				items = append(items, model.RefundGoods{OrderGoods: line.ID, Goods: line.Goods, Nums: nums})
+			}
+		} else if req.Type == model.RefundTypeReturnGoods {
+			for _, line := range orderGoods {
+				if remaining := line.Nums - returned[line.ID]; remaining > 0 {
+					items = append(items, model.RefundGoods{OrderGoods: line.ID, Goods: line.Goods, Nums: remaining})
+				}
+			}
+			if len(items) == 0 {
+				return status.Errorf(codes.FailedPrecondition, "订单没有可退货的商品")
+			}
+		}
+
+		if maxCents <= 0 {
+			return status.Errorf(codes.FailedPrecondition, "没有可退款的金额")
+		}
+		amount := req.RefundAmount
+		if amount == 0 {
+			amount = maxCents
+		}
+		if amount > maxCents {
+			return status.Errorf(codes.FailedPrecondition, "退款金额超出可退金额，最多可退%s元", payment.CentsToYuan(maxCents))
+		}
+
+		refund = model.RefundOrder{
+			RefundSn:    "R" + refundSn,
+			Order:       orderInfo.ID,
+			OrderSn:     orderInfo.OrderSn,
+			User:        orderInfo.User,
+			OrderGoods:  req.OrderGoodsId,
+			Type:        req.Type,
+			Status:      model.RefundStatusPending,
+			Amount:      amount,
+			Reason:      req.Reason,
+			Description: req.Description,
+			Message:     req.MessageId,
+		}
+		if err := tx.Create(&refund).Error; err != nil {
+			return err
+		}
+		for i := range items {
+			items[i].Refund = refund.ID
+		}
+		if len(items) > 0 {
+			if err := tx.Create(&items).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		global.Logger.Errorf("创建售后单失败，订单ID: %d，错误: %v", req.OrderId, err)
+		return nil, status.Errorf(codes.Internal, "申请售后失败")
+	}
+
+	global.Logger.Infof("成功创建售后单，售后单号: %s，退款金额: %s", refund.RefundSn, payment.CentsToYuan(refund.Amount))
+	return refundToResponse(&refund, items), nil
+}
+
+// RefundAudit 审核售后单
+// 仅退款同意后直接退款；退货退款同意后等待商家确认收货；退款失败的售后单再次同意时重试退款
+func (s *OrderServiceServer) RefundAudit(ctx context.Context, req *proto.RefundAuditRequest) (*proto.RefundInfoResponse, error) {
+	global.Logger.Infof("审核售后，售后单ID: %d，是否同意: %v", req.Id, req.Approve)
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "售后单ID必须大于0")
+	}
+	if !req.Approve && req.Remark == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "拒绝售后时必须填写原因")
+	}
+
+	refund, err := findRefund(req.Id, 0)
+	if err != nil {
+		return nil, err
+	}
+	if refund.Status != model.RefundStatusPending && refund.Status != model.RefundStatusFailed {
+		return nil, status.Errorf(codes.FailedPrecondition, "售后单当前状态不需要审核")
+	}
+
+	var next string
+	switch {
+	case !req.Approve:
+		next = model.RefundStatusRejected
+	case refund.Status == model.RefundStatusPending && refund.Type == model.RefundTypeReturnGoods:
+		next = model.RefundStatusWaitReturn
+	default:
+		next = model.RefundStatusRefunding
+	}
+	updates := map[string]interface{}{}
+	if req.Remark != "" {
+		updates["audit_remark"] = req.Remark
+	}
+	if err := transitRefund(global.DB, refund, next, updates); err != nil {
+		return nil, refundTransitError(refund, err)
+	}
+
+	if next == model.RefundStatusRefunding {
+		executeRefund(ctx, refund)
+	}
+	return loadRefundResponse(refund.ID)
+}
+
+// RefundConfirmReturn 商家确认收到退货，写入归还库存消息后退款
+func (s *OrderServiceServer) RefundConfirmReturn(ctx context.Context, req *proto.RefundOperateRequest) (*proto.RefundInfoResponse, error) {
+	global.Logger.Infof("确认收到退货，售后单ID: %d", req.Id)
+
+	refund, err := findRefund(req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if refund.Type != model.RefundTypeReturnGoods {
+		return nil, status.Errorf(codes.FailedPrecondition, "仅退款的售后单不需要确认退货")
+	}
+	// 归还库存消息与状态变更在同一事务写入发件箱，由投递任务重试直到库存归还
+	prev := refund.Status
+	err = global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := transitRefund(tx, refund, model.RefundStatusRefunding, map[string]interface{}{"stock_returned": true}); err != nil {
+			return err
+		}
+		return enqueueRefundStockReback(tx, refund)
+	})
+	if err != nil {
+		refund.Status = prev
+		return nil, refundTransitError(refund, err)
+	}
+	refund.StockReturned = true
+
+	executeRefund(ctx, refund)
+	return loadRefundResponse(refund.ID)
+}
+
+// RefundCancel 用户撤销售后，已开始退款的售后单不能撤销
+func (s *OrderServiceServer) RefundCancel(ctx context.Context, req *proto.RefundOperateRequest) (*emptypb.Empty, error) {
+	global.Logger.Infof("撤销售后，售后单ID: %d，用户ID: %d", req.Id, req.UserId)
+
+	refund, err := findRefund(req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := transitRefund(global.DB, refund, model.RefundStatusCancelled, nil); err != nil {
+		return nil, refundTransitError(refund, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RefundList 售后列表，提供用户ID时只查询该用户的售后单
+func (s *OrderServiceServer) RefundList(ctx context.Context, req *proto.RefundFilterRequest) (*proto.RefundListResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	query := global.DB.Model(&model.RefundOrder{})
+	if req.UserId > 0 {
+		query = query.Where("user = ?", req.UserId)
+	}
+	if req.OrderId > 0 {
+		query = query.Where("`order` = ?", req.OrderId)
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		global.Logger.Errorf("查询售后总数失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询售后列表失败")
+	}
+	var refunds []model.RefundOrder
+	offset := (req.Page - 1) * req.PageSize
+	if err := query.Offset(int(offset)).Limit(int(req.PageSize)).Order("id DESC").Find(&refunds).Error; err != nil {
+		global.Logger.Errorf("查询售后列表失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询售后列表失败")
+	}
+
+	ids := make([]int32, 0, len(refunds))
+	for _, refund := range refunds {
+		ids = append(ids, refund.ID)
+	}
+	var goods []model.RefundGoods
+	if len(ids) > 0 {
+		if err := global.DB.Where("refund IN ?", ids).Find(&goods).Error; err != nil {
+			global.Logger.Errorf("查询退货商品失败: %v", err)
+			return nil, status.Errorf(codes.Internal, "查询售后列表失败")
+		}
+	}
+	goodsMap := make(map[int32][]model.RefundGoods)
+	for _, g := range goods {
+		goodsMap[g.Refund] = append(goodsMap[g.Refund], g)
+	}
+
+	data := make([]*proto.RefundInfoResponse, 0, len(refunds))
+	for i := range refunds {
+		data = append(data, refundToResponse(&refunds[i], goodsMap[refunds[i].ID]))
+	}
+	return &proto.RefundListResponse{
+		Total: int32(total),
+		Data:  data,
+	}, nil
+}
+
+// executeRefund 调用原支付渠道退款，售后单需已处于退款中状态
+// 渠道退款处理中时售后单保持退款中，由退款查询任务确认结果；渠道退款失败时售后单置为退款失败并记录原因，可通过再次审核同意重试
+func executeRefund(ctx context.Context, refund *model.RefundOrder) {
+	paySn := refundPaySn(refund)
+	var record model.PaymentRecord
+	err := global.DB.Where("order_sn = ? AND status = ?", paySn, model.PaymentStatusSuccess).First(&record).Error
+	if err != nil {
+		reason := "查询支付单失败"
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			reason = "订单没有在线支付记录，需线下退款"
+		}
+		failRefund(refund, reason)
+		return
+	}
+	provider, err := payment.Get(record.PayType)
+	if err != nil {
+		failRefund(refund, fmt.Sprintf("支付渠道%s未启用", record.PayType))
+		return
+	}
+
+	// 使用售后单号作为渠道退款单号，重试时渠道不会重复退款
+	result, err := provider.Refund(ctx, &payment.RefundRequest{
+		OutTradeNo:  paySn,
+		OutRefundNo: refund.RefundSn,
+		TotalCents:  record.Amount,
+		RefundCents: refund.Amount,
+		Reason:      refund.Reason,
+	})
+	if err != nil {
+		global.Logger.Errorf("渠道退款失败，售后单号: %s，错误: %v", refund.RefundSn, err)
+		failRefund(refund, err.Error())
+		return
+	}
+	applyRefundResult(ctx, refund, record.PayType, result)
+}
+
+// refundPaySn 售后单向渠道退款使用的商户订单号，拆单的子订单按父订单支付，使用父订单号
+func refundPaySn(refund *model.RefundOrder) string {
+	var orderInfo model.OrderInfo
+	if err := global.DB.Unscoped().Select("id", "order_sn", "parent_sn").First(&orderInfo, refund.Order).Error; err != nil {
+		return refund.OrderSn
+	}
+	return paymentOrderSn(&orderInfo)
+}
+
+// applyRefundResult 按渠道退款状态更新售后单，只有退款已到账时才置为已退款
+func applyRefundResult(ctx context.Context, refund *model.RefundOrder, payType string, result *payment.RefundResult) {
+	switch result.Status {
+	case payment.RefundStatusSuccess:
+		completeRefund(ctx, refund, payType, result.RefundNo)
+	case payment.RefundStatusClosed:
+		failRefund(refund, "渠道退款已关闭")
+	case payment.RefundStatusAbnormal:
+		failRefund(refund, "渠道退款异常，请联系支付渠道处理")
+	default:
+		// 渠道已受理退款，记录渠道和渠道退款单号，同时刷新更新时间，退款查询任务按更新时间轮流确认
+		err := global.DB.Model(&model.RefundOrder{}).
+			Where("id = ? AND status = ?", refund.ID, model.RefundStatusRefunding).
+			Updates(map[string]interface{}{
+				"pay_type":       payType,
+				"channel_refund": result.RefundNo,
+				"fail_reason":    "",
+			}).Error
+		if err != nil {
+			global.Logger.Errorf("记录渠道退款单号失败，售后单号: %s，错误: %v", refund.RefundSn, err)
+			return
+		}
+		refund.PayType = payType
+		refund.ChannelRefund = result.RefundNo
+		global.Logger.Infof("渠道退款处理中，售后单号: %s，渠道状态: %s", refund.RefundSn, result.Status)
+	}
+}
+
+// completeRefund 渠道退款已到账，售后单置为已退款，订单金额全部退回时订单置为已退款
+func completeRefund(ctx context.Context, refund *model.RefundOrder, payType, channelRefund string) {
+	now := time.Now()
+	prev := refund.Status
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := transitRefund(tx, refund, model.RefundStatusRefunded, map[string]interface{}{
+			"pay_type":       payType,
+			"channel_refund": channelRefund,
+			"fail_reason":    "",
+			"refunded_at":    &now,
+		}); err != nil {
+			return err
+		}
+		return refreshOrderRefundStatus(ctx, tx, refund)
+	})
+	if err != nil {
+		// 渠道已退款，售后单仍为退款中，由退款查询任务再次同步
+		refund.Status = prev
+		global.Logger.Errorf("更新售后单退款结果失败，售后单号: %s，错误: %v", refund.RefundSn, err)
+		return
+	}
+	refund.PayType = payType
+	refund.ChannelRefund = channelRefund
+	refund.RefundedAt = &now
+	global.Logger.Infof("售后退款成功，售后单号: %s，金额: %s", refund.RefundSn, payment.CentsToYuan(refund.Amount))
+}
+
+func failRefund(refund *model.RefundOrder, reason string) {
+	if len([]rune(reason)) > 200 {
+		reason = string([]rune(reason)[:200])
+	}
+	if err := transitRefund(global.DB, refund, model.RefundStatusFailed, map[string]interface{}{"fail_reason": reason}); err != nil {
+		global.Logger.Errorf("更新售后单为退款失败出错，售后单号: %s，错误: %v", refund.RefundSn, err)
+		return
+	}
+	refund.FailReason = reason
+}
+
+// SyncRefundingRefunds 确认退款中售后单的渠道退款结果
+// 已记录渠道的售后单查询渠道退款状态；未记录渠道的说明退款请求没有完成（如调用渠道前服务重启），重新发起退款，渠道按售后单号去重
+func SyncRefundingRefunds(ctx context.Context) {
+	var refunds []model.RefundOrder
+	if err := global.DB.Where("status = ? AND updated_at <= ?", model.RefundStatusRefunding, time.Now().Add(-refundSyncAfter)).
+		Order("updated_at").Limit(refundSyncBatch).Find(&refunds).Error; err != nil {
+		global.Logger.Errorf("查询退款中的售后单失败: %v", err)
+		return
+	}
+	for i := range refunds {
+		if ctx.Err() != nil {
+			return
+		}
+		refund := &refunds[i]
+		if refund.PayType == "" {
+			executeRefund(ctx, refund)
+			continue
+		}
+		syncRefund(ctx, refund)
+	}
+}
+
+// syncRefund 向渠道查询售后单的退款状态，渠道侧没有该退款时重新发起退款
+func syncRefund(ctx context.Context, refund *model.RefundOrder) {
+	provider, err := payment.Get(refund.PayType)
+	if err != nil {
+		global.Logger.Errorf("查询渠道退款失败，支付渠道%s未启用，售后单号: %s", refund.PayType, refund.RefundSn)
+		return
+	}
+	result, err := provider.QueryRefund(ctx, refundPaySn(refund), refund.RefundSn)
+	if errors.Is(err, payment.ErrRefundNotFound) {
+		executeRefund(ctx, refund)
+		return
+	}
+	if err != nil {
+		global.Logger.Errorf("查询渠道退款失败，售后单号: %s，错误: %v", refund.RefundSn, err)
+		return
+	}
+	applyRefundResult(ctx, refund, refund.PayType, result)
+}
+
+func runRefundSync(ctx context.Context) {
+	ticker := time.NewTicker(refundSyncScanEvery)
+	defer ticker.Stop()
+	SyncRefundingRefunds(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			SyncRefundingRefunds(ctx)
+		}
+	}
+}
+
+// refreshOrderRefundStatus 订单金额全部退回后将订单置为已退款，是否全部退回由状态机守卫判断
+func refreshOrderRefundStatus(ctx context.Context, tx *gorm.DB, refund *model.RefundOrder) error {
+	var orderInfo model.OrderInfo
+	if err := tx.First(&orderInfo, refund.Order).Error; err != nil {
+		return err
+	}
+	if !orderMachine.Can(fsm.State(orderInfo.Status), fsm.EventRefund) {
+		return nil
+	}
+	err := transitOrder(ctx, tx, &orderInfo, fsm.EventRefund, actorSystem, "售后单"+refund.RefundSn+"退款完成", nil)
+	if errors.Is(err, errOrderNotFullyRefunded) {
+		return nil
+	}
+	return err
+}
+
+// transitRefund 按状态流转规则更新售后单状态，更新带有原状态条件，并发修改时返回errRefundStatusChanged
+func transitRefund(tx *gorm.DB, refund *model.RefundOrder, to string, updates map[string]interface{}) error {
+	if !isValidRefundTransition(refund.Status, to) {
+		return fmt.Errorf("%w: %s -> %s", errInvalidRefundTransition, refund.Status, to)
+	}
+	values := map[string]interface{}{"status": to}
+	for k, v := range updates {
+		values[k] = v
+	}
+	result := tx.Model(&model.RefundOrder{}).Where("id = ? AND status = ?", refund.ID, refund.Status).Updates(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errRefundStatusChanged
+	}
+	global.Logger.Infof("售后单状态变更，售后单号: %s，%s -> %s", refund.RefundSn, refund.Status, to)
+	refund.Status = to
+	return nil
+}
+
+// isValidRefundTransition 验证售后单状态转换是否合法
+func isValidRefundTransition(from, to string) bool {
+	for _, next := range refundTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// refundTransitError 将售后单状态更新错误转换为gRPC错误
+func refundTransitError(refund *model.RefundOrder, err error) error {
+	switch {
+	case errors.Is(err, errRefundStatusChanged):
+		return status.Errorf(codes.Aborted, "售后单状态已变化，请刷新后重试")
+	case errors.Is(err, errInvalidRefundTransition):
+		return status.Errorf(codes.FailedPrecondition, "售后单当前状态不允许该操作")
+	default:
+		global.Logger.Errorf("更新售后单状态失败，售后单号: %s，错误: %v", refund.RefundSn, err)
+		return status.Errorf(codes.Internal, "更新售后单失败")
+	}
+}
+
+func findRefund(id, userId int32) (*model.RefundOrder, error) {
+	if id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "售后单ID必须大于0")
+	}
+	var refund model.RefundOrder
+	query := global.DB.Where("id = ?", id)
+	if userId > 0 {
+		query = query.Where("user = ?", userId)
+	}
+	if err := query.First(&refund).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "售后单不存在")
+		}
+		global.Logger.Errorf("查询售后单失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询售后单失败")
+	}
+	return &refund, nil
+}
+
+func loadRefundResponse(id int32) (*proto.RefundInfoResponse, error) {
+	refund, err := findRefund(id, 0)
+	if err != nil {
+		return nil, err
+	}
+	var goods []model.RefundGoods
+	if err := global.DB.Where("refund = ?", id).Find(&goods).Error; err != nil {
+		global.Logger.Errorf("查询退货商品失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询售后单失败")
+	}
+	return refundToResponse(refund, goods), nil
+}
+
+func refundToResponse(refund *model.RefundOrder, goods []model.RefundGoods) *proto.RefundInfoResponse {
+	resp := &proto.RefundInfoResponse{
+		Id:           refund.ID,
+		RefundSn:     refund.RefundSn,
+		OrderId:      refund.Order,
+		OrderSn:      refund.OrderSn,
+		UserId:       refund.User,
+		OrderGoodsId: refund.OrderGoods,
+		Type:         refund.Type,
+		Status:       refund.Status,
+		RefundAmount: refund.Amount,
+		Reason:       refund.Reason,
+		Description:  refund.Description,
+		AuditRemark:  refund.AuditRemark,
+		FailReason:   refund.FailReason,
+		MessageId:    refund.Message,
+		AddTime:      refund.CreatedAt.Unix(),
+	}
+	if refund.RefundedAt != nil {
+		resp.RefundedTime = refund.RefundedAt.Unix()
+	}
+	for _, g := range goods {
+		resp.Goods = append(resp.Goods, &proto.RefundGoodsInfo{
+			OrderGoodsId: g.OrderGoods,
+			GoodsId:      g.Goods,
+			Nums:         g.Nums,
+		})
+	}
+	return resp
+}
+
+func activeRefundStatus() []string {
+	return []string{model.RefundStatusPending, model.RefundStatusWaitReturn, model.RefundStatusRefunding, model.RefundStatusFailed}
+}
+
+// refundedAmount 统计未被拒绝或撤销的售后单退款金额（分）
+func refundedAmount(tx *gorm.DB, where string, args ...interface{}) (int64, error) {
+	var total int64
+	err := tx.Model(&model.RefundOrder{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where(where, args...).
+		Where("status NOT IN ?", closedRefundStatus).
+		Scan(&total).Error
+	return total, err
+}
+
+// returnedGoodsNums 统计订单每个商品已申请退货的数量
+func returnedGoodsNums(tx *gorm.DB, orderId int32) (map[int32]int32, error) {
+	var rows []struct {
+		OrderGoods int32
+		Nums       int32
+	}
+	err := tx.Model(&model.RefundGoods{}).
+		Select("refund_goods.order_goods, SUM(refund_goods.nums) AS nums").
+		Joins("JOIN refund_order ON refund_order.id = refund_goods.refund AND refund_order.deleted_at IS NULL").
+		Where("refund_order.`order` = ? AND refund_order.status NOT IN ?", orderId, closedRefundStatus).
+		Group("refund_goods.order_goods").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int32]int32, len(rows))
+	for _, row := range rows {
+		result[row.OrderGoods] = row.Nums
+	}
+	return result, nil
+}
+
+// lineCents 订单商品指定数量的实付金额（分），扣除下单时分摊的优惠
+func lineCents(line *model.OrderGoods, nums int32) int64 {
+	paid := int64(line.GoodsPrice)*int64(line.Nums) - int64(line.DiscountAmount)
+	if nums == line.Nums || line.Nums == 0 {
+		return paid
+	}
+	return paid * int64(nums) / int64(line.Nums)
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
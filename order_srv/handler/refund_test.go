@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"testing"
+
+	"order_srv/model"
+)
+
+// TestRefundTransitionRules 测试售后单状态流转规则，终态不能再变更
+func TestRefundTransitionRules(t *testing.T) {
+	cases := []struct {
+		from, to string
+		want     bool
+	}{
+		{model.RefundStatusPending, model.RefundStatusWaitReturn, true},
+		{model.RefundStatusPending, model.RefundStatusRefunding, true},
+		{model.RefundStatusPending, model.RefundStatusRefunded, false},
+		{model.RefundStatusWaitReturn, model.RefundStatusRefunding, true},
+		{model.RefundStatusWaitReturn, model.RefundStatusRejected, false},
+		{model.RefundStatusRefunding, model.RefundStatusRefunded, true},
+		{model.RefundStatusRefunding, model.RefundStatusFailed, true},
+		{model.RefundStatusRefunding, model.RefundStatusWaitReturn, false},
+		{model.RefundStatusRefunding, model.RefundStatusCancelled, false},
+		{model.RefundStatusFailed, model.RefundStatusRefunding, true},
+		{model.RefundStatusFailed, model.RefundStatusCancelled, false},
+		{model.RefundStatusRefunded, model.RefundStatusRefunding, false},
+		{model.RefundStatusRejected, model.RefundStatusPending, false},
+		{model.RefundStatusCancelled, model.RefundStatusPending, false},
+	}
+	for _, c := range cases {
+		if got := isValidRefundTransition(c.from, c.to); got != c.want {
+			t.Errorf("%s -> %s 期望 %v，实际 %v", c.from, c.to, c.want, got)
+		}
+	}
+}
+
+// TestLineCents 测试按退货数量折算订单商品的实付金额
+func TestLineCents(t *testing.T) {
+	line := &model.OrderGoods{GoodsPrice: 1000, Nums: 3, DiscountAmount: 300}
+	cases := map[int32]int64{3: 2700, 1: 900, 2: 1800}
+	for nums, want := range cases {
+		if got := lineCents(line, nums); got != want {
+			t.Errorf("退 %d 件金额期望 %d，实际 %d", nums, want, got)
+		}
+	}
+	if got := lineCents(&model.OrderGoods{GoodsPrice: 1000}, 0); got != 0 {
+		t.Errorf("数量为0的商品金额应为0，实际 %d", got)
+	}
+}
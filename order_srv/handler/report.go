@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/proto"
+	"order_srv/report"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultReportRefreshInterval = 10 * time.Minute
+	defaultReportRefreshDays     = 45
+	reportMaxDays                = 366 // 单次查询的最大日期范围
+	topGoodsDefaultLimit         = 10
+	topGoodsMaxLimit             = 100
+)
+
+// paidOrderStatuses 已支付且未全额退款的订单状态，商品排行默认只统计这些订单
+var paidOrderStatuses = []string{"TRADE_SUCCESS", "PART_SHIPPED", "SHIPPED", "TRADE_FINISHED"}
+
+// refreshOrderStatsSQL 按下单日期和订单当前状态重算订单汇总，已删除的订单也计入
+const refreshOrderStatsSQL = "INSERT INTO order_daily_stat (created_at, updated_at, is_deleted, stat_date, status, order_count, paid_count, order_amount, paid_amount) " +
+	"SELECT ?, ?, false, DATE(created_at), status, COUNT(*), SUM(pay_time IS NOT NULL), SUM(order_mount), " +
+	"SUM(CASE WHEN pay_time IS NOT NULL THEN order_mount ELSE 0 END) " +
+	"FROM order_info WHERE created_at >= ? AND created_at < ? GROUP BY DATE(created_at), status"
+
+// refreshGoodsStatsSQL 按下单日期、订单当前状态和商品重算销量
+const refreshGoodsStatsSQL = "INSERT INTO goods_daily_stat (created_at, updated_at, is_deleted, stat_date, status, goods, goods_name, nums, revenue) " +
+	"SELECT ?, ?, false, DATE(o.created_at), o.status, g.goods, MAX(g.goods_name), SUM(g.nums), SUM(g.goods_price * g.nums - g.discount_amount) " +
+	"FROM order_goods g JOIN order_info o ON o.id = g.`order` " +
+	"WHERE o.created_at >= ? AND o.created_at < ? GROUP BY DATE(o.created_at), o.status, g.goods"
+
+// RefreshOrderStats 重算最近若干天的汇总表，订单状态在售后期内仍会变化，因此每次整天重算而不是增量累加
+func RefreshOrderStats(ctx context.Context) error {
+	days := global.ServerConfig.Report.RefreshDays
+	if days <= 0 {
+		days = defaultReportRefreshDays
+	}
+	now := time.Now()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1)
+	start := end.AddDate(0, 0, -days)
+
+	return global.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("stat_date >= ?", start).Delete(&model.OrderDailyStat{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("stat_date >= ?", start).Delete(&model.GoodsDailyStat{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(refreshOrderStatsSQL, now, now, start, end).Error; err != nil {
+			return err
+		}
+		return tx.Exec(refreshGoodsStatsSQL, now, now, start, end).Error
+	})
+}
+
+// runOrderStatsRefresh 定时刷新报表汇总表
+func runOrderStatsRefresh(ctx context.Context) {
+	interval := defaultReportRefreshInterval
+	if seconds := global.ServerConfig.Report.RefreshInterval; seconds > 0 {
+		interval = time.Duration(seconds) * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		start := time.Now()
+		if err := RefreshOrderStats(ctx); err != nil {
+			global.Logger.Errorf("刷新报表汇总表失败: %v", err)
+		} else {
+			global.Logger.Infof("报表汇总表已刷新，耗时: %v", time.Since(start))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SalesStats 按日、周、月统计GMV、订单数、转化率和客单价，没有订单的周期补零
+func (s *OrderServiceServer) SalesStats(ctx context.Context, req *proto.SalesStatsRequest) (*proto.SalesStatsResponse, error) {
+	start, end, err := parseReportRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	period := req.Period
+	if period == "" {
+		period = report.PeriodDay
+	}
+	if !report.ValidPeriod(period) {
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的汇总周期: %s", period)
+	}
+
+	var rows []struct {
+		StatDate    time.Time
+		OrderCount  int64
+		PaidCount   int64
+		OrderAmount model.Money
+		PaidAmount  model.Money
+	}
+	query := global.DB.Model(&model.OrderDailyStat{}).
+		Select("stat_date, SUM(order_count) AS order_count, SUM(paid_count) AS paid_count, SUM(order_amount) AS order_amount, SUM(paid_amount) AS paid_amount").
+		Where("stat_date >= ? AND stat_date <= ?", start, end)
+	if len(req.Statuses) > 0 {
+		query = query.Where("status IN ?", req.Statuses)
+	}
+	if err := query.Group("stat_date").Scan(&rows).Error; err != nil {
+		global.Logger.Errorf("查询订单汇总失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询报表失败")
+	}
+
+	daily := make([]report.DailyRow, 0, len(rows))
+	for _, r := range rows {
+		daily = append(daily, report.DailyRow{
+			// 汇总表日期按本地时区解释，与请求的日期保持一致
+			Date:        time.Date(r.StatDate.Year(), r.StatDate.Month(), r.StatDate.Day(), 0, 0, 0, 0, time.Local),
+			OrderCount:  r.OrderCount,
+			PaidCount:   r.PaidCount,
+			OrderAmount: int64(r.OrderAmount),
+			PaidAmount:  int64(r.PaidAmount),
+		})
+	}
+	buckets, err := report.Aggregate(daily, start, end, period)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &proto.SalesStatsResponse{RefreshedAt: statsRefreshedAt(&model.OrderDailyStat{})}
+	for _, b := range buckets {
+		resp.Items = append(resp.Items, bucketToStatsItem(b))
+	}
+	resp.Total = bucketToStatsItem(report.Total(buckets))
+	return resp, nil
+}
+
+// TopGoods 按销量或销售额排行的商品，默认只统计已支付且未全额退款的订单
+func (s *OrderServiceServer) TopGoods(ctx context.Context, req *proto.TopGoodsRequest) (*proto.TopGoodsResponse, error) {
+	start, end, err := parseReportRange(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	orderBy := "nums DESC, revenue DESC"
+	switch req.SortBy {
+	case "", "nums":
+	case "revenue":
+		orderBy = "revenue DESC, nums DESC"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的排序字段: %s", req.SortBy)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = topGoodsDefaultLimit
+	}
+	if limit > topGoodsMaxLimit {
+		limit = topGoodsMaxLimit
+	}
+	statuses := req.Statuses
+	if len(statuses) == 0 {
+		statuses = paidOrderStatuses
+	}
+
+	var rows []struct {
+		Goods     int32
+		GoodsName string
+		Nums      int64
+		Revenue   model.Money
+	}
+	if err := global.DB.Model(&model.GoodsDailyStat{}).
+		Select("goods, MAX(goods_name) AS goods_name, SUM(nums) AS nums, SUM(revenue) AS revenue").
+		Where("stat_date >= ? AND stat_date <= ? AND status IN ?", start, end, statuses).
+		Group("goods").
+		Order(orderBy + ", goods").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		global.Logger.Errorf("查询商品排行失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询报表失败")
+	}
+
+	resp := &proto.TopGoodsResponse{RefreshedAt: statsRefreshedAt(&model.GoodsDailyStat{})}
+	for _, r := range rows {
+		resp.Items = append(resp.Items, &proto.TopGoodsItem{
+			GoodsId:   r.Goods,
+			GoodsName: r.GoodsName,
+			Nums:      r.Nums,
+			Revenue:   int64(r.Revenue),
+		})
+	}
+	return resp, nil
+}
+
+// parseReportRange 解析报表日期范围，两端都包含
+func parseReportRange(startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(report.DateLayout, startDate, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "开始日期格式错误，应为%s", report.DateLayout)
+	}
+	end, err := time.ParseInLocation(report.DateLayout, endDate, time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "结束日期格式错误，应为%s", report.DateLayout)
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "结束日期不能早于开始日期")
+	}
+	if end.Sub(start) > reportMaxDays*24*time.Hour {
+		return time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "日期范围不能超过%d天", reportMaxDays)
+	}
+	return start, end, nil
+}
+
+// statsRefreshedAt 汇总表最近刷新时间，没有数据时为0
+func statsRefreshedAt(table interface{}) int64 {
+	var refreshed *time.Time
+	if err := global.DB.Model(table).Select("MAX(updated_at)").Scan(&refreshed).Error; err != nil || refreshed == nil {
+		return 0
+	}
+	return refreshed.Unix()
+}
+
+func bucketToStatsItem(b report.Bucket) *proto.SalesStatsItem {
+	return &proto.SalesStatsItem{
+		PeriodStart:       b.Start.Format(report.DateLayout),
+		OrderCount:        b.OrderCount,
+		PaidCount:         b.PaidCount,
+		OrderAmount:       b.OrderAmount,
+		Gmv:               b.PaidAmount,
+		ConversionRate:    b.ConversionRate(),
+		AverageOrderValue: b.AverageOrderValue(),
+	}
+}
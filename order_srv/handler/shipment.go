@@ -0,0 +1,423 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"order_srv/fsm"
+	"order_srv/global"
+	"order_srv/model"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAutoConfirmDays = 10            // 未配置时发货后自动确认收货的天数
+	autoConfirmScanEvery   = 1 * time.Hour // 自动确认收货扫描间隔
+	autoConfirmBatchSize   = 100           // 每次扫描处理的订单数量
+)
+
+var errShipmentExists = errors.New("物流单号已存在")
+
+// OrderShip 订单发货，可以只发出部分商品，全部商品发出后订单进入待收货状态并开始计算自动确认收货时间
+func (s *OrderServiceServer) OrderShip(ctx context.Context, req *proto.OrderShipRequest) (*proto.ShipmentInfo, error) {
+	global.Logger.Infof("订单发货，订单ID: %d，物流公司: %s，物流单号: %s", req.OrderId, req.Carrier, req.TrackingNo)
+
+	if req.OrderId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "订单ID必须大于0")
+	}
+	if req.Carrier == "" || req.TrackingNo == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "物流公司和物流单号不能为空")
+	}
+
+	// 与订单状态更新使用同一把锁，避免并发发货重复发出同一商品
+	lock := utils.NewRedisLock(fmt.Sprintf("order_update_lock:id:%d", req.OrderId), 10*time.Second)
+	locked, err := lock.TryLock(ctx, 3, 50*time.Millisecond)
+	if err != nil || !locked {
+		global.Logger.Warnf("获取订单更新锁失败，订单ID: %d，错误: %v", req.OrderId, err)
+		return nil, status.Errorf(codes.Aborted, "订单正在处理中，请稍后重试")
+	}
+	defer func() {
+		if unlockErr := lock.Unlock(ctx); unlockErr != nil {
+			global.Logger.Errorf("释放订单更新锁失败: %v", unlockErr)
+		}
+	}()
+
+	order, err := findOrder(req.OrderId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !orderMachine.Can(fsm.State(order.Status), fsm.EventShip) {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单当前状态不能发货")
+	}
+
+	remaining, err := unshippedGoods(global.DB, order.ID)
+	if err != nil {
+		global.Logger.Errorf("查询未发货商品失败，订单号: %s，错误: %v", order.OrderSn, err)
+		return nil, status.Errorf(codes.Internal, "查询订单商品失败")
+	}
+	lines, err := shipmentLines(req.Items, remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	shippedAt := time.Now()
+	if req.ShippedAt > 0 {
+		shippedAt = time.Unix(req.ShippedAt, 0)
+	}
+	shipment := model.Shipment{
+		Order:      order.ID,
+		OrderSn:    order.OrderSn,
+		Carrier:    req.Carrier,
+		TrackingNo: req.TrackingNo,
+		ShippedAt:  shippedAt,
+	}
+	err = global.DB.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&model.Shipment{}).Where("carrier = ? AND tracking_no = ?", req.Carrier, req.TrackingNo).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errShipmentExists
+		}
+		if err := tx.Create(&shipment).Error; err != nil {
+			return err
+		}
+		for i := range lines {
+			lines[i].Shipment = shipment.ID
+		}
+		if err := tx.Create(&lines).Error; err != nil {
+			return err
+		}
+
+		// 所有商品都已发出时进入待收货，否则首次发货进入部分发货
+		allShipped := true
+		for _, line := range lines {
+			remaining[line.OrderGoods].Nums -= line.Nums
+		}
+		for _, goods := range remaining {
+			if goods.Nums > 0 {
+				allShipped = false
+				break
+			}
+		}
+		reason := fmt.Sprintf("%s %s", req.Carrier, req.TrackingNo)
+		switch {
+		case allShipped:
+			return transitOrder(ctx, tx, order, fsm.EventShip, actorAdmin, reason, map[string]interface{}{"ship_time": shippedAt})
+		case order.Status == string(fsm.OrderTradeSuccess):
+			return transitOrder(ctx, tx, order, fsm.EventShipPart, actorAdmin, reason, map[string]interface{}{})
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errShipmentExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "物流单号已存在")
+		}
+		global.Logger.Errorf("订单发货失败，订单号: %s，错误: %v", order.OrderSn, err)
+		return nil, orderTransitError(err)
+	}
+
+	global.Logger.Infof("订单发货成功，订单号: %s，发货单ID: %d，订单状态: %s", order.OrderSn, shipment.ID, order.Status)
+	return shipmentToInfo(&shipment, lines, nil), nil
+}
+
+// ShipmentTrackAdd 追加物流轨迹，发货单的最新物流状态取发生时间最晚的轨迹
+func (s *OrderServiceServer) ShipmentTrackAdd(ctx context.Context, req *proto.ShipmentTrackRequest) (*emptypb.Empty, error) {
+	if req.ShipmentId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "发货单ID必须大于0")
+	}
+	if req.Status == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "物流状态不能为空")
+	}
+
+	var shipment model.Shipment
+	if err := global.DB.First(&shipment, req.ShipmentId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "发货单不存在")
+		}
+		global.Logger.Errorf("查询发货单失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询发货单失败")
+	}
+
+	eventTime := time.Now()
+	if req.EventTime > 0 {
+		eventTime = time.Unix(req.EventTime, 0)
+	}
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model.ShipmentTrack{
+			Shipment:    shipment.ID,
+			Status:      req.Status,
+			Location:    req.Location,
+			Description: req.Description,
+			EventTime:   eventTime,
+		}).Error; err != nil {
+			return err
+		}
+		// 物流回调可能乱序到达，按发生时间取最新状态
+		var latest model.ShipmentTrack
+		if err := tx.Where("shipment = ?", shipment.ID).Order("event_time DESC, id DESC").First(&latest).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.Shipment{}).Where("id = ?", shipment.ID).Update("status", latest.Status).Error
+	})
+	if err != nil {
+		global.Logger.Errorf("追加物流轨迹失败，发货单ID: %d，错误: %v", shipment.ID, err)
+		return nil, status.Errorf(codes.Internal, "追加物流轨迹失败")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// OrderShipmentList 查询订单的发货单和物流轨迹，提供用户ID时校验订单所属用户
+func (s *OrderServiceServer) OrderShipmentList(ctx context.Context, req *proto.OrderRequest) (*proto.ShipmentListResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "订单ID必须大于0")
+	}
+	order, err := findOrder(req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var shipments []model.Shipment
+	if err := global.DB.Where("`order` = ?", order.ID).Order("shipped_at, id").Find(&shipments).Error; err != nil {
+		global.Logger.Errorf("查询发货单失败，订单ID: %d，错误: %v", order.ID, err)
+		return nil, status.Errorf(codes.Internal, "查询发货单失败")
+	}
+	resp := &proto.ShipmentListResponse{OrderStatus: order.Status}
+	if len(shipments) == 0 {
+		return resp, nil
+	}
+
+	ids := make([]int32, len(shipments))
+	for i, shipment := range shipments {
+		ids[i] = shipment.ID
+	}
+	var lines []model.ShipmentGoods
+	if err := global.DB.Where("shipment IN ?", ids).Order("id").Find(&lines).Error; err != nil {
+		global.Logger.Errorf("查询发货商品失败，订单ID: %d，错误: %v", order.ID, err)
+		return nil, status.Errorf(codes.Internal, "查询发货单失败")
+	}
+	var tracks []model.ShipmentTrack
+	if err := global.DB.Where("shipment IN ?", ids).Order("event_time, id").Find(&tracks).Error; err != nil {
+		global.Logger.Errorf("查询物流轨迹失败，订单ID: %d，错误: %v", order.ID, err)
+		return nil, status.Errorf(codes.Internal, "查询发货单失败")
+	}
+	linesByShipment := make(map[int32][]model.ShipmentGoods, len(shipments))
+	for _, line := range lines {
+		linesByShipment[line.Shipment] = append(linesByShipment[line.Shipment], line)
+	}
+	tracksByShipment := make(map[int32][]model.ShipmentTrack, len(shipments))
+	for _, track := range tracks {
+		tracksByShipment[track.Shipment] = append(tracksByShipment[track.Shipment], track)
+	}
+	for i := range shipments {
+		shipment := &shipments[i]
+		resp.Shipments = append(resp.Shipments, shipmentToInfo(shipment, linesByShipment[shipment.ID], tracksByShipment[shipment.ID]))
+	}
+	return resp, nil
+}
+
+// OrderConfirmReceipt 确认收货，提供用户ID时校验订单所属用户，未提供时视为后台操作
+func (s *OrderServiceServer) OrderConfirmReceipt(ctx context.Context, req *proto.OrderRequest) (*emptypb.Empty, error) {
+	global.Logger.Infof("确认收货，订单ID: %d，用户ID: %d", req.Id, req.UserId)
+
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "订单ID必须大于0")
+	}
+	order, err := findOrder(req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	actor := actorAdmin
+	if req.UserId > 0 {
+		actor = userActor(req.UserId)
+	}
+	if err := confirmReceipt(ctx, order, actor, "确认收货"); err != nil {
+		global.Logger.Warnf("确认收货失败，订单号: %s，错误: %v", order.OrderSn, err)
+		return nil, orderTransitError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// confirmReceipt 将待收货订单置为交易结束
+func confirmReceipt(ctx context.Context, order *model.OrderInfo, actor, reason string) error {
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		return transitOrder(ctx, tx, order, fsm.EventConfirm, actor, reason, map[string]interface{}{"receive_time": time.Now()})
+	})
+}
+
+// AutoConfirmReceipt 全部发货超过配置天数仍未确认收货的订单自动确认收货
+func AutoConfirmReceipt(ctx context.Context) {
+	days := global.ServerConfig.Delivery.AutoConfirmDays
+	if days <= 0 {
+		days = defaultAutoConfirmDays
+	}
+	deadline := time.Now().AddDate(0, 0, -days)
+	reason := fmt.Sprintf("发货超过%d天自动确认收货", days)
+
+	var lastId int32
+	confirmed := 0
+	for {
+		var orders []model.OrderInfo
+		if err := global.DB.Where("status = ? AND ship_time < ? AND id > ?", string(fsm.OrderShipped), deadline, lastId).
+			Order("id").Limit(autoConfirmBatchSize).Find(&orders).Error; err != nil {
+			global.Logger.Errorf("查询待自动确认收货的订单失败: %v", err)
+			return
+		}
+		for i := range orders {
+			order := &orders[i]
+			lastId = order.ID
+			if err := confirmReceipt(ctx, order, actorSystem, reason); err != nil {
+				// 用户同时确认收货或申请了退款时状态已变化，跳过即可
+				if !errors.Is(err, errOrderStatusChanged) {
+					global.Logger.Errorf("自动确认收货失败，订单号: %s，错误: %v", order.OrderSn, err)
+				}
+				continue
+			}
+			confirmed++
+		}
+		if len(orders) < autoConfirmBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+	if confirmed > 0 {
+		global.Logger.Infof("自动确认收货完成，共处理 %d 个订单", confirmed)
+	}
+}
+
+// runOrderAutoConfirm 定时扫描自动确认收货
+func runOrderAutoConfirm(ctx context.Context) {
+	ticker := time.NewTicker(autoConfirmScanEvery)
+	defer ticker.Stop()
+	AutoConfirmReceipt(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			AutoConfirmReceipt(ctx)
+		}
+	}
+}
+
+// findOrder 按ID查询订单，userId大于0时校验订单所属用户
+func findOrder(id, userId int32) (*model.OrderInfo, error) {
+	query := global.DB.Where("id = ?", id)
+	if userId > 0 {
+		query = query.Where("user = ?", userId)
+	}
+	var order model.OrderInfo
+	if err := query.First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "订单不存在")
+		}
+		global.Logger.Errorf("查询订单失败: %v", err)
+		return nil, status.Errorf(codes.Internal, "查询订单失败")
+	}
+	return &order, nil
+}
+
+// unshippedGoods 查询订单各商品的未发货数量，返回订单商品ID到商品的映射，Nums为未发货数量
+func unshippedGoods(db *gorm.DB, orderId int32) (map[int32]*model.OrderGoods, error) {
+	var goods []model.OrderGoods
+	if err := db.Where("`order` = ?", orderId).Find(&goods).Error; err != nil {
+		return nil, err
+	}
+	var shipped []struct {
+		OrderGoods int32
+		Nums       int32
+	}
+	if err := db.Model(&model.ShipmentGoods{}).
+		Select("order_goods, SUM(nums) AS nums").
+		Where("`order` = ?", orderId).
+		Group("order_goods").
+		Scan(&shipped).Error; err != nil {
+		return nil, err
+	}
+
+	remaining := make(map[int32]*model.OrderGoods, len(goods))
+	for i := range goods {
+		remaining[goods[i].ID] = &goods[i]
+	}
+	for _, s := range shipped {
+		if g, ok := remaining[s.OrderGoods]; ok {
+			g.Nums -= s.Nums
+		}
+	}
+	return remaining, nil
+}
+
+// shipmentLines 校验本次发货的商品和数量，未指定商品时发出全部未发货商品
+func shipmentLines(items []*proto.ShipGoodsItem, remaining map[int32]*model.OrderGoods) ([]model.ShipmentGoods, error) {
+	var lines []model.ShipmentGoods
+	if len(items) == 0 {
+		for _, goods := range remaining {
+			if goods.Nums > 0 {
+				lines = append(lines, model.ShipmentGoods{Order: goods.Order, OrderGoods: goods.ID, Goods: goods.Goods, Nums: goods.Nums})
+			}
+		}
+		if len(lines) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "订单商品已全部发货")
+		}
+		sort.Slice(lines, func(i, j int) bool { return lines[i].OrderGoods < lines[j].OrderGoods })
+		return lines, nil
+	}
+
+	index := make(map[int32]int, len(items))
+	for _, item := range items {
+		if item.Nums <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "发货数量必须大于0")
+		}
+		goods, ok := remaining[item.OrderGoodsId]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "订单商品%d不属于该订单", item.OrderGoodsId)
+		}
+		i, ok := index[item.OrderGoodsId]
+		if !ok {
+			i = len(lines)
+			index[item.OrderGoodsId] = i
+			lines = append(lines, model.ShipmentGoods{Order: goods.Order, OrderGoods: goods.ID, Goods: goods.Goods})
+		}
+		lines[i].Nums += item.Nums
+		if lines[i].Nums > goods.Nums {
+			return nil, status.Errorf(codes.InvalidArgument, "商品%d发货数量超过未发货数量%d", goods.Goods, goods.Nums)
+		}
+	}
+	return lines, nil
+}
+
+func shipmentToInfo(shipment *model.Shipment, lines []model.ShipmentGoods, tracks []model.ShipmentTrack) *proto.ShipmentInfo {
+	info := &proto.ShipmentInfo{
+		Id:         shipment.ID,
+		OrderId:    shipment.Order,
+		OrderSn:    shipment.OrderSn,
+		Carrier:    shipment.Carrier,
+		TrackingNo: shipment.TrackingNo,
+		ShippedAt:  shipment.ShippedAt.Unix(),
+		Status:     shipment.Status,
+	}
+	for _, line := range lines {
+		info.Goods = append(info.Goods, &proto.ShipmentGoodsInfo{
+			OrderGoodsId: line.OrderGoods,
+			GoodsId:      line.Goods,
+			Nums:         line.Nums,
+		})
+	}
+	for _, track := range tracks {
+		info.Tracks = append(info.Tracks, &proto.ShipmentTrackInfo{
+			Status:      track.Status,
+			Location:    track.Location,
+			Description: track.Description,
+			EventTime:   track.EventTime.Unix(),
+		})
+	}
+	return info
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"testing"
+
+	"order_srv/model"
+	"order_srv/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestShipmentLines 测试发货商品校验：重复的订单商品合并计数，不能超发，不能发出其他订单的商品
+func TestShipmentLines(t *testing.T) {
+	newRemaining := func() map[int32]*model.OrderGoods {
+		return map[int32]*model.OrderGoods{
+			10: {BaseModel: model.BaseModel{ID: 10}, Order: 5, Goods: 1, Nums: 3},
+			11: {BaseModel: model.BaseModel{ID: 11}, Order: 5, Goods: 2, Nums: 1},
+			12: {BaseModel: model.BaseModel{ID: 12}, Order: 5, Goods: 3, Nums: 0},
+		}
+	}
+
+	lines, err := shipmentLines(nil, newRemaining())
+	if err != nil {
+		t.Fatalf("发出全部商品失败: %v", err)
+	}
+	if len(lines) != 2 || lines[0].OrderGoods != 10 || lines[0].Nums != 3 || lines[1].OrderGoods != 11 || lines[1].Nums != 1 {
+		t.Errorf("未指定商品时应按订单商品ID发出全部未发货商品: %+v", lines)
+	}
+
+	lines, err = shipmentLines([]*proto.ShipGoodsItem{{OrderGoodsId: 10, Nums: 1}, {OrderGoodsId: 11, Nums: 1}, {OrderGoodsId: 10, Nums: 2}}, newRemaining())
+	if err != nil {
+		t.Fatalf("重复商品发货失败: %v", err)
+	}
+	if len(lines) != 2 || lines[0].OrderGoods != 10 || lines[0].Nums != 3 || lines[0].Order != 5 || lines[0].Goods != 1 {
+		t.Errorf("重复的订单商品应合并为一行: %+v", lines)
+	}
+
+	cases := []struct {
+		name  string
+		items []*proto.ShipGoodsItem
+	}{
+		{"重复商品合计超发", []*proto.ShipGoodsItem{{OrderGoodsId: 10, Nums: 2}, {OrderGoodsId: 10, Nums: 2}}},
+		{"单个商品超发", []*proto.ShipGoodsItem{{OrderGoodsId: 11, Nums: 2}}},
+		{"已全部发货的商品", []*proto.ShipGoodsItem{{OrderGoodsId: 12, Nums: 1}}},
+		{"其他订单的商品", []*proto.ShipGoodsItem{{OrderGoodsId: 99, Nums: 1}}},
+		{"发货数量为0", []*proto.ShipGoodsItem{{OrderGoodsId: 10, Nums: 0}}},
+	}
+	for _, c := range cases {
+		if _, err := shipmentLines(c.items, newRemaining()); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s 应返回InvalidArgument，实际: %v", c.name, err)
+		}
+	}
+
+	shipped := map[int32]*model.OrderGoods{10: {BaseModel: model.BaseModel{ID: 10}, Nums: 0}}
+	if _, err := shipmentLines(nil, shipped); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("商品已全部发货时应返回FailedPrecondition，实际: %v", err)
+	}
+}
@@ -2,16 +2,80 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"order_srv/fsm"
 	"order_srv/global"
 	"order_srv/model"
 	"order_srv/utils"
+	"strconv"
 	"time"
 
-	inventorypb "order_srv/proto/inventory"
+
+	"gorm.io/gorm"
+)
+
+// 订单超时关闭的延时队列，任务为订单ID，到期时间为支付截止时间
+var orderTimeoutQueue = utils.NewDelayQueue("order_timeout", 30*time.Second, 5, 10*time.Second)
+
+const (
+	orderTimeoutConsumers = 4               // 延时队列消费者数量
+	orderTimeoutScanEvery = 5 * time.Minute // 数据库兜底扫描间隔
 )
 
-// CheckOrderTimeout 检查并关闭超时订单
+// scheduleOrderTimeout 订单创建后加入超时关闭队列，加入失败时由数据库兜底扫描关闭
+func scheduleOrderTimeout(ctx context.Context, order *model.OrderInfo) {
+	if order.PayDeadline == nil {
+		return
+	}
+	if err := orderTimeoutQueue.Add(ctx, strconv.Itoa(int(order.ID)), *order.PayDeadline); err != nil {
+		global.Logger.Warnf("订单加入超时队列失败，将由定时扫描关闭，订单号: %s，错误: %v", order.OrderSn, err)
+	}
+}
+
+// handleOrderTimeoutTask 处理到期的超时任务，订单已支付或已关闭时直接确认
+func handleOrderTimeoutTask(ctx context.Context, member string) error {
+	id, err := strconv.Atoi(member)
+	if err != nil {
+		global.Logger.Errorf("无效的超时任务: %s", member)
+		return nil
+	}
+	var order model.OrderInfo
+	if err := global.DB.First(&order, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if !orderMachine.Can(fsm.State(order.Status), fsm.EventTimeout) || order.PayDeadline == nil {
+		return nil
+	}
+	// 支付截止时间被延长时按新的时间重新入队
+	if order.PayDeadline.After(time.Now()) {
+		return orderTimeoutQueue.Add(ctx, member, *order.PayDeadline)
+	}
+	return closeTimeoutOrder(ctx, &order)
+}
+
+// closeTimeoutOrder 关闭前向支付渠道确认，回调丢失的已支付订单直接置为已支付
+// 队列消费者和兜底扫描可能同时处理同一订单，状态已变化时视为处理成功
+func closeTimeoutOrder(ctx context.Context, order *model.OrderInfo) error {
+	if paid, err := syncPaymentStatus(ctx, order); err != nil {
+		return fmt.Errorf("查询订单支付结果失败: %w", err)
+	} else if paid {
+		global.Logger.Infof("超时订单已支付，跳过关闭，订单号: %s", order.OrderSn)
+		return nil
+	}
+	if err := CloseTimeoutOrder(order); err != nil {
+		if errors.Is(err, errOrderStatusChanged) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// CheckOrderTimeout 兜底扫描超时未关闭的订单，处理延时队列丢失或重试耗尽的任务
 func CheckOrderTimeout() {
 	global.Logger.Info("开始检查超时订单...")
 	
@@ -39,109 +103,44 @@ func CheckOrderTimeout() {
 	
 	// 批量关闭超时订单
 	for _, order := range orders {
-		if err := CloseTimeoutOrder(&order); err != nil {
+		if err := closeTimeoutOrder(context.Background(), &order); err != nil {
 			global.Logger.Errorf("关闭超时订单失败，订单ID: %d，错误: %v", order.ID, err)
 			continue
 		}
-		global.Logger.Infof("成功关闭超时订单，订单号: %s", order.OrderSn)
 	}
 	
 	global.Logger.Infof("超时订单检查完成，共处理 %d 个订单", len(orders))
 }
 
-// CloseTimeoutOrder 关闭单个超时订单
+// CloseTimeoutOrder 关闭单个超时订单，由状态机在关闭后归还库存
+// 状态更新带有原状态条件，关闭期间订单已支付时放弃关闭
 func CloseTimeoutOrder(order *model.OrderInfo) error {
-	// 开启事务
-	tx := global.DB.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-			global.Logger.Errorf("关闭超时订单异常: %v", r)
-		}
-	}()
-	
-	// 更新订单状态为超时关闭
-	if err := tx.Model(order).Update("status", "TRADE_CLOSED").Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-	
-	// 恢复库存（如果已经扣减的话）
-	// 这里需要调用库存服务恢复库存
-	// 由于订单商品信息存在OrderGoods表中，需要查询并恢复
-	var orderGoods []model.OrderGoods
-	if err := tx.Where("order = ?", order.ID).Find(&orderGoods).Error; err != nil {
-		tx.Rollback()
-		return err
-	}
-	
-	// 调用已有的库存回滚函数
-	if err := RollbackOrderInventory(order.ID); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("归还库存失败: %w", err)
-	}
-	
-	// 提交事务
-	if err := tx.Commit().Error; err != nil {
-		return err
-	}
-	
-	global.Logger.Infof("订单 %s 已超时关闭", order.OrderSn)
-	return nil
-}
-
-// StartTimeoutChecker 启动定时检查超时订单
-func StartTimeoutChecker() {
-	global.Logger.Info("启动订单超时检查定时任务...")
-	
-	// 每分钟检查一次超时订单
-	ticker := time.NewTicker(1 * time.Minute)
-	go func() {
-		// 首次启动立即执行一次
-		CheckOrderTimeout()
-		
-		for range ticker.C {
-			CheckOrderTimeout()
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := transitOrder(context.Background(), tx, order, fsm.EventTimeout, actorSystem, "超时未支付", nil); err != nil {
+			return err
 		}
-	}()
-	
-	global.Logger.Info("订单超时检查定时任务已启动")
+		global.Logger.Infof("订单 %s 已超时关闭", order.OrderSn)
+		return nil
+	})
 }
 
-// CheckOrderTimeoutWithRedis 使用Redis优化的超时检查（可选实现）
-func CheckOrderTimeoutWithRedis() {
-	// 使用Redis的过期键通知机制
-	// 当订单创建时，设置一个Redis键，过期时间为支付截止时间
-	// 监听Redis过期事件，触发订单关闭
-	// 这种方式更高效，避免了定时轮询数据库
-	
-	// 示例代码：
-	// 1. 订单创建时：redis.SetEX("order_timeout:{order_id}", 30*60, "1")
-	// 2. 监听过期事件：redis.Subscribe("__keyevent@0__:expired")
-	// 3. 处理过期事件：解析order_id，关闭对应订单
+// runOrderTimeoutQueue 消费超时关闭延时队列，在到期后几秒内关闭订单
+func runOrderTimeoutQueue(ctx context.Context) {
+	orderTimeoutQueue.Consume(ctx, orderTimeoutConsumers, handleOrderTimeoutTask)
 }
 
-// RollbackOrderInventory 回滚订单库存
-func RollbackOrderInventory(orderId int32) error {
-	// 查询订单商品
-	var orderGoods []model.OrderGoods
-	if err := global.DB.Where("order = ?", orderId).Find(&orderGoods).Error; err != nil {
-		return err
-	}
-	
-	// 将订单商品信息转换为库存归还格式
-	rebackItems := make([]*inventorypb.GoodsInvInfo, 0, len(orderGoods))
-	for _, orderGood := range orderGoods {
-		rebackItems = append(rebackItems, &inventorypb.GoodsInvInfo{
-			GoodsId: orderGood.Goods,
-			Num:     orderGood.Nums,
-		})
-	}
-	
-	// 批量归还库存
-	if len(rebackItems) > 0 {
-		return utils.RebackInventory(context.Background(), rebackItems)
+// runOrderTimeoutScan 定时扫描数据库兜底关闭超时订单
+func runOrderTimeoutScan(ctx context.Context) {
+	ticker := time.NewTicker(orderTimeoutScanEvery)
+	defer ticker.Stop()
+	// 首次启动立即执行一次
+	CheckOrderTimeout()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			CheckOrderTimeout()
+		}
 	}
-	
-	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,163 @@
+// Package idgen 订单号生成，默认使用Snowflake算法，机器号由调用方通过Redis或Consul分配后传入
+package idgen
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNotInitialized = errors.New("订单号生成器未初始化")
+	ErrNoWorker       = errors.New("订单号生成器未持有机器号")
+	ErrClockBackwards = errors.New("系统时钟回拨")
+)
+
+// Generator 订单号生成器，生成的订单号需要在所有实例间唯一
+type Generator interface {
+	Next() (string, error)
+}
+
+var (
+	mu      sync.RWMutex
+	current Generator
+)
+
+// SetDefault 设置默认生成器
+func SetDefault(g Generator) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = g
+}
+
+// Next 使用默认生成器生成订单号
+func Next() (string, error) {
+	mu.RLock()
+	g := current
+	mu.RUnlock()
+	if g == nil {
+		return "", ErrNotInitialized
+	}
+	return g.Next()
+}
+
+// Snowflake位分配：41位毫秒时间戳 + 10位机器号 + 12位序列号
+const (
+	workerBits   = 10
+	sequenceBits = 12
+	MaxWorkerId  = 1<<workerBits - 1
+	maxSequence  = 1<<sequenceBits - 1
+
+	// 时钟回拨不超过该时长时等待追上，否则拒绝发号
+	maxBackwards = 5 * time.Millisecond
+)
+
+// snowflakeEpoch 时间戳起点，41位毫秒时间戳可以使用约69年
+var snowflakeEpoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Snowflake 按时间戳、机器号和序列号生成递增ID，订单号为19位补零的ID加1位校验码
+// 机器号失效（租约丢失）后停止发号，直到重新设置机器号
+type Snowflake struct {
+	mu       sync.Mutex
+	workerId int64
+	lastMs   int64
+	sequence int64
+	now      func() time.Time
+}
+
+// NewSnowflake 创建Snowflake生成器，workerId为-1时需要稍后通过SetWorker设置
+func NewSnowflake(workerId int64) (*Snowflake, error) {
+	s := &Snowflake{workerId: -1, now: time.Now}
+	if workerId >= 0 {
+		if err := s.SetWorker(workerId); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// SetWorker 设置机器号，-1表示机器号已失效
+func (s *Snowflake) SetWorker(workerId int64) error {
+	if workerId < -1 || workerId > MaxWorkerId {
+		return fmt.Errorf("机器号超出范围[0, %d]: %d", MaxWorkerId, workerId)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workerId = workerId
+	return nil
+}
+
+// NextID 生成下一个ID，同一毫秒内序列号用完时等待下一毫秒
+func (s *Snowflake) NextID() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.workerId < 0 {
+		return 0, ErrNoWorker
+	}
+
+	ms := s.now().Sub(snowflakeEpoch).Milliseconds()
+	if ms < s.lastMs {
+		if time.Duration(s.lastMs-ms)*time.Millisecond > maxBackwards {
+			return 0, fmt.Errorf("%w: %dms", ErrClockBackwards, s.lastMs-ms)
+		}
+		for ms < s.lastMs {
+			time.Sleep(time.Duration(s.lastMs-ms) * time.Millisecond)
+			ms = s.now().Sub(snowflakeEpoch).Milliseconds()
+		}
+	}
+	if ms == s.lastMs {
+		s.sequence = (s.sequence + 1) & maxSequence
+		if s.sequence == 0 {
+			for ms <= s.lastMs {
+				time.Sleep(100 * time.Microsecond)
+				ms = s.now().Sub(snowflakeEpoch).Milliseconds()
+			}
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.lastMs = ms
+	return ms<<(workerBits+sequenceBits) | s.workerId<<sequenceBits | s.sequence, nil
+}
+
+// Next 生成20位订单号
+func (s *Snowflake) Next() (string, error) {
+	id, err := s.NextID()
+	if err != nil {
+		return "", err
+	}
+	digits := fmt.Sprintf("%019d", id)
+	return digits + string(Checksum(digits)), nil
+}
+
+// Checksum 按Luhn算法计算数字串的校验位
+func Checksum(digits string) byte {
+	sum := 0
+	double := true
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Valid 校验订单号的最后一位校验码，用于提前拦截输错或伪造的订单号
+func Valid(sn string) bool {
+	if len(sn) < 2 {
+		return false
+	}
+	for i := 0; i < len(sn); i++ {
+		if sn[i] < '0' || sn[i] > '9' {
+			return false
+		}
+	}
+	return Checksum(sn[:len(sn)-1]) == sn[len(sn)-1]
+}
@@ -0,0 +1,92 @@
+package idgen
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestSnowflakeUnique 测试并发生成的订单号唯一且校验码有效
+func TestSnowflakeUnique(t *testing.T) {
+	s, err := NewSnowflake(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const workers, each = 8, 2000
+	var mu sync.Mutex
+	seen := make(map[string]bool, workers*each)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < each; i++ {
+				sn, err := s.Next()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				mu.Lock()
+				if seen[sn] {
+					t.Errorf("订单号重复: %s", sn)
+				}
+				seen[sn] = true
+				mu.Unlock()
+				if len(sn) != 20 || !Valid(sn) {
+					t.Errorf("订单号格式错误: %s", sn)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// TestSnowflakeWorker 测试不同机器号同一毫秒生成的ID不同，机器号失效后停止发号
+func TestSnowflakeWorker(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	a, _ := NewSnowflake(1)
+	b, _ := NewSnowflake(2)
+	a.now = func() time.Time { return at }
+	b.now = func() time.Time { return at }
+	idA, _ := a.NextID()
+	idB, _ := b.NextID()
+	if idA == idB {
+		t.Errorf("不同机器号生成了相同的ID: %d", idA)
+	}
+
+	if err := a.SetWorker(-1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Next(); !errors.Is(err, ErrNoWorker) {
+		t.Errorf("机器号失效后应拒绝发号，实际: %v", err)
+	}
+	if err := a.SetWorker(MaxWorkerId + 1); err == nil {
+		t.Error("机器号超出范围应返回错误")
+	}
+
+	// 时钟大幅回拨时拒绝发号
+	b.now = func() time.Time { return at.Add(-time.Second) }
+	if _, err := b.NextID(); !errors.Is(err, ErrClockBackwards) {
+		t.Errorf("时钟回拨应返回错误，实际: %v", err)
+	}
+}
+
+// TestValid 测试校验码能发现单个数字错误和相邻数字交换
+func TestValid(t *testing.T) {
+	digits := "0123456789012345678"
+	sn := digits + string(Checksum(digits))
+	if !Valid(sn) {
+		t.Fatalf("订单号校验失败: %s", sn)
+	}
+	for _, bad := range []string{
+		"1" + sn[1:],
+		sn[:3] + sn[4:5] + sn[3:4] + sn[5:],
+		sn[:19] + "a",
+		"",
+	} {
+		if Valid(bad) {
+			t.Errorf("错误的订单号通过了校验: %s", bad)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package initialize
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"order_srv/global"
+	"order_srv/idgen"
+	"order_srv/utils"
+
+	"go.uber.org/zap"
+)
+
+// 机器号租约默认时长
+const defaultWorkerTTL = 30 * time.Second
+
+var (
+	stopWorkerLease context.CancelFunc
+	workerLeaseDone chan struct{}
+)
+
+// InitIdGenerator 申请机器号并初始化Snowflake订单号生成器，机器号由后台协程续期
+func InitIdGenerator() {
+	cfg := global.ServerConfig.IdGen
+	ttl := defaultWorkerTTL
+	if cfg.WorkerTTL > 0 {
+		ttl = time.Duration(cfg.WorkerTTL) * time.Second
+	}
+	hostname, _ := os.Hostname()
+	instance := fmt.Sprintf("%s:%d/%s/%d", global.ServerConfig.Host, global.ServerConfig.Port, hostname, os.Getpid())
+
+	allocator, err := utils.NewWorkerIdAllocator(cfg.WorkerSource, global.ServerConfig.Name, instance, idgen.MaxWorkerId, ttl)
+	if err != nil {
+		zap.S().Fatalf("初始化机器号分配失败: %v", err)
+	}
+	generator, _ := idgen.NewSnowflake(-1)
+	lease := utils.NewWorkerLease(allocator, ttl, func(id int64) {
+		if err := generator.SetWorker(id); err != nil {
+			zap.S().Errorf("设置机器号失败: %v", err)
+		}
+	})
+	if err := lease.Acquire(context.Background()); err != nil {
+		zap.S().Fatalf("申请机器号失败: %v", err)
+	}
+	idgen.SetDefault(generator)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopWorkerLease = cancel
+	workerLeaseDone = make(chan struct{})
+	go func() {
+		defer close(workerLeaseDone)
+		lease.Run(ctx)
+	}()
+	zap.S().Infof("订单号生成器初始化成功，机器号分配方式: %s，机器号: %d", cfg.WorkerSource, lease.ID())
+}
+
+// CloseIdGenerator 停止续期并释放机器号
+func CloseIdGenerator() {
+	if stopWorkerLease == nil {
+		return
+	}
+	stopWorkerLease()
+	<-workerLeaseDone
+}
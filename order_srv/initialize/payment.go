@@ -0,0 +1,33 @@
+package initialize
+
+import (
+	"order_srv/global"
+	"order_srv/payment"
+
+	"go.uber.org/zap"
+)
+
+// InitPayment 根据配置注册支付渠道
+func InitPayment() {
+	cfg := global.ServerConfig.Payment
+
+	if cfg.Mock.Enabled {
+		payment.Register(payment.NewMockProvider(cfg.Mock.Secret))
+	}
+	if cfg.Alipay.Enabled {
+		provider, err := payment.NewAlipayProvider(cfg.Alipay)
+		if err != nil {
+			zap.S().Fatalf("初始化支付宝支付失败: %v", err)
+		}
+		payment.Register(provider)
+	}
+	if cfg.Wechat.Enabled {
+		provider, err := payment.NewWechatProvider(cfg.Wechat)
+		if err != nil {
+			zap.S().Fatalf("初始化微信支付失败: %v", err)
+		}
+		payment.Register(provider)
+	}
+
+	zap.S().Infof("已启用支付渠道: %v", payment.Names())
+}
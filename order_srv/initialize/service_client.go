@@ -1,74 +1,30 @@
 package initialize
 
 import (
-	"fmt"
 	"order_srv/global"
+	"order_srv/util"
 
 	"go.uber.org/zap"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 // InitServiceClients 初始化服务客户端连接
+// 商品服务、用户操作服务和订单服务互相依赖，依赖的服务尚未启动时不阻止启动，使用时再通过util.ServiceConn重新连接
 func InitServiceClients() {
-	initGoodsClient()
-	initInventoryClient()
-}
-
-// initGoodsClient 初始化商品服务客户端
-func initGoodsClient() {
-	// 从Consul获取商品服务地址
-	services, _, err := global.ConsulClient.Health().Service("goods_srv", "", true, nil)
-	if err != nil {
-		zap.S().Errorf("从Consul获取商品服务失败: %v", err)
-		return
+	if util.ServiceConn(&global.GoodsClient, "goods_srv") == nil {
+		zap.S().Warn("商品服务暂不可用，使用时重新连接")
 	}
-	
-	if len(services) == 0 {
-		zap.S().Error("没有可用的商品服务实例")
-		return
+	if util.ServiceConn(&global.InventoryClient, "inventory_srv") == nil {
+		zap.S().Warn("库存服务暂不可用，使用时重新连接")
 	}
-	
-	// 简单选择第一个健康的服务实例
-	service := services[0]
-	addr := fmt.Sprintf("%s:%d", service.Service.Address, service.Service.Port)
-	
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		zap.S().Errorf("连接商品服务失败: %v", err)
-		return
-	}
-	
-	global.GoodsClient = conn
-	zap.S().Infof("商品服务客户端连接成功: %s", addr)
+	initUserClient()
 }
 
-// initInventoryClient 初始化库存服务客户端
-func initInventoryClient() {
-	// 从Consul获取库存服务地址
-	services, _, err := global.ConsulClient.Health().Service("inventory_srv", "", true, nil)
-	if err != nil {
-		zap.S().Errorf("从Consul获取库存服务失败: %v", err)
-		return
-	}
-	
-	if len(services) == 0 {
-		zap.S().Error("没有可用的库存服务实例")
-		return
+// initUserClient 初始化用户服务客户端，下单时用于校验用户
+// 连接失败时不阻止启动，下单时重新连接，仍不可用时按配置拒绝或放行
+func initUserClient() {
+	if util.ServiceConn(&global.UserClient, "user_srv") == nil {
+		zap.S().Warn("用户服务暂不可用，下单时重新连接")
 	}
-	
-	// 简单选择第一个健康的服务实例
-	service := services[0]
-	addr := fmt.Sprintf("%s:%d", service.Service.Address, service.Service.Port)
-	
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		zap.S().Errorf("连接库存服务失败: %v", err)
-		return
-	}
-	
-	global.InventoryClient = conn
-	zap.S().Infof("库存服务客户端连接成功: %s", addr)
 }
 
 // CloseServiceClients 关闭所有服务客户端连接
@@ -79,4 +35,7 @@ func CloseServiceClients() {
 	if global.InventoryClient != nil {
 		global.InventoryClient.Close()
 	}
-}
\ No newline at end of file
+	if global.UserClient != nil {
+		global.UserClient.Close()
+	}
+}
@@ -46,6 +46,12 @@ func main() {
 	// 初始化服务客户端
 	initialize.InitServiceClients()
 
+	// 初始化订单号生成器
+	initialize.InitIdGenerator()
+
+	// 初始化支付渠道
+	initialize.InitPayment()
+
 	// 创建 gRPC 服务器
 	server := grpc.NewServer()
 
@@ -72,8 +78,8 @@ func main() {
 		}
 	}()
 	
-	// 启动订单超时检查定时任务
-	handler.StartTimeoutChecker()
+	// 启动后台任务，多副本时只在主实例上运行
+	handler.StartBackgroundJobs()
 
 	// 注册服务到 Consul
 	if err := util.RegisterService(); err != nil {
@@ -90,6 +96,12 @@ func main() {
 		zap.S().Errorf("从 Consul 注销服务失败: %v", err)
 	}
 
+	// 停止后台任务并释放租约
+	handler.StopBackgroundJobs()
+
+	// 停止机器号续期并释放机器号
+	initialize.CloseIdGenerator()
+
 	// 关闭服务客户端连接
 	initialize.CloseServiceClients()
 
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Money 金额，程序中为以分为单位的整数，数据库中存为DECIMAL，读写都不经过浮点数
+type Money int64
+
+// Yuan 转换为元，仅用于日志等展示场景
+func (m Money) Yuan() float64 {
+	return float64(m) / 100
+}
+
+// String 格式化为两位小数的元，如 12.34
+func (m Money) String() string {
+	sign := ""
+	v := int64(m)
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)
+}
+
+// ParseMoney 解析DECIMAL格式的金额，超过两位的小数四舍五入
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+	yuan, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("无效的金额: %s", s)
+	}
+	var cents int64
+	roundUp := false
+	for i, c := range fracPart {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("无效的金额: %s", s)
+		}
+		switch {
+		case i < 2:
+			cents = cents*10 + int64(c-'0')
+		case i == 2:
+			roundUp = c >= '5'
+		}
+	}
+	if len(fracPart) == 1 {
+		cents *= 10
+	}
+	v := yuan*100 + cents
+	if roundUp {
+		v++
+	}
+	if neg {
+		v = -v
+	}
+	return Money(v), nil
+}
+
+// Value 写入数据库时转换为DECIMAL字符串
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan 读取DECIMAL、整数或浮点列
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+	case []byte:
+		parsed, err := ParseMoney(string(v))
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case string:
+		parsed, err := ParseMoney(v)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+	case int64:
+		*m = Money(v * 100)
+	case float64:
+		// 迁移前的FLOAT列，按两位小数格式化后解析，避免二进制误差
+		return m.Scan(strconv.FormatFloat(v, 'f', 2, 64))
+	default:
+		return fmt.Errorf("不支持的金额类型: %T", src)
+	}
+	return nil
+}
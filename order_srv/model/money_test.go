@@ -0,0 +1,69 @@
+package model
+
+import "testing"
+
+// TestMoneyParseAndFormat 测试金额在DECIMAL字符串和分之间的转换
+func TestMoneyParseAndFormat(t *testing.T) {
+	cases := map[string]Money{
+		"0":          0,
+		"0.00":       0,
+		"19.99":      1999,
+		"19.9":       1990,
+		".5":         50,
+		"12345678.9": 1234567890,
+		"-1.50":      -150,
+		"1.005":      101,
+		"1.004":      100,
+	}
+	for s, want := range cases {
+		got, err := ParseMoney(s)
+		if err != nil {
+			t.Errorf("ParseMoney(%q) 失败: %v", s, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseMoney(%q) 期望 %d，实际 %d", s, want, got)
+		}
+	}
+	for _, s := range []string{"abc", "1.2x", "1..2"} {
+		if _, err := ParseMoney(s); err == nil {
+			t.Errorf("ParseMoney(%q) 应返回错误", s)
+		}
+	}
+
+	formats := map[Money]string{0: "0.00", 5: "0.05", 1999: "19.99", -150: "-1.50", 1234567890: "12345678.90"}
+	for m, want := range formats {
+		if got := m.String(); got != want {
+			t.Errorf("Money(%d).String() 期望 %s，实际 %s", int64(m), want, got)
+		}
+	}
+}
+
+// TestMoneyScan 测试从数据库读取不同类型的金额列
+func TestMoneyScan(t *testing.T) {
+	cases := []struct {
+		src  interface{}
+		want Money
+	}{
+		{[]byte("88.88"), 8888},
+		{"0.10", 10},
+		{int64(3), 300},
+		{float64(float32(19.99)), 1999},
+		{nil, 0},
+	}
+	for _, c := range cases {
+		var m Money
+		if err := m.Scan(c.src); err != nil {
+			t.Errorf("Scan(%v) 失败: %v", c.src, err)
+			continue
+		}
+		if m != c.want {
+			t.Errorf("Scan(%v) 期望 %d，实际 %d", c.src, c.want, m)
+		}
+	}
+
+	v, err := Money(1999).Value()
+	if err != nil || v != "19.99" {
+		t.Errorf("Value 期望 19.99，实际 %v，错误: %v", v, err)
+	}
+}
@@ -14,30 +14,62 @@ import (
 
 type ShoppingCart struct {
 	BaseModel
-	User       int32   `gorm:"type:int;index;not null;comment:用户ID" json:"user"`
-	Goods      int32   `gorm:"type:int;index;not null;comment:商品ID" json:"goods"`
-	GoodsName  string  `gorm:"type:varchar(100);not null;comment:商品名称" json:"goods_name"`
-	GoodsImage string  `gorm:"type:varchar(200);comment:商品图片" json:"goods_image"`
-	GoodsPrice float32 `gorm:"type:decimal(10,2);not null;comment:商品价格快照" json:"goods_price"`
-	Nums       int32   `gorm:"type:int;not null;comment:商品数量" json:"nums"`
-	Checked    bool    `gorm:"type:bool;default:true;comment:是否选中" json:"checked"`
+	User       int32  `gorm:"type:int;index;not null;comment:用户ID" json:"user"`
+	Goods      int32  `gorm:"type:int;index;not null;comment:商品ID" json:"goods"`
+	GoodsName  string `gorm:"type:varchar(100);not null;comment:商品名称" json:"goods_name"`
+	GoodsImage string `gorm:"type:varchar(200);comment:商品图片" json:"goods_image"`
+	GoodsPrice Money  `gorm:"type:decimal(10,2);not null;comment:商品价格快照" json:"goods_price"`
+	Nums       int32  `gorm:"type:int;not null;comment:商品数量" json:"nums"`
+	Checked    bool   `gorm:"type:bool;default:true;comment:是否选中" json:"checked"`
+}
+
+// CartMergeRecord 游客购物车合并记录，与合并写入的购物车在同一事务中写入，同一会话令牌只合并一次
+type CartMergeRecord struct {
+	BaseModel
+	SessionToken string `gorm:"type:varchar(64);not null;uniqueIndex;comment:游客会话令牌" json:"session_token"`
+	User         int32  `gorm:"type:int;index;not null;comment:合并到的用户ID" json:"user"`
 }
 
 type OrderInfo struct {
 	BaseModel
 	User    int32  `gorm:"type:int;index"`
-	OrderSn string `gorm:"type:varchar(30);index"` // 平台自己生成的订单号
+	OrderSn string `gorm:"type:varchar(30);uniqueIndex:uk_order_info_order_sn"` // 平台自己生成的订单号
 	PayType string `gorm:"type:varchar(20);comment:'alipay(支付宝), wechat(微信)'"`
 	// status大家可以考虑用iota来做
-	Status       string `gorm:"type:varchar(20);comment:'PAYING(待支付), TRADE_SUCCESS(成功), TRADE_CLOSED(超时关闭), WAIT_BUYER_PAY(交易创建), TRADE_FINISHED(交易结束)'"`
-	TradeNo      string `gorm:"type:varchar(100);comment:'交易号'"` // 交易号就是支付订单号
-	OrderMount   float32
-	PayTime      *time.Time `gorm:"comment:支付时间"`
-	PayDeadline  *time.Time `gorm:"comment:支付截止时间"`  // 新增：支付截止时间
-	Address      string `gorm:"type:varchar(100)"`
-	SignerName   string `gorm:"type:varchar(20)"`
-	SingerMobile string `gorm:"type:varchar(11)"`
-	Post         string `gorm:"type:varchar(20)"` //留言信息
+	Status         string     `gorm:"type:varchar(20);comment:'PAYING(待支付), TRADE_SUCCESS(成功), PART_SHIPPED(部分发货), SHIPPED(已发货), TRADE_CLOSED(超时关闭), WAIT_BUYER_PAY(交易创建), TRADE_FINISHED(交易结束), TRADE_REFUNDED(已全额退款)'"`
+	TradeNo        string     `gorm:"type:varchar(100);index;comment:'交易号'"` // 交易号就是支付订单号
+	GoodsAmount    Money      `gorm:"type:decimal(12,2);not null;default:0;comment:商品总额"`
+	ShippingFee    Money      `gorm:"type:decimal(12,2);not null;default:0;comment:运费"`
+	DiscountAmount Money      `gorm:"type:decimal(12,2);not null;default:0;comment:优惠总金额，明细见OrderDiscount"`
+	OrderMount     Money      `gorm:"type:decimal(12,2);not null;default:0;comment:应付金额，商品总额+运费-优惠"`
+	PayTime        *time.Time `gorm:"comment:支付时间"`
+	PayDeadline    *time.Time `gorm:"comment:支付截止时间"` // 新增：支付截止时间
+	ShipTime       *time.Time `gorm:"index;comment:全部发货时间，自动确认收货从该时间起计算"`
+	ReceiveTime    *time.Time `gorm:"comment:确认收货时间"`
+	Address        string     `gorm:"type:varchar(100)"`
+	SignerName     string     `gorm:"type:varchar(20)"`
+	SingerMobile   string     `gorm:"type:varchar(11);index"`
+	Post           string     `gorm:"type:varchar(20)"` //留言信息
+	Coupon         int32      `gorm:"type:int;not null;default:0;comment:使用的用户优惠券ID"`
+	ParentSn       string     `gorm:"type:varchar(30);index;not null;default:'';comment:拆单时的父订单号"`
+}
+
+// ParentOrder 拆单时的父订单，用户对父订单支付一次，支付结果同步到所有子订单
+// 优惠券和优惠明细记在父订单上，各子订单的金额为按商品分摊后的金额
+type ParentOrder struct {
+	BaseModel
+	ParentSn       string     `gorm:"type:varchar(30);uniqueIndex;not null;comment:父订单号，即支付使用的商户订单号"`
+	User           int32      `gorm:"type:int;index;not null"`
+	Status         string     `gorm:"type:varchar(20);not null;comment:PAYING(待支付), TRADE_SUCCESS(已支付), TRADE_CLOSED(已关闭)"`
+	PayType        string     `gorm:"type:varchar(20)"`
+	TradeNo        string     `gorm:"type:varchar(100)"`
+	GoodsAmount    Money      `gorm:"type:decimal(12,2);not null;default:0;comment:商品总额"`
+	ShippingFee    Money      `gorm:"type:decimal(12,2);not null;default:0;comment:运费"`
+	DiscountAmount Money      `gorm:"type:decimal(12,2);not null;default:0;comment:优惠总金额"`
+	PayAmount      Money      `gorm:"type:decimal(12,2);not null;default:0;comment:应付金额，等于子订单应付金额之和"`
+	PayTime        *time.Time `gorm:"comment:支付时间"`
+	PayDeadline    *time.Time `gorm:"comment:支付截止时间"`
+	Coupon         int32      `gorm:"type:int;not null;default:0;comment:使用的用户优惠券ID"`
 }
 
 // 订单商品信息
@@ -46,8 +78,9 @@ type OrderGoods struct {
 	Order int32 `gorm:"type:int;index"`
 	Goods int32 `gorm:"type:int;index"`
 	// 商品名称、商品图片、商品价格、商品数量，高并发场景下都不会遵守第三范式，所以这里不使用外键（字段冗余）
-	GoodsName  string  `gorm:"type:varchar(100);index"`
-	GoodsImage string  `gorm:"type:varchar(200)"`
-	GoodsPrice float32 // 快照价格
-	Nums       int32   `gorm:"type:int"`
+	GoodsName      string `gorm:"type:varchar(100);index"`
+	GoodsImage     string `gorm:"type:varchar(200)"`
+	GoodsPrice     Money  `gorm:"type:decimal(10,2);not null;default:0"` // 快照价格
+	Nums           int32  `gorm:"type:int"`
+	DiscountAmount Money  `gorm:"type:decimal(12,2);not null;default:0;comment:分摊的优惠金额"`
 }
@@ -0,0 +1,13 @@
+package model
+
+// OrderStatusLog 订单状态流转记录，所有状态变更都通过订单状态机写入
+type OrderStatusLog struct {
+	BaseModel
+	Order      int32  `gorm:"type:int;index;comment:订单ID"`
+	OrderSn    string `gorm:"type:varchar(30);index;comment:订单号"`
+	FromStatus string `gorm:"type:varchar(20);comment:原状态"`
+	ToStatus   string `gorm:"type:varchar(20);comment:新状态"`
+	Event      string `gorm:"type:varchar(20);comment:触发事件"`
+	Actor      string `gorm:"type:varchar(50);comment:'操作人，如 user:12、admin、system'"`
+	Reason     string `gorm:"type:varchar(200);comment:变更原因"`
+}
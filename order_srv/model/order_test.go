@@ -82,7 +82,7 @@ func setupTestDB(t *testing.T) {
 	global.DB = db
 
 	// 自动迁移订单相关表结构
-	if err := db.AutoMigrate(&OrderInfo{}, &OrderGoods{}, &ShoppingCart{}); err != nil {
+	if err := db.AutoMigrate(&OrderInfo{}, &OrderGoods{}, &ShoppingCart{}, &CartMergeRecord{}, &PaymentRecord{}, &RefundOrder{}, &RefundGoods{}, &OrderStatusLog{}, &OutboxMessage{}, &OutboxDeadLetter{}, &SagaInstance{}, &CouponTemplate{}, &UserCoupon{}, &Promotion{}, &OrderDiscount{}, &ParentOrder{}, &Shipment{}, &ShipmentGoods{}, &ShipmentTrack{}, &OrderDailyStat{}, &GoodsDailyStat{}); err != nil {
 		t.Fatalf("自动迁移表结构失败: %v", err)
 	}
 }
@@ -97,7 +97,7 @@ func TestOrderInfoCRUD(t *testing.T) {
 		PayType:      "alipay",
 		Status:       "PAYING",
 		TradeNo:      "TRADE123456",
-		OrderMount:   99.99,
+		OrderMount:   Money(9999),
 		PayTime:      nil, // 使用nil，让数据库设置为NULL
 		Address:      "测试地址",
 		SignerName:   "张三",
@@ -165,7 +165,7 @@ func TestOrderGoodsCRUD(t *testing.T) {
 		Goods:      2,
 		GoodsName:  "测试商品",
 		GoodsImage: "http://example.com/image.jpg",
-		GoodsPrice: 88.88,
+		GoodsPrice: Money(8888),
 		Nums:       3,
 	}
 
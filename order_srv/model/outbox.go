@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// 发件箱消息状态
+const (
+	OutboxStatusPending   = "PENDING"   // 待投递或等待重试
+	OutboxStatusDelivered = "DELIVERED" // 已投递
+)
+
+// OutboxMessage 事务发件箱，跨服务调用的意图与业务数据在同一事务中写入，由投递任务异步执行
+// MsgKey 唯一，同一意图重复写入会失败；超过最大重试次数的消息移入死信表
+type OutboxMessage struct {
+	BaseModel
+	Topic       string     `gorm:"type:varchar(50);not null;index;comment:消息主题，如 inventory.reback"`
+	MsgKey      string     `gorm:"type:varchar(100);not null;uniqueIndex;comment:业务唯一键"`
+	Payload     string     `gorm:"type:text;comment:消息内容JSON"`
+	Status      string     `gorm:"type:varchar(20);not null;index:idx_outbox_status_retry,priority:1;comment:'PENDING(待投递), DELIVERED(已投递)'"`
+	Attempts    int32      `gorm:"type:int;not null;default:0;comment:已投递次数"`
+	NextRetryAt time.Time  `gorm:"index:idx_outbox_status_retry,priority:2;comment:下次投递时间"`
+	LastError   string     `gorm:"type:varchar(500);comment:最近一次投递错误"`
+	DeliveredAt *time.Time `gorm:"comment:投递成功时间"`
+}
+
+// OutboxDeadLetter 投递失败次数过多的发件箱消息，人工确认后可以重放
+type OutboxDeadLetter struct {
+	BaseModel
+	Message    int32      `gorm:"type:int;index;comment:原发件箱消息ID"`
+	Topic      string     `gorm:"type:varchar(50);not null;index;comment:消息主题"`
+	MsgKey     string     `gorm:"type:varchar(100);not null;index;comment:业务唯一键"`
+	Payload    string     `gorm:"type:text;comment:消息内容JSON"`
+	Attempts   int32      `gorm:"type:int;comment:已投递次数"`
+	LastError  string     `gorm:"type:varchar(500);comment:最后一次投递错误"`
+	Replayed   bool       `gorm:"not null;default:false;index;comment:是否已重放"`
+	ReplayedAt *time.Time `gorm:"comment:重放时间"`
+}
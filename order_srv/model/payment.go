@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// 支付单状态
+const (
+	PaymentStatusPending = "PENDING" // 已发起，等待支付
+	PaymentStatusSuccess = "SUCCESS" // 支付成功
+	PaymentStatusClosed  = "CLOSED"  // 渠道交易关闭
+)
+
+// PaymentRecord 支付单，同一订单每个支付渠道一条记录，商户订单号使用平台订单号
+type PaymentRecord struct {
+	BaseModel
+	Order      int32      `gorm:"type:int;index;comment:订单ID"`
+	OrderSn    string     `gorm:"type:varchar(30);not null;uniqueIndex:idx_payment_order_pay_type;comment:订单号"`
+	PayType    string     `gorm:"type:varchar(20);not null;uniqueIndex:idx_payment_order_pay_type;comment:支付渠道"`
+	TradeNo    string     `gorm:"type:varchar(100);index;comment:渠道交易号"`
+	Amount     int64      `gorm:"type:bigint;not null;comment:支付金额（分）"`
+	Status     string     `gorm:"type:varchar(20);not null;default:'PENDING';comment:支付状态"`
+	PaidAt     *time.Time `gorm:"comment:支付时间"`
+	NotifyData string     `gorm:"type:text;comment:回调原文"`
+}
@@ -0,0 +1,67 @@
+package model
+
+import "time"
+
+// 用户优惠券状态，过期由ExpireAt判断，不单独更新状态
+const (
+	CouponStatusUnused  = "UNUSED"  // 未使用
+	CouponStatusUsed    = "USED"    // 已使用
+	CouponStatusExpired = "EXPIRED" // 已过期，仅用于查询
+)
+
+// DiscountRule 优惠规则，优惠券模板和促销活动共用，金额单位为分
+type DiscountRule struct {
+	Type        string `gorm:"type:varchar(20);not null;comment:'FIXED(立减), PERCENT(折扣), FULL_REDUCTION(满减)'"`
+	Threshold   int64  `gorm:"type:bigint;not null;default:0;comment:使用门槛（分），0表示无门槛"`
+	Amount      int64  `gorm:"type:bigint;not null;default:0;comment:优惠金额（分）"`
+	Percent     int32  `gorm:"type:int;not null;default:0;comment:折扣百分比，85表示85折"`
+	MaxDiscount int64  `gorm:"type:bigint;not null;default:0;comment:折扣最高优惠（分），0表示不限"`
+	Scope       string `gorm:"type:varchar(20);not null;default:'ALL';comment:'ALL(全部商品), CATEGORY(指定分类), BRAND(指定品牌)'"`
+	ScopeId     int32  `gorm:"type:int;not null;default:0;comment:分类ID或品牌ID"`
+}
+
+// CouponTemplate 优惠券模板，按模板向用户发放优惠券
+type CouponTemplate struct {
+	BaseModel
+	Name string `gorm:"type:varchar(50);not null;comment:优惠券名称"`
+	DiscountRule
+	Total        int32      `gorm:"type:int;not null;default:0;comment:发放总量，0表示不限"`
+	Issued       int32      `gorm:"type:int;not null;default:0;comment:已发放数量"`
+	PerUserLimit int32      `gorm:"type:int;not null;default:0;comment:每人限领数量，0表示不限"`
+	ValidDays    int32      `gorm:"type:int;not null;default:0;comment:领取后有效天数，0表示以结束时间为准"`
+	StartTime    *time.Time `gorm:"comment:发放开始时间"`
+	EndTime      *time.Time `gorm:"comment:发放结束时间"`
+	Enabled      bool       `gorm:"not null;default:true;comment:是否启用"`
+}
+
+// UserCoupon 用户优惠券，下单时在创建订单的事务中核销，订单关闭后退回
+type UserCoupon struct {
+	BaseModel
+	User     int32      `gorm:"type:int;not null;index:idx_user_coupon_user_status;comment:用户ID"`
+	Template int32      `gorm:"type:int;not null;index;comment:优惠券模板ID"`
+	Status   string     `gorm:"type:varchar(20);not null;default:'UNUSED';index:idx_user_coupon_user_status;comment:'UNUSED(未使用), USED(已使用)'"`
+	ExpireAt time.Time  `gorm:"not null;comment:过期时间"`
+	OrderSn  string     `gorm:"type:varchar(30);index;comment:核销订单号"`
+	UsedAt   *time.Time `gorm:"comment:核销时间"`
+}
+
+// Promotion 店铺促销活动，按分类或品牌对商品优惠
+type Promotion struct {
+	BaseModel
+	Name string `gorm:"type:varchar(50);not null;comment:活动名称"`
+	DiscountRule
+	StartTime time.Time `gorm:"not null;index;comment:开始时间"`
+	EndTime   time.Time `gorm:"not null;index;comment:结束时间"`
+	Enabled   bool      `gorm:"not null;default:true;comment:是否启用"`
+}
+
+// OrderDiscount 订单优惠明细快照，活动或优惠券后续修改不影响已下单的订单
+type OrderDiscount struct {
+	BaseModel
+	Order    int32  `gorm:"type:int;index;comment:订单ID，拆单时为0，按父订单号关联"`
+	OrderSn  string `gorm:"type:varchar(30);index;comment:订单号"`
+	Source   string `gorm:"type:varchar(20);not null;comment:'PROMOTION(促销活动), COUPON(优惠券)'"`
+	SourceId int32  `gorm:"type:int;not null;comment:活动ID或用户优惠券ID"`
+	Name     string `gorm:"type:varchar(50);comment:优惠名称"`
+	Amount   int64  `gorm:"type:bigint;not null;comment:优惠金额（分）"`
+}
@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// 售后类型
+const (
+	RefundTypeRefundOnly  = "REFUND_ONLY"  // 仅退款
+	RefundTypeReturnGoods = "RETURN_GOODS" // 退货退款
+)
+
+// 售后单状态
+const (
+	RefundStatusPending    = "PENDING"     // 待审核
+	RefundStatusWaitReturn = "WAIT_RETURN" // 已同意退货，等待商家收货
+	RefundStatusRefunding  = "REFUNDING"   // 退款中
+	RefundStatusRefunded   = "REFUNDED"    // 退款成功
+	RefundStatusFailed     = "FAILED"      // 退款失败，可重试
+	RefundStatusRejected   = "REJECTED"    // 已拒绝
+	RefundStatusCancelled  = "CANCELLED"   // 用户已撤销
+)
+
+// RefundOrder 售后单，OrderGoods为0表示整单售后
+type RefundOrder struct {
+	BaseModel
+	RefundSn      string     `gorm:"type:varchar(32);not null;uniqueIndex;comment:售后单号"`
+	Order         int32      `gorm:"type:int;index;comment:订单ID"`
+	OrderSn       string     `gorm:"type:varchar(30);index;comment:订单号"`
+	User          int32      `gorm:"type:int;index;comment:用户ID"`
+	OrderGoods    int32      `gorm:"type:int;not null;default:0;comment:订单商品ID，0表示整单"`
+	Type          string     `gorm:"type:varchar(20);not null;comment:'REFUND_ONLY(仅退款), RETURN_GOODS(退货退款)'"`
+	Status        string     `gorm:"type:varchar(20);not null;index;comment:'PENDING(待审核), WAIT_RETURN(待退货), REFUNDING(退款中), REFUNDED(已退款), FAILED(退款失败), REJECTED(已拒绝), CANCELLED(已撤销)'"`
+	Amount        int64      `gorm:"type:bigint;not null;comment:退款金额（分）"`
+	Reason        string     `gorm:"type:varchar(100);comment:售后原因"`
+	Description   string     `gorm:"type:varchar(500);comment:问题描述"`
+	AuditRemark   string     `gorm:"type:varchar(200);comment:审核备注"`
+	Message       int32      `gorm:"type:int;index;comment:关联的售后留言ID"`
+	PayType       string     `gorm:"type:varchar(20);comment:退款渠道"`
+	ChannelRefund string     `gorm:"type:varchar(64);comment:渠道退款单号"`
+	FailReason    string     `gorm:"type:varchar(200);comment:退款失败原因"`
+	StockReturned bool       `gorm:"not null;default:false;comment:退货库存归还消息是否已写入发件箱"`
+	RefundedAt    *time.Time `gorm:"comment:退款成功时间"`
+}
+
+// RefundGoods 退货商品明细，仅退款的售后单没有明细
+type RefundGoods struct {
+	BaseModel
+	Refund     int32 `gorm:"type:int;index;comment:售后单ID"`
+	OrderGoods int32 `gorm:"type:int;index;comment:订单商品ID"`
+	Goods      int32 `gorm:"type:int;comment:商品ID"`
+	Nums       int32 `gorm:"type:int;comment:退货数量"`
+}
+
+// IsActive 售后单是否仍在处理中
+func (r *RefundOrder) IsActive() bool {
+	switch r.Status {
+	case RefundStatusPending, RefundStatusWaitReturn, RefundStatusRefunding, RefundStatusFailed:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// OrderDailyStat 按下单日期和订单当前状态汇总的订单数据，由定时任务从order_info重算，报表只读该表
+type OrderDailyStat struct {
+	BaseModel
+	StatDate    time.Time `gorm:"type:date;not null;uniqueIndex:idx_order_daily_stat;comment:下单日期"`
+	Status      string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_order_daily_stat;comment:订单当前状态"`
+	OrderCount  int64     `gorm:"type:bigint;not null;default:0;comment:下单数"`
+	PaidCount   int64     `gorm:"type:bigint;not null;default:0;comment:已支付订单数"`
+	OrderAmount Money     `gorm:"type:decimal(14,2);not null;default:0;comment:下单金额"`
+	PaidAmount  Money     `gorm:"type:decimal(14,2);not null;default:0;comment:已支付订单金额(GMV)"`
+}
+
+// GoodsDailyStat 按下单日期、订单当前状态和商品汇总的销量
+type GoodsDailyStat struct {
+	BaseModel
+	StatDate  time.Time `gorm:"type:date;not null;uniqueIndex:idx_goods_daily_stat;comment:下单日期"`
+	Status    string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_goods_daily_stat;comment:订单当前状态"`
+	Goods     int32     `gorm:"type:int;not null;uniqueIndex:idx_goods_daily_stat;index;comment:商品ID"`
+	GoodsName string    `gorm:"type:varchar(100);comment:商品名称"`
+	Nums      int64     `gorm:"type:bigint;not null;default:0;comment:销量"`
+	Revenue   Money     `gorm:"type:decimal(14,2);not null;default:0;comment:销售额，扣除分摊的优惠"`
+}
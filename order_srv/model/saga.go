@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// Saga实例状态
+const (
+	SagaStatusRunning      = "RUNNING"      // 正在执行
+	SagaStatusCompensating = "COMPENSATING" // 正在补偿，补偿失败时等待重试
+	SagaStatusSucceeded    = "SUCCEEDED"    // 全部步骤执行成功
+	SagaStatusCompensated  = "COMPENSATED"  // 已补偿完成
+	SagaStatusFailed       = "FAILED"       // 补偿多次失败，需要人工处理
+)
+
+// SagaInstance Saga执行状态，进程崩溃后由恢复任务根据该记录继续执行或补偿
+// Step为已完成的步骤数，补偿时逐步递减，Data为各步骤共享的业务数据JSON
+type SagaInstance struct {
+	BaseModel
+	SagaId      string    `gorm:"type:varchar(100);not null;uniqueIndex;comment:Saga业务唯一ID"`
+	Name        string    `gorm:"type:varchar(50);not null;index;comment:Saga定义名称"`
+	Status      string    `gorm:"type:varchar(20);not null;index;comment:'RUNNING(执行中), COMPENSATING(补偿中), SUCCEEDED(成功), COMPENSATED(已补偿), FAILED(补偿失败)'"`
+	Step        int32     `gorm:"type:int;not null;default:0;comment:已完成的步骤数"`
+	Data        string    `gorm:"type:text;comment:业务数据JSON"`
+	LastError   string    `gorm:"type:varchar(500);comment:最近一次错误"`
+	Attempts    int32     `gorm:"type:int;not null;default:0;comment:恢复或补偿重试次数"`
+	NextRetryAt time.Time `gorm:"comment:下次恢复时间"`
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// Shipment 发货单，一个订单可以分多次发货，每个发货单对应一个物流单号
+type Shipment struct {
+	BaseModel
+	Order      int32     `gorm:"type:int;index;not null;comment:订单ID"`
+	OrderSn    string    `gorm:"type:varchar(30);index;not null;comment:订单号"`
+	Carrier    string    `gorm:"type:varchar(30);not null;uniqueIndex:idx_shipment_tracking;comment:物流公司编码"`
+	TrackingNo string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_shipment_tracking;comment:物流单号"`
+	ShippedAt  time.Time `gorm:"not null;comment:发货时间"`
+	Status     string    `gorm:"type:varchar(30);not null;default:'';comment:最新物流状态"`
+}
+
+// ShipmentGoods 发货单包含的订单商品及数量
+type ShipmentGoods struct {
+	BaseModel
+	Shipment   int32 `gorm:"type:int;index;comment:发货单ID"`
+	Order      int32 `gorm:"type:int;index;comment:订单ID"`
+	OrderGoods int32 `gorm:"type:int;index;comment:订单商品ID"`
+	Goods      int32 `gorm:"type:int;comment:商品ID"`
+	Nums       int32 `gorm:"type:int;comment:发货数量"`
+}
+
+// ShipmentTrack 物流轨迹，由物流回调或人工录入追加
+type ShipmentTrack struct {
+	BaseModel
+	Shipment    int32     `gorm:"type:int;index;comment:发货单ID"`
+	Status      string    `gorm:"type:varchar(30);not null;comment:物流状态"`
+	Location    string    `gorm:"type:varchar(100);comment:所在地点"`
+	Description string    `gorm:"type:varchar(200);comment:轨迹描述"`
+	EventTime   time.Time `gorm:"not null;comment:轨迹发生时间"`
+}
@@ -0,0 +1,263 @@
+// Package outbox 事务发件箱：业务事务中写入跨服务调用意图，投递任务异步执行并在失败时退避重试
+// 投递语义为至少一次，消息处理函数需要保证幂等
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"order_srv/global"
+	"order_srv/model"
+
+	"gorm.io/gorm"
+)
+
+const (
+	batchSize    = 50
+	pollInterval = time.Second
+	// MaxAttempts 超过该投递次数的消息移入死信表
+	MaxAttempts = 10
+	// claimLease 消息被领取后在该时长内不会被再次领取，投递进程崩溃时到期后重新投递
+	claimLease = time.Minute
+	maxBackoff = 10 * time.Minute
+)
+
+var (
+	// ErrNoHandler 消息主题没有注册处理函数
+	ErrNoHandler = errors.New("消息主题没有注册处理函数")
+	// ErrAlreadyReplayed 死信已经重放过
+	ErrAlreadyReplayed = errors.New("死信已经重放")
+	// ErrKeyInFlight 相同业务键的消息仍在发件箱中
+	ErrKeyInFlight = errors.New("相同业务键的消息仍在发件箱中")
+)
+
+// Handler 消息处理函数，返回错误时消息按退避时间重试
+// key为消息的业务键，同一业务键的消息可能被投递多次，下游可以按业务键去重
+type Handler func(ctx context.Context, key string, payload []byte) error
+
+var (
+	mu       sync.RWMutex
+	handlers = make(map[string]Handler)
+)
+
+// Register 注册消息主题的处理函数
+func Register(topic string, h Handler) {
+	mu.Lock()
+	defer mu.Unlock()
+	handlers[topic] = h
+}
+
+func handler(topic string) (Handler, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	h, ok := handlers[topic]
+	return h, ok
+}
+
+// Enqueue 在业务事务中写入消息，事务提交后由投递任务执行
+func Enqueue(tx *gorm.DB, topic, key string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("序列化发件箱消息失败: %w", err)
+	}
+	return tx.Create(&model.OutboxMessage{
+		Topic:       topic,
+		MsgKey:      key,
+		Payload:     string(data),
+		Status:      model.OutboxStatusPending,
+		NextRetryAt: time.Now(),
+	}).Error
+}
+
+// EnqueueOnce 写入消息，相同key的消息已存在（包括已投递和已进入死信的）时不再写入，用于可能重复执行的补偿
+func EnqueueOnce(tx *gorm.DB, topic, key string, payload interface{}) error {
+	var count int64
+	if err := tx.Unscoped().Model(&model.OutboxMessage{}).Where("msg_key = ?", key).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	if err := tx.Model(&model.OutboxDeadLetter{}).Where("msg_key = ?", key).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return Enqueue(tx, topic, key, payload)
+}
+
+// Backoff 第attempts次投递失败后的等待时间，从1秒开始指数增长，最长10分钟
+func Backoff(attempts int32) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts > 20 {
+		return maxBackoff
+	}
+	d := time.Second << uint(attempts-1)
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
+
+// RunRelay 投递任务，轮询到期的消息并投递，阻塞直到ctx取消
+func RunRelay(ctx context.Context) {
+	for {
+		n, err := RelayOnce(ctx)
+		if err != nil {
+			global.Logger.Errorf("投递发件箱消息失败: %v", err)
+		}
+		// 本批领满说明还有积压，继续投递
+		if err == nil && n == batchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// RelayOnce 投递一批到期的消息，返回本批消息数量
+func RelayOnce(ctx context.Context) (int, error) {
+	var messages []model.OutboxMessage
+	if err := global.DB.Where("status = ? AND next_retry_at <= ?", model.OutboxStatusPending, time.Now()).
+		Order("id").Limit(batchSize).Find(&messages).Error; err != nil {
+		return 0, err
+	}
+	for i := range messages {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		deliver(ctx, &messages[i])
+	}
+	return len(messages), nil
+}
+
+// deliver 领取并投递单条消息，领取带有投递次数条件，多个投递任务并发时同一消息只会被一个领取
+func deliver(ctx context.Context, msg *model.OutboxMessage) {
+	now := time.Now()
+	result := global.DB.Model(&model.OutboxMessage{}).
+		Where("id = ? AND status = ? AND attempts = ?", msg.ID, model.OutboxStatusPending, msg.Attempts).
+		Updates(map[string]interface{}{
+			"attempts":      gorm.Expr("attempts + 1"),
+			"next_retry_at": now.Add(claimLease),
+		})
+	if result.Error != nil {
+		global.Logger.Errorf("领取发件箱消息失败，消息ID: %d，错误: %v", msg.ID, result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+	msg.Attempts++
+
+	err := ErrNoHandler
+	if h, ok := handler(msg.Topic); ok {
+		callCtx, cancel := context.WithTimeout(ctx, claimLease/2)
+		err = h(callCtx, msg.MsgKey, []byte(msg.Payload))
+		cancel()
+	}
+
+	if err == nil {
+		deliveredAt := time.Now()
+		if err := global.DB.Model(&model.OutboxMessage{}).Where("id = ?", msg.ID).Updates(map[string]interface{}{
+			"status":       model.OutboxStatusDelivered,
+			"delivered_at": &deliveredAt,
+			"last_error":   "",
+		}).Error; err != nil {
+			// 领取期满后会重新投递，依赖处理函数幂等
+			global.Logger.Errorf("更新发件箱消息为已投递失败，消息ID: %d，错误: %v", msg.ID, err)
+		}
+		return
+	}
+
+	lastError := truncate(err.Error(), 500)
+	if msg.Attempts >= MaxAttempts {
+		if dlErr := moveToDeadLetter(msg, lastError); dlErr != nil {
+			global.Logger.Errorf("发件箱消息移入死信表失败，消息ID: %d，错误: %v", msg.ID, dlErr)
+			return
+		}
+		global.Logger.Errorf("发件箱消息投递失败次数过多，已移入死信表，主题: %s，业务键: %s，错误: %s", msg.Topic, msg.MsgKey, lastError)
+		return
+	}
+
+	retryAt := time.Now().Add(Backoff(msg.Attempts))
+	if err := global.DB.Model(&model.OutboxMessage{}).Where("id = ?", msg.ID).Updates(map[string]interface{}{
+		"next_retry_at": retryAt,
+		"last_error":    lastError,
+	}).Error; err != nil {
+		global.Logger.Errorf("更新发件箱消息重试时间失败，消息ID: %d，错误: %v", msg.ID, err)
+		return
+	}
+	global.Logger.Warnf("发件箱消息投递失败，第%d次，%s后重试，主题: %s，业务键: %s，错误: %s",
+		msg.Attempts, retryAt.Sub(time.Now()).Round(time.Second), msg.Topic, msg.MsgKey, lastError)
+}
+
+// moveToDeadLetter 写入死信表并物理删除原消息，释放业务唯一键以便重放
+func moveToDeadLetter(msg *model.OutboxMessage, lastError string) error {
+	return global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model.OutboxDeadLetter{
+			Message:   msg.ID,
+			Topic:     msg.Topic,
+			MsgKey:    msg.MsgKey,
+			Payload:   msg.Payload,
+			Attempts:  msg.Attempts,
+			LastError: lastError,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&model.OutboxMessage{}, msg.ID).Error
+	})
+}
+
+// Replay 将死信重新写入发件箱，投递次数从零开始
+func Replay(ctx context.Context, deadLetterId int32) error {
+	return global.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dead model.OutboxDeadLetter
+		if err := tx.First(&dead, deadLetterId).Error; err != nil {
+			return err
+		}
+		if dead.Replayed {
+			return ErrAlreadyReplayed
+		}
+		var inFlight int64
+		if err := tx.Unscoped().Model(&model.OutboxMessage{}).Where("msg_key = ?", dead.MsgKey).Count(&inFlight).Error; err != nil {
+			return err
+		}
+		if inFlight > 0 {
+			return ErrKeyInFlight
+		}
+		now := time.Now()
+		result := tx.Model(&model.OutboxDeadLetter{}).
+			Where("id = ? AND replayed = ?", dead.ID, false).
+			Updates(map[string]interface{}{"replayed": true, "replayed_at": &now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrAlreadyReplayed
+		}
+		return tx.Create(&model.OutboxMessage{
+			Topic:       dead.Topic,
+			MsgKey:      dead.MsgKey,
+			Payload:     dead.Payload,
+			Status:      model.OutboxStatusPending,
+			NextRetryAt: now,
+		}).Error
+	})
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
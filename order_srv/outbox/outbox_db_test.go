@@ -0,0 +1,299 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"order_srv/config"
+	"order_srv/global"
+	"order_srv/model"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// setupTestDB 连接开发环境数据库并迁移发件箱表，与saga包的测试使用同一份配置
+func setupTestDB(t *testing.T) {
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("获取工作目录失败: %v", err)
+	}
+	configFile := filepath.Join(dir, "..", "config", "config-develop.yaml")
+	if _, err := os.Stat(configFile); os.IsNotExist(err) {
+		t.Fatalf("配置文件不存在: %s", configFile)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("读取配置文件失败: %v", err)
+	}
+	var cfg config.ServerConfig
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("解析配置文件失败: %v", err)
+	}
+
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		cfg.MySQL.User,
+		cfg.MySQL.Password,
+		cfg.MySQL.Host,
+		cfg.MySQL.Port,
+		cfg.MySQL.DBName,
+	)
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true, // 使用单数表名
+		},
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		t.Fatalf("连接数据库失败: %v", err)
+	}
+
+	global.DB = db
+	global.Logger = zap.S()
+	if err := db.AutoMigrate(&model.OutboxMessage{}, &model.OutboxDeadLetter{}); err != nil {
+		t.Fatalf("自动迁移表结构失败: %v", err)
+	}
+}
+
+// testTopic 每个测试使用独立的主题和业务键，测试结束后清理消息、死信和处理函数
+func testTopic(t *testing.T) (topic, key string) {
+	suffix := time.Now().UnixNano()
+	topic = fmt.Sprintf("test.outbox.%d", suffix)
+	key = fmt.Sprintf("test_outbox:%d", suffix)
+	t.Cleanup(func() {
+		global.DB.Unscoped().Where("topic = ?", topic).Delete(&model.OutboxMessage{})
+		global.DB.Unscoped().Where("topic = ?", topic).Delete(&model.OutboxDeadLetter{})
+		mu.Lock()
+		delete(handlers, topic)
+		mu.Unlock()
+	})
+	return topic, key
+}
+
+// deliverByKey 按业务键投递消息，只投递测试消息，避免影响开发库中的其他消息
+func deliverByKey(t *testing.T, key string) {
+	t.Helper()
+	msg := loadMessage(t, key)
+	if msg == nil {
+		t.Fatalf("业务键 %s 的消息不存在", key)
+	}
+	deliver(context.Background(), msg)
+}
+
+// loadMessage 按业务键查询消息，不存在时返回nil
+func loadMessage(t *testing.T, key string) *model.OutboxMessage {
+	t.Helper()
+	var msg model.OutboxMessage
+	err := global.DB.Where("msg_key = ?", key).First(&msg).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("查询发件箱消息失败: %v", err)
+	}
+	return &msg
+}
+
+// TestRelayDeliverAndAck 测试投递成功后消息标记为已投递，处理函数收到业务键和消息内容
+func TestRelayDeliverAndAck(t *testing.T) {
+	setupTestDB(t)
+	topic, key := testTopic(t)
+
+	var gotKey, gotPayload string
+	Register(topic, func(ctx context.Context, k string, payload []byte) error {
+		gotKey, gotPayload = k, string(payload)
+		return nil
+	})
+	if err := Enqueue(global.DB, topic, key, map[string]int{"goods_id": 1}); err != nil {
+		t.Fatalf("写入消息失败: %v", err)
+	}
+
+	deliverByKey(t, key)
+	if gotKey != key || gotPayload != `{"goods_id":1}` {
+		t.Errorf("处理函数收到的内容错误，业务键: %s，内容: %s", gotKey, gotPayload)
+	}
+	msg := loadMessage(t, key)
+	if msg.Status != model.OutboxStatusDelivered || msg.DeliveredAt == nil || msg.Attempts != 1 {
+		t.Errorf("投递成功后消息状态错误: %+v", msg)
+	}
+
+	// 已投递的消息不会被再次领取
+	deliverByKey(t, key)
+	if msg := loadMessage(t, key); msg.Attempts != 1 {
+		t.Errorf("已投递的消息不应再次投递，投递次数: %d", msg.Attempts)
+	}
+}
+
+// TestRelayRetrySchedule 测试投递失败后按退避时间安排重试，过期的领取不会重复投递
+func TestRelayRetrySchedule(t *testing.T) {
+	setupTestDB(t)
+	topic, key := testTopic(t)
+
+	calls := 0
+	Register(topic, func(ctx context.Context, k string, payload []byte) error {
+		calls++
+		return errors.New("库存服务不可用")
+	})
+	if err := Enqueue(global.DB, topic, key, map[string]int{}); err != nil {
+		t.Fatalf("写入消息失败: %v", err)
+	}
+	stale := loadMessage(t, key)
+
+	before := time.Now()
+	deliverByKey(t, key)
+	msg := loadMessage(t, key)
+	if msg.Status != model.OutboxStatusPending || msg.Attempts != 1 || msg.LastError != "库存服务不可用" {
+		t.Errorf("投递失败后消息状态错误: %+v", msg)
+	}
+	// 数据库时间精度为秒，按秒比较
+	earliest := before.Add(Backoff(1)).Truncate(time.Second)
+	latest := time.Now().Add(Backoff(1)).Add(time.Second)
+	if msg.NextRetryAt.Before(earliest) || msg.NextRetryAt.After(latest) {
+		t.Errorf("下次投递时间 %v 不在 %v ~ %v 之间", msg.NextRetryAt, earliest, latest)
+	}
+
+	// 其他投递任务持有的旧消息投递次数已过期，领取失败
+	deliver(context.Background(), stale)
+	if calls != 1 {
+		t.Errorf("投递次数已变化的消息不应再次投递，处理函数调用 %d 次", calls)
+	}
+
+	// 再次失败时退避时间翻倍
+	before = time.Now()
+	deliverByKey(t, key)
+	msg = loadMessage(t, key)
+	if msg.Attempts != 2 || msg.NextRetryAt.Before(before.Add(Backoff(2)).Truncate(time.Second)) {
+		t.Errorf("第二次投递失败后消息状态错误: %+v", msg)
+	}
+}
+
+// TestRelayNoHandler 测试没有注册处理函数的主题按投递失败重试
+func TestRelayNoHandler(t *testing.T) {
+	setupTestDB(t)
+	topic, key := testTopic(t)
+	if err := Enqueue(global.DB, topic, key, map[string]int{}); err != nil {
+		t.Fatalf("写入消息失败: %v", err)
+	}
+
+	deliverByKey(t, key)
+	msg := loadMessage(t, key)
+	if msg.Status != model.OutboxStatusPending || msg.LastError != ErrNoHandler.Error() {
+		t.Errorf("没有处理函数时应记录错误并等待重试: %+v", msg)
+	}
+}
+
+// TestRelayDeadLetter 测试达到最大投递次数后消息移入死信表并删除原消息
+func TestRelayDeadLetter(t *testing.T) {
+	setupTestDB(t)
+	topic, key := testTopic(t)
+
+	Register(topic, func(ctx context.Context, k string, payload []byte) error {
+		return errors.New("库存服务不可用")
+	})
+	if err := Enqueue(global.DB, topic, key, map[string]int{"goods_id": 1}); err != nil {
+		t.Fatalf("写入消息失败: %v", err)
+	}
+	if err := global.DB.Model(&model.OutboxMessage{}).Where("msg_key = ?", key).Update("attempts", MaxAttempts-1).Error; err != nil {
+		t.Fatalf("更新投递次数失败: %v", err)
+	}
+
+	deliverByKey(t, key)
+	if msg := loadMessage(t, key); msg != nil {
+		t.Errorf("移入死信表后原消息应被删除: %+v", msg)
+	}
+	var dead model.OutboxDeadLetter
+	if err := global.DB.Where("msg_key = ?", key).First(&dead).Error; err != nil {
+		t.Fatalf("查询死信失败: %v", err)
+	}
+	if dead.Topic != topic || dead.Attempts != MaxAttempts || dead.LastError != "库存服务不可用" || dead.Payload != `{"goods_id":1}` || dead.Replayed {
+		t.Errorf("死信内容错误: %+v", dead)
+	}
+
+	// 已进入死信的业务键不会被EnqueueOnce重复写入
+	if err := EnqueueOnce(global.DB, topic, key, map[string]int{}); err != nil {
+		t.Fatalf("写入消息失败: %v", err)
+	}
+	if msg := loadMessage(t, key); msg != nil {
+		t.Error("已进入死信的业务键不应再次写入发件箱")
+	}
+}
+
+// TestReplay 测试死信重放：重新写入发件箱且投递次数从零开始，重复重放和业务键仍在发件箱中时拒绝
+func TestReplay(t *testing.T) {
+	setupTestDB(t)
+	topic, key := testTopic(t)
+	ctx := context.Background()
+
+	if err := Replay(ctx, 0); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("重放不存在的死信应返回ErrRecordNotFound，实际: %v", err)
+	}
+
+	dead := model.OutboxDeadLetter{Topic: topic, MsgKey: key, Payload: `{"goods_id":1}`, Attempts: MaxAttempts, LastError: "库存服务不可用"}
+	if err := global.DB.Create(&dead).Error; err != nil {
+		t.Fatalf("创建死信失败: %v", err)
+	}
+	if err := Replay(ctx, dead.ID); err != nil {
+		t.Fatalf("重放死信失败: %v", err)
+	}
+	msg := loadMessage(t, key)
+	if msg == nil || msg.Status != model.OutboxStatusPending || msg.Attempts != 0 || msg.Topic != topic || msg.Payload != dead.Payload {
+		t.Errorf("重放后的消息错误: %+v", msg)
+	}
+	var replayed model.OutboxDeadLetter
+	global.DB.First(&replayed, dead.ID)
+	if !replayed.Replayed || replayed.ReplayedAt == nil {
+		t.Errorf("重放后死信应标记为已重放: %+v", replayed)
+	}
+
+	if err := Replay(ctx, dead.ID); !errors.Is(err, ErrAlreadyReplayed) {
+		t.Errorf("重复重放应返回ErrAlreadyReplayed，实际: %v", err)
+	}
+
+	// 相同业务键的消息仍在发件箱中时，另一条死信不能重放
+	another := model.OutboxDeadLetter{Topic: topic, MsgKey: key, Payload: `{}`, Attempts: MaxAttempts}
+	if err := global.DB.Create(&another).Error; err != nil {
+		t.Fatalf("创建死信失败: %v", err)
+	}
+	if err := Replay(ctx, another.ID); !errors.Is(err, ErrKeyInFlight) {
+		t.Errorf("业务键仍在发件箱中时应返回ErrKeyInFlight，实际: %v", err)
+	}
+	global.DB.First(&another, another.ID)
+	if another.Replayed {
+		t.Error("重放失败的死信不应标记为已重放")
+	}
+}
+
+// TestEnqueueOnce 测试相同业务键只写入一次，包括已投递的消息
+func TestEnqueueOnce(t *testing.T) {
+	setupTestDB(t)
+	topic, key := testTopic(t)
+	Register(topic, func(ctx context.Context, k string, payload []byte) error { return nil })
+
+	for i := 0; i < 2; i++ {
+		if err := EnqueueOnce(global.DB, topic, key, map[string]int{"n": i}); err != nil {
+			t.Fatalf("写入消息失败: %v", err)
+		}
+	}
+	deliverByKey(t, key)
+	if err := EnqueueOnce(global.DB, topic, key, map[string]int{"n": 2}); err != nil {
+		t.Fatalf("写入消息失败: %v", err)
+	}
+
+	var messages []model.OutboxMessage
+	global.DB.Where("msg_key = ?", key).Find(&messages)
+	if len(messages) != 1 || !strings.Contains(messages[0].Payload, `"n":0`) {
+		t.Errorf("相同业务键应只写入第一条消息: %+v", messages)
+	}
+}
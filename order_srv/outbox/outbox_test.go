@@ -0,0 +1,34 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+// TestBackoff 测试投递失败的退避时间
+func TestBackoff(t *testing.T) {
+	cases := map[int32]time.Duration{
+		0:   time.Second,
+		1:   time.Second,
+		2:   2 * time.Second,
+		5:   16 * time.Second,
+		10:  512 * time.Second,
+		11:  maxBackoff,
+		100: maxBackoff,
+	}
+	for attempts, want := range cases {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) 期望 %v，实际 %v", attempts, want, got)
+		}
+	}
+}
+
+// TestTruncate 测试错误信息按字符截断
+func TestTruncate(t *testing.T) {
+	if got := truncate("库存服务不可用", 4); got != "库存服务" {
+		t.Errorf("截断结果错误: %s", got)
+	}
+	if got := truncate("ok", 10); got != "ok" {
+		t.Errorf("未超长时不应截断: %s", got)
+	}
+}
@@ -0,0 +1,359 @@
+package payment
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"order_srv/config"
+)
+
+const (
+	alipayGateway    = "https://openapi.alipay.com/gateway.do"
+	alipayTimeLayout = "2006-01-02 15:04:05"
+	alipaySuccess    = "10000"
+)
+
+// 调用渠道接口的HTTP客户端
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// AlipayProvider 支付宝电脑网站支付，请求使用RSA2签名
+type AlipayProvider struct {
+	cfg        config.AlipayConfig
+	privateKey *rsa.PrivateKey
+	publicKey  *rsa.PublicKey
+}
+
+// NewAlipayProvider 创建支付宝支付渠道
+func NewAlipayProvider(cfg config.AlipayConfig) (*AlipayProvider, error) {
+	if cfg.AppId == "" {
+		return nil, errors.New("支付宝app_id未配置")
+	}
+	privateKey, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("支付宝应用私钥: %w", err)
+	}
+	publicKey, err := parsePublicKey(cfg.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("支付宝公钥: %w", err)
+	}
+	if cfg.Gateway == "" {
+		cfg.Gateway = alipayGateway
+	}
+	return &AlipayProvider{cfg: cfg, privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+func (p *AlipayProvider) Name() string {
+	return PayTypeAlipay
+}
+
+func (p *AlipayProvider) CreatePayment(ctx context.Context, req *CreateRequest) (*CreateResult, error) {
+	biz := map[string]string{
+		"out_trade_no": req.OutTradeNo,
+		"total_amount": CentsToYuan(req.AmountCents),
+		"subject":      req.Subject,
+		"product_code": "FAST_INSTANT_TRADE_PAY",
+	}
+	if !req.ExpireAt.IsZero() {
+		biz["time_expire"] = req.ExpireAt.Format(alipayTimeLayout)
+	}
+	params, err := p.buildParams("alipay.trade.page.pay", biz)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateResult{PayUrl: p.cfg.Gateway + "?" + params.Encode()}, nil
+}
+
+func (p *AlipayProvider) VerifyNotify(ctx context.Context, headers map[string]string, body []byte) (*Notification, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("解析回调内容失败: %w", err)
+	}
+	sign := values.Get("sign")
+	if sign == "" {
+		return nil, ErrInvalidSignature
+	}
+	// 异步通知验签时sign和sign_type都不参与签名
+	values.Del("sign")
+	values.Del("sign_type")
+	if err := verifySHA256WithRSA(p.publicKey, alipaySignContent(values), sign); err != nil {
+		return nil, err
+	}
+	if values.Get("app_id") != p.cfg.AppId {
+		return nil, fmt.Errorf("回调app_id不匹配: %s", values.Get("app_id"))
+	}
+
+	amount, err := parseYuan(values.Get("total_amount"))
+	if err != nil {
+		return nil, err
+	}
+	n := &Notification{
+		OutTradeNo:  values.Get("out_trade_no"),
+		TradeNo:     values.Get("trade_no"),
+		AmountCents: amount,
+		Status:      alipayTradeStatus(values.Get("trade_status")),
+		Raw:         string(body),
+	}
+	if t, err := time.ParseInLocation(alipayTimeLayout, values.Get("gmt_payment"), time.Local); err == nil {
+		n.PaidAt = t
+	}
+	return n, nil
+}
+
+func (p *AlipayProvider) NotifyReply(success bool) string {
+	if success {
+		return "success"
+	}
+	return "failure"
+}
+
+func (p *AlipayProvider) QueryPayment(ctx context.Context, outTradeNo string) (*QueryResult, error) {
+	var resp struct {
+		alipayResponse
+		OutTradeNo  string `json:"out_trade_no"`
+		TradeNo     string `json:"trade_no"`
+		TradeStatus string `json:"trade_status"`
+		TotalAmount string `json:"total_amount"`
+		SendPayDate string `json:"send_pay_date"`
+	}
+	err := p.call(ctx, "alipay.trade.query", map[string]string{"out_trade_no": outTradeNo}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != alipaySuccess {
+		if resp.SubCode == "ACQ.TRADE_NOT_EXIST" {
+			return nil, ErrTradeNotFound
+		}
+		return nil, resp.err()
+	}
+
+	amount, err := parseYuan(resp.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+	result := &QueryResult{
+		OutTradeNo:  resp.OutTradeNo,
+		TradeNo:     resp.TradeNo,
+		AmountCents: amount,
+		Status:      alipayTradeStatus(resp.TradeStatus),
+	}
+	if t, err := time.ParseInLocation(alipayTimeLayout, resp.SendPayDate, time.Local); err == nil {
+		result.PaidAt = t
+	}
+	return result, nil
+}
+
+func (p *AlipayProvider) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	var resp struct {
+		alipayResponse
+		TradeNo    string `json:"trade_no"`
+		FundChange string `json:"fund_change"`
+		RefundFee  string `json:"refund_fee"`
+	}
+	biz := map[string]string{
+		"out_trade_no":   req.OutTradeNo,
+		"out_request_no": req.OutRefundNo,
+		"refund_amount":  CentsToYuan(req.RefundCents),
+		"refund_reason":  req.Reason,
+	}
+	if err := p.call(ctx, "alipay.trade.refund", biz, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Code != alipaySuccess {
+		return nil, resp.err()
+	}
+
+	// fund_change为Y表示本次请求资金已退回；为N可能是重复请求也可能尚未退款，需查询退款结果确认
+	status := RefundStatusProcessing
+	if resp.FundChange == "Y" {
+		status = RefundStatusSuccess
+	}
+	return &RefundResult{
+		RefundNo:    req.OutRefundNo,
+		RefundCents: req.RefundCents,
+		Status:      status,
+	}, nil
+}
+
+func (p *AlipayProvider) QueryRefund(ctx context.Context, outTradeNo, outRefundNo string) (*RefundResult, error) {
+	var resp struct {
+		alipayResponse
+		OutRequestNo string `json:"out_request_no"`
+		RefundAmount string `json:"refund_amount"`
+		RefundStatus string `json:"refund_status"`
+	}
+	biz := map[string]string{
+		"out_trade_no":   outTradeNo,
+		"out_request_no": outRefundNo,
+	}
+	if err := p.call(ctx, "alipay.trade.fastpay.refund.query", biz, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Code != alipaySuccess {
+		if resp.SubCode == "ACQ.TRADE_NOT_EXIST" {
+			return nil, ErrRefundNotFound
+		}
+		return nil, resp.err()
+	}
+	// 退款请求不存在时接口同样返回成功，但不包含退款单号
+	if resp.OutRequestNo == "" {
+		return nil, ErrRefundNotFound
+	}
+
+	result := &RefundResult{RefundNo: resp.OutRequestNo, Status: RefundStatusProcessing}
+	if resp.RefundStatus == "REFUND_SUCCESS" {
+		result.Status = RefundStatusSuccess
+	}
+	if resp.RefundAmount != "" {
+		amount, err := parseYuan(resp.RefundAmount)
+		if err != nil {
+			return nil, err
+		}
+		result.RefundCents = amount
+	}
+	return result, nil
+}
+
+// alipayResponse 支付宝接口公共响应参数
+type alipayResponse struct {
+	Code    string `json:"code"`
+	Msg     string `json:"msg"`
+	SubCode string `json:"sub_code"`
+	SubMsg  string `json:"sub_msg"`
+}
+
+func (r alipayResponse) err() error {
+	return fmt.Errorf("支付宝接口错误: %s %s(%s %s)", r.Code, r.Msg, r.SubCode, r.SubMsg)
+}
+
+// call 调用支付宝接口，校验响应签名后解析到out
+func (p *AlipayProvider) call(ctx context.Context, method string, biz map[string]string, out interface{}) error {
+	params, err := p.buildParams(method, biz)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.Gateway, strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("请求支付宝失败: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("读取支付宝响应失败: %w", err)
+	}
+
+	// 响应签名针对 xxx_response 节点的原始JSON文本
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return fmt.Errorf("解析支付宝响应失败: %w", err)
+	}
+	node := raw[strings.ReplaceAll(method, ".", "_")+"_response"]
+	if node == nil {
+		node = raw["error_response"]
+	}
+	if node == nil {
+		return fmt.Errorf("支付宝响应格式错误: %s", body)
+	}
+	var sign string
+	if s, ok := raw["sign"]; ok {
+		_ = json.Unmarshal(s, &sign)
+	}
+	if sign == "" {
+		// 网关层的错误响应可能不带签名，只接受非成功的响应；成功响应缺少签名视为被篡改
+		var common alipayResponse
+		if err := json.Unmarshal(node, &common); err != nil || common.Code == alipaySuccess {
+			return ErrInvalidSignature
+		}
+	} else if err := verifySHA256WithRSA(p.publicKey, string(node), sign); err != nil {
+		return err
+	}
+	return json.Unmarshal(node, out)
+}
+
+// buildParams 组装公共请求参数并签名
+func (p *AlipayProvider) buildParams(method string, biz map[string]string) (url.Values, error) {
+	bizContent, err := json.Marshal(biz)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("app_id", p.cfg.AppId)
+	params.Set("method", method)
+	params.Set("format", "JSON")
+	params.Set("charset", "utf-8")
+	params.Set("sign_type", "RSA2")
+	params.Set("timestamp", time.Now().Format(alipayTimeLayout))
+	params.Set("version", "1.0")
+	params.Set("biz_content", string(bizContent))
+	if p.cfg.NotifyUrl != "" {
+		params.Set("notify_url", p.cfg.NotifyUrl)
+	}
+	if p.cfg.ReturnUrl != "" && method == "alipay.trade.page.pay" {
+		params.Set("return_url", p.cfg.ReturnUrl)
+	}
+
+	sign, err := signSHA256WithRSA(p.privateKey, alipaySignContent(params))
+	if err != nil {
+		return nil, fmt.Errorf("支付宝请求签名失败: %w", err)
+	}
+	params.Set("sign", sign)
+	return params, nil
+}
+
+// alipaySignContent 按参数名排序后拼接为待签名字符串，空值不参与签名
+func alipaySignContent(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if k == "sign" || values.Get(k) == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(values.Get(k))
+	}
+	return b.String()
+}
+
+func alipayTradeStatus(status string) string {
+	switch status {
+	case "TRADE_SUCCESS", "TRADE_FINISHED":
+		return TradeStatusSuccess
+	case "TRADE_CLOSED":
+		return TradeStatusClosed
+	default:
+		return TradeStatusPending
+	}
+}
+
+// parseYuan 将"12.34"格式的金额解析为分
+func parseYuan(s string) (int64, error) {
+	yuan, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("金额格式错误: %s", s)
+	}
+	return YuanToCents(yuan), nil
+}
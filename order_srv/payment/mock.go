@@ -0,0 +1,222 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// MockSignatureHeader 模拟支付回调的签名请求头
+const MockSignatureHeader = "X-Mock-Signature"
+
+// mockNotifyBody 模拟支付回调内容
+type mockNotifyBody struct {
+	OutTradeNo string `json:"out_trade_no"`
+	TradeNo    string `json:"trade_no"`
+	Amount     int64  `json:"amount"`
+	Status     string `json:"status"`
+	PaidAt     int64  `json:"paid_at"`
+}
+
+type mockTrade struct {
+	amount   int64
+	refunded int64
+	tradeNo  string
+	status   string
+	paidAt   time.Time
+	refunds  map[string]*RefundResult
+}
+
+// MockProvider 本地模拟支付渠道，回调使用HMAC-SHA256签名，交易状态保存在内存中
+type MockProvider struct {
+	secret      []byte
+	mu          sync.Mutex
+	trades      map[string]*mockTrade
+	asyncRefund bool
+}
+
+// NewMockProvider 创建模拟支付渠道
+func NewMockProvider(secret string) *MockProvider {
+	return &MockProvider{
+		secret: []byte(secret),
+		trades: make(map[string]*mockTrade),
+	}
+}
+
+func (p *MockProvider) Name() string {
+	return PayTypeMock
+}
+
+func (p *MockProvider) CreatePayment(ctx context.Context, req *CreateRequest) (*CreateResult, error) {
+	if req.AmountCents <= 0 {
+		return nil, fmt.Errorf("支付金额必须大于0")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t, ok := p.trades[req.OutTradeNo]; ok && t.status == TradeStatusSuccess {
+		return nil, fmt.Errorf("订单 %s 已支付", req.OutTradeNo)
+	}
+	p.trades[req.OutTradeNo] = &mockTrade{amount: req.AmountCents, status: TradeStatusPending, refunds: make(map[string]*RefundResult)}
+
+	query := url.Values{}
+	query.Set("out_trade_no", req.OutTradeNo)
+	query.Set("amount", CentsToYuan(req.AmountCents))
+	return &CreateResult{
+		PayUrl: "mock://pay?" + query.Encode(),
+		PayParams: map[string]string{
+			"out_trade_no": req.OutTradeNo,
+		},
+	}, nil
+}
+
+// BuildNotify 构造一条已签名的支付成功回调，用于本地联调和测试
+func (p *MockProvider) BuildNotify(outTradeNo, tradeNo string, amountCents int64) (map[string]string, []byte) {
+	body, _ := json.Marshal(mockNotifyBody{
+		OutTradeNo: outTradeNo,
+		TradeNo:    tradeNo,
+		Amount:     amountCents,
+		Status:     TradeStatusSuccess,
+		PaidAt:     time.Now().Unix(),
+	})
+	return map[string]string{MockSignatureHeader: p.sign(body)}, body
+}
+
+func (p *MockProvider) VerifyNotify(ctx context.Context, headers map[string]string, body []byte) (*Notification, error) {
+	expected := p.sign(body)
+	if !hmac.Equal([]byte(expected), []byte(headerValue(headers, MockSignatureHeader))) {
+		return nil, ErrInvalidSignature
+	}
+
+	var n mockNotifyBody
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, fmt.Errorf("解析回调内容失败: %w", err)
+	}
+	paidAt := time.Unix(n.PaidAt, 0)
+
+	p.mu.Lock()
+	if t, ok := p.trades[n.OutTradeNo]; ok && n.Status == TradeStatusSuccess {
+		t.status = TradeStatusSuccess
+		t.tradeNo = n.TradeNo
+		t.paidAt = paidAt
+	}
+	p.mu.Unlock()
+
+	return &Notification{
+		OutTradeNo:  n.OutTradeNo,
+		TradeNo:     n.TradeNo,
+		AmountCents: n.Amount,
+		Status:      n.Status,
+		PaidAt:      paidAt,
+		Raw:         string(body),
+	}, nil
+}
+
+func (p *MockProvider) NotifyReply(success bool) string {
+	if success {
+		return "success"
+	}
+	return "fail"
+}
+
+func (p *MockProvider) QueryPayment(ctx context.Context, outTradeNo string) (*QueryResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	t, ok := p.trades[outTradeNo]
+	if !ok {
+		return nil, ErrTradeNotFound
+	}
+	return &QueryResult{
+		OutTradeNo:  outTradeNo,
+		TradeNo:     t.tradeNo,
+		AmountCents: t.amount,
+		Status:      t.status,
+		PaidAt:      t.paidAt,
+	}, nil
+}
+
+func (p *MockProvider) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	t, ok := p.trades[req.OutTradeNo]
+	if !ok {
+		return nil, ErrTradeNotFound
+	}
+	if t.status != TradeStatusSuccess && t.status != TradeStatusRefunded {
+		return nil, fmt.Errorf("交易 %s 未支付，不能退款", req.OutTradeNo)
+	}
+	if r, ok := t.refunds[req.OutRefundNo]; ok {
+		result := *r
+		return &result, nil
+	}
+	if req.RefundCents <= 0 || t.refunded+req.RefundCents > t.amount {
+		return nil, fmt.Errorf("退款金额超出可退金额")
+	}
+	r := &RefundResult{RefundNo: req.OutRefundNo, RefundCents: req.RefundCents, Status: RefundStatusSuccess}
+	if p.asyncRefund {
+		r.Status = RefundStatusProcessing
+	}
+	t.refunds[req.OutRefundNo] = r
+	t.refunded += req.RefundCents
+	if t.refunded == t.amount {
+		t.status = TradeStatusRefunded
+	}
+	result := *r
+	return &result, nil
+}
+
+func (p *MockProvider) QueryRefund(ctx context.Context, outTradeNo, outRefundNo string) (*RefundResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	t, ok := p.trades[outTradeNo]
+	if !ok {
+		return nil, ErrRefundNotFound
+	}
+	r, ok := t.refunds[outRefundNo]
+	if !ok {
+		return nil, ErrRefundNotFound
+	}
+	result := *r
+	return &result, nil
+}
+
+// SetAsyncRefund 设置为异步退款，退款请求返回处理中，调用SetRefundStatus后才有最终结果
+func (p *MockProvider) SetAsyncRefund(async bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.asyncRefund = async
+}
+
+// SetRefundStatus 模拟渠道退款处理完成，退款关闭或异常时退回可退金额
+func (p *MockProvider) SetRefundStatus(outTradeNo, outRefundNo, status string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	t, ok := p.trades[outTradeNo]
+	if !ok {
+		return ErrRefundNotFound
+	}
+	r, ok := t.refunds[outRefundNo]
+	if !ok {
+		return ErrRefundNotFound
+	}
+	if r.Status != RefundStatusProcessing {
+		return fmt.Errorf("退款 %s 已处理完成", outRefundNo)
+	}
+	r.Status = status
+	if status == RefundStatusClosed || status == RefundStatusAbnormal {
+		t.refunded -= r.RefundCents
+		t.status = TradeStatusSuccess
+	}
+	return nil
+}
+
+func (p *MockProvider) sign(body []byte) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
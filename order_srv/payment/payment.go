@@ -0,0 +1,163 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// 支付渠道
+const (
+	PayTypeAlipay = "alipay"
+	PayTypeWechat = "wechat"
+	PayTypeMock   = "mock"
+)
+
+// 渠道交易状态，各渠道的状态统一转换为以下几种
+const (
+	TradeStatusPending  = "PENDING"  // 待支付
+	TradeStatusSuccess  = "SUCCESS"  // 支付成功
+	TradeStatusClosed   = "CLOSED"   // 交易关闭
+	TradeStatusRefunded = "REFUNDED" // 已退款
+)
+
+// 渠道退款状态，各渠道的退款状态统一转换为以下几种
+const (
+	RefundStatusSuccess    = "SUCCESS"    // 退款已到账
+	RefundStatusProcessing = "PROCESSING" // 退款处理中
+	RefundStatusClosed     = "CLOSED"     // 退款关闭，资金未退回
+	RefundStatusAbnormal   = "ABNORMAL"   // 退款异常，需人工处理
+)
+
+var (
+	// ErrProviderNotFound 支付渠道未注册
+	ErrProviderNotFound = errors.New("不支持的支付方式")
+	// ErrInvalidSignature 回调或响应签名校验失败
+	ErrInvalidSignature = errors.New("支付签名校验失败")
+	// ErrTradeNotFound 渠道侧不存在该交易
+	ErrTradeNotFound = errors.New("支付交易不存在")
+	// ErrRefundNotFound 渠道侧不存在该退款
+	ErrRefundNotFound = errors.New("退款单不存在")
+)
+
+// CreateRequest 创建支付请求
+type CreateRequest struct {
+	OutTradeNo  string    // 商户订单号，使用平台订单号
+	Subject     string    // 订单标题
+	AmountCents int64     // 支付金额（分）
+	ExpireAt    time.Time // 支付截止时间，零值表示使用渠道默认值
+	ClientIP    string
+}
+
+// CreateResult 创建支付结果
+type CreateResult struct {
+	PayUrl    string            // 支付跳转链接或二维码内容
+	PayParams map[string]string // 客户端调起支付需要的参数
+}
+
+// Notification 验签通过的支付回调
+type Notification struct {
+	OutTradeNo  string
+	TradeNo     string // 渠道交易号
+	AmountCents int64
+	Status      string
+	PaidAt      time.Time
+	Raw         string // 回调原文，用于对账
+}
+
+// QueryResult 查询支付结果
+type QueryResult struct {
+	OutTradeNo  string
+	TradeNo     string
+	AmountCents int64
+	Status      string
+	PaidAt      time.Time
+}
+
+// RefundRequest 退款请求，同一OutRefundNo重复请求只会退款一次
+type RefundRequest struct {
+	OutTradeNo  string
+	OutRefundNo string
+	TotalCents  int64 // 原支付金额（分）
+	RefundCents int64 // 本次退款金额（分）
+	Reason      string
+}
+
+// RefundResult 退款结果
+type RefundResult struct {
+	RefundNo    string // 渠道退款单号
+	RefundCents int64
+	Status      string // 渠道退款状态，取值见RefundStatus常量
+}
+
+// PaymentProvider 支付渠道
+type PaymentProvider interface {
+	// Name 渠道名称，与订单的PayType一致
+	Name() string
+	// CreatePayment 创建支付
+	CreatePayment(ctx context.Context, req *CreateRequest) (*CreateResult, error)
+	// VerifyNotify 校验回调签名并解析回调内容，签名错误返回ErrInvalidSignature
+	VerifyNotify(ctx context.Context, headers map[string]string, body []byte) (*Notification, error)
+	// NotifyReply 返回给渠道的回调应答内容
+	NotifyReply(success bool) string
+	// QueryPayment 主动查询支付状态，渠道侧不存在时返回ErrTradeNotFound
+	QueryPayment(ctx context.Context, outTradeNo string) (*QueryResult, error)
+	// Refund 申请退款
+	Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error)
+	// QueryRefund 查询退款状态，渠道侧不存在该退款时返回ErrRefundNotFound
+	QueryRefund(ctx context.Context, outTradeNo, outRefundNo string) (*RefundResult, error)
+}
+
+var (
+	mu        sync.RWMutex
+	providers = make(map[string]PaymentProvider)
+)
+
+// Register 注册支付渠道，同名渠道会被覆盖
+func Register(p PaymentProvider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[p.Name()] = p
+}
+
+// Get 获取支付渠道
+func Get(name string) (PaymentProvider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFound, name)
+	}
+	return p, nil
+}
+
+// Names 返回已注册的支付渠道名称
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// YuanToCents 将元转换为分，四舍五入消除浮点误差
+func YuanToCents(yuan float64) int64 {
+	return int64(math.Round(yuan * 100))
+}
+
+// CentsToYuan 将分格式化为两位小数的元，如 1234 -> "12.34"
+func CentsToYuan(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,327 @@
+package payment
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"order_srv/config"
+)
+
+func testKeyPair(t *testing.T) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("生成RSA密钥失败: %v", err)
+	}
+	privateDer := x509.MarshalPKCS1PrivateKey(key)
+	publicDer, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("编码公钥失败: %v", err)
+	}
+	privatePem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: privateDer})
+	// 支付宝后台下载的公钥不带PEM头尾
+	return string(privatePem), base64.StdEncoding.EncodeToString(publicDer)
+}
+
+// TestAmountConversion 测试元和分的转换
+func TestAmountConversion(t *testing.T) {
+	if cents := YuanToCents(float64(float32(19.99))); cents != 1999 {
+		t.Errorf("19.99元应转换为1999分，实际: %d", cents)
+	}
+	cases := map[int64]string{0: "0.00", 5: "0.05", 1234: "12.34", -150: "-1.50"}
+	for cents, want := range cases {
+		if got := CentsToYuan(cents); got != want {
+			t.Errorf("CentsToYuan(%d) 期望 %s，实际 %s", cents, want, got)
+		}
+	}
+}
+
+// TestMockProvider 测试模拟支付的回调验签、查询和退款
+func TestMockProvider(t *testing.T) {
+	ctx := context.Background()
+	p := NewMockProvider("secret")
+	if _, err := p.CreatePayment(ctx, &CreateRequest{OutTradeNo: "SN001", AmountCents: 1000}); err != nil {
+		t.Fatalf("创建支付失败: %v", err)
+	}
+
+	headers, body := p.BuildNotify("SN001", "T001", 1000)
+	n, err := p.VerifyNotify(ctx, headers, body)
+	if err != nil {
+		t.Fatalf("回调验签失败: %v", err)
+	}
+	if n.Status != TradeStatusSuccess || n.AmountCents != 1000 || n.TradeNo != "T001" {
+		t.Errorf("回调内容解析错误: %+v", n)
+	}
+
+	forged := NewMockProvider("other")
+	headers, body = forged.BuildNotify("SN001", "T002", 1)
+	if _, err := p.VerifyNotify(ctx, headers, body); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("伪造回调应返回ErrInvalidSignature，实际: %v", err)
+	}
+
+	result, err := p.QueryPayment(ctx, "SN001")
+	if err != nil || result.Status != TradeStatusSuccess {
+		t.Errorf("查询支付结果错误: %+v, %v", result, err)
+	}
+	if _, err := p.QueryPayment(ctx, "SN404"); !errors.Is(err, ErrTradeNotFound) {
+		t.Errorf("查询不存在的交易应返回ErrTradeNotFound，实际: %v", err)
+	}
+
+	if _, err := p.Refund(ctx, &RefundRequest{OutTradeNo: "SN001", OutRefundNo: "R1", RefundCents: 600}); err != nil {
+		t.Fatalf("退款失败: %v", err)
+	}
+	// 同一退款单号重复请求不重复退款
+	if _, err := p.Refund(ctx, &RefundRequest{OutTradeNo: "SN001", OutRefundNo: "R1", RefundCents: 600}); err != nil {
+		t.Fatalf("重复退款请求失败: %v", err)
+	}
+	if _, err := p.Refund(ctx, &RefundRequest{OutTradeNo: "SN001", OutRefundNo: "R2", RefundCents: 500}); err == nil {
+		t.Error("超额退款应失败")
+	}
+}
+
+// TestMockAsyncRefund 测试模拟支付的异步退款，处理中的退款需要查询才能得到最终结果
+func TestMockAsyncRefund(t *testing.T) {
+	ctx := context.Background()
+	p := NewMockProvider("secret")
+	if _, err := p.CreatePayment(ctx, &CreateRequest{OutTradeNo: "SN001", AmountCents: 1000}); err != nil {
+		t.Fatalf("创建支付失败: %v", err)
+	}
+	headers, body := p.BuildNotify("SN001", "T001", 1000)
+	if _, err := p.VerifyNotify(ctx, headers, body); err != nil {
+		t.Fatalf("回调验签失败: %v", err)
+	}
+
+	if _, err := p.QueryRefund(ctx, "SN001", "R1"); !errors.Is(err, ErrRefundNotFound) {
+		t.Errorf("查询不存在的退款应返回ErrRefundNotFound，实际: %v", err)
+	}
+
+	p.SetAsyncRefund(true)
+	result, err := p.Refund(ctx, &RefundRequest{OutTradeNo: "SN001", OutRefundNo: "R1", RefundCents: 1000})
+	if err != nil {
+		t.Fatalf("退款失败: %v", err)
+	}
+	if result.Status != RefundStatusProcessing {
+		t.Errorf("异步退款应返回处理中，实际: %s", result.Status)
+	}
+	if err := p.SetRefundStatus("SN001", "R1", RefundStatusClosed); err != nil {
+		t.Fatalf("设置退款结果失败: %v", err)
+	}
+	result, err = p.QueryRefund(ctx, "SN001", "R1")
+	if err != nil || result.Status != RefundStatusClosed {
+		t.Errorf("查询退款结果错误: %+v, %v", result, err)
+	}
+
+	// 退款关闭后资金未退出，可以用新的退款单号再次退款
+	if _, err := p.Refund(ctx, &RefundRequest{OutTradeNo: "SN001", OutRefundNo: "R2", RefundCents: 1000}); err != nil {
+		t.Fatalf("退款关闭后再次退款失败: %v", err)
+	}
+	if err := p.SetRefundStatus("SN001", "R2", RefundStatusSuccess); err != nil {
+		t.Fatalf("设置退款结果失败: %v", err)
+	}
+	if err := p.SetRefundStatus("SN001", "R2", RefundStatusClosed); err == nil {
+		t.Error("已完成的退款不能再修改结果")
+	}
+	result, err = p.QueryRefund(ctx, "SN001", "R2")
+	if err != nil || result.Status != RefundStatusSuccess || result.RefundCents != 1000 {
+		t.Errorf("查询退款结果错误: %+v, %v", result, err)
+	}
+}
+
+// TestAlipayVerifyNotify 测试支付宝异步通知验签
+func TestAlipayVerifyNotify(t *testing.T) {
+	privateKey, publicKey := testKeyPair(t)
+	p, err := NewAlipayProvider(config.AlipayConfig{AppId: "2021000000", PrivateKey: privateKey, PublicKey: publicKey})
+	if err != nil {
+		t.Fatalf("创建支付宝渠道失败: %v", err)
+	}
+
+	values := url.Values{}
+	values.Set("app_id", "2021000000")
+	values.Set("out_trade_no", "SN001")
+	values.Set("trade_no", "2024010122001")
+	values.Set("trade_status", "TRADE_SUCCESS")
+	values.Set("total_amount", "88.80")
+	values.Set("gmt_payment", "2024-01-01 12:00:00")
+	sign, err := signSHA256WithRSA(p.privateKey, alipaySignContent(values))
+	if err != nil {
+		t.Fatalf("签名失败: %v", err)
+	}
+	values.Set("sign", sign)
+	values.Set("sign_type", "RSA2")
+
+	n, err := p.VerifyNotify(context.Background(), nil, []byte(values.Encode()))
+	if err != nil {
+		t.Fatalf("验签失败: %v", err)
+	}
+	if n.Status != TradeStatusSuccess || n.AmountCents != 8880 || n.PaidAt.IsZero() {
+		t.Errorf("回调内容解析错误: %+v", n)
+	}
+
+	values.Set("total_amount", "0.01")
+	if _, err := p.VerifyNotify(context.Background(), nil, []byte(values.Encode())); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("篡改金额后应返回ErrInvalidSignature，实际: %v", err)
+	}
+}
+
+// TestAlipayQuerySignature 测试支付宝同步响应验签，成功响应缺少签名或签名不符时不能当作支付结果
+func TestAlipayQuerySignature(t *testing.T) {
+	privateKey, publicKey := testKeyPair(t)
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+	p, err := NewAlipayProvider(config.AlipayConfig{AppId: "2021000000", PrivateKey: privateKey, PublicKey: publicKey, Gateway: server.URL})
+	if err != nil {
+		t.Fatalf("创建支付宝渠道失败: %v", err)
+	}
+
+	node := `{"code":"10000","msg":"Success","out_trade_no":"SN001","trade_no":"2024010122001","trade_status":"TRADE_SUCCESS","total_amount":"88.80","send_pay_date":"2024-01-01 12:00:00"}`
+	sign, err := signSHA256WithRSA(p.privateKey, node)
+	if err != nil {
+		t.Fatalf("签名失败: %v", err)
+	}
+
+	body = `{"alipay_trade_query_response":` + node + `,"sign":"` + sign + `"}`
+	result, err := p.QueryPayment(context.Background(), "SN001")
+	if err != nil {
+		t.Fatalf("查询支付结果失败: %v", err)
+	}
+	if result.Status != TradeStatusSuccess || result.AmountCents != 8880 {
+		t.Errorf("查询结果解析错误: %+v", result)
+	}
+
+	body = `{"alipay_trade_query_response":` + node + `}`
+	if _, err := p.QueryPayment(context.Background(), "SN001"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("成功响应缺少签名时应返回ErrInvalidSignature，实际: %v", err)
+	}
+
+	tampered := `{"code":"10000","msg":"Success","out_trade_no":"SN001","trade_no":"2024010122001","trade_status":"TRADE_SUCCESS","total_amount":"0.01","send_pay_date":"2024-01-01 12:00:00"}`
+	body = `{"alipay_trade_query_response":` + tampered + `,"sign":"` + sign + `"}`
+	if _, err := p.QueryPayment(context.Background(), "SN001"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("篡改响应后应返回ErrInvalidSignature，实际: %v", err)
+	}
+
+	// 网关层错误不带签名，按接口错误返回
+	body = `{"error_response":{"code":"40002","msg":"Invalid Arguments","sub_code":"isv.invalid-app-id","sub_msg":"无效的AppID参数"}}`
+	if _, err := p.QueryPayment(context.Background(), "SN001"); err == nil || errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("未签名的错误响应应返回接口错误，实际: %v", err)
+	}
+}
+
+// TestAlipayRefundStatus 测试支付宝退款结果，只有资金变化或查询到退款成功时才算退款到账
+func TestAlipayRefundStatus(t *testing.T) {
+	privateKey, publicKey := testKeyPair(t)
+	var method, node string
+	var p *AlipayProvider
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sign, err := signSHA256WithRSA(p.privateKey, node)
+		if err != nil {
+			t.Errorf("签名失败: %v", err)
+		}
+		w.Write([]byte(`{"` + method + `_response":` + node + `,"sign":"` + sign + `"}`))
+	}))
+	defer server.Close()
+	p, err := NewAlipayProvider(config.AlipayConfig{AppId: "2021000000", PrivateKey: privateKey, PublicKey: publicKey, Gateway: server.URL})
+	if err != nil {
+		t.Fatalf("创建支付宝渠道失败: %v", err)
+	}
+	ctx := context.Background()
+	req := &RefundRequest{OutTradeNo: "SN001", OutRefundNo: "R1", RefundCents: 8880}
+
+	method = "alipay_trade_refund"
+	cases := map[string]string{"Y": RefundStatusSuccess, "N": RefundStatusProcessing}
+	for fundChange, want := range cases {
+		node = `{"code":"10000","msg":"Success","trade_no":"2024010122001","fund_change":"` + fundChange + `","refund_fee":"88.80"}`
+		result, err := p.Refund(ctx, req)
+		if err != nil {
+			t.Fatalf("退款失败: %v", err)
+		}
+		if result.Status != want {
+			t.Errorf("fund_change为%s时退款状态期望 %s，实际 %s", fundChange, want, result.Status)
+		}
+	}
+
+	method = "alipay_trade_fastpay_refund_query"
+	node = `{"code":"10000","msg":"Success","out_trade_no":"SN001","out_request_no":"R1","refund_amount":"88.80","refund_status":"REFUND_SUCCESS"}`
+	result, err := p.QueryRefund(ctx, "SN001", "R1")
+	if err != nil {
+		t.Fatalf("查询退款失败: %v", err)
+	}
+	if result.Status != RefundStatusSuccess || result.RefundCents != 8880 {
+		t.Errorf("查询退款结果解析错误: %+v", result)
+	}
+
+	node = `{"code":"10000","msg":"Success","out_trade_no":"SN001","out_request_no":"R1","refund_amount":"88.80"}`
+	if result, err := p.QueryRefund(ctx, "SN001", "R1"); err != nil || result.Status != RefundStatusProcessing {
+		t.Errorf("没有退款状态时应为处理中: %+v, %v", result, err)
+	}
+
+	node = `{"code":"10000","msg":"Success","out_trade_no":"SN001"}`
+	if _, err := p.QueryRefund(ctx, "SN001", "R1"); !errors.Is(err, ErrRefundNotFound) {
+		t.Errorf("退款请求不存在时应返回ErrRefundNotFound，实际: %v", err)
+	}
+}
+
+// TestWechatVerifyNotify 测试微信支付回调验签和解密
+func TestWechatVerifyNotify(t *testing.T) {
+	privateKey, publicKey := testKeyPair(t)
+	apiV3Key := "0123456789abcdef0123456789abcdef"
+	p, err := NewWechatProvider(config.WechatConfig{
+		AppId:        "wx123",
+		MchId:        "1900000001",
+		SerialNo:     "SERIAL",
+		PrivateKey:   privateKey,
+		ApiV3Key:     apiV3Key,
+		PlatformCert: publicKey,
+	})
+	if err != nil {
+		t.Fatalf("创建微信支付渠道失败: %v", err)
+	}
+
+	plaintext := `{"out_trade_no":"SN001","transaction_id":"4200001","trade_state":"SUCCESS","success_time":"2024-01-01T12:00:00+08:00","amount":{"total":8880}}`
+	block, _ := aes.NewCipher([]byte(apiV3Key))
+	gcm, _ := cipher.NewGCM(block)
+	nonce := "abcdefghijkl"
+	ciphertext := base64.StdEncoding.EncodeToString(gcm.Seal(nil, []byte(nonce), []byte(plaintext), []byte("transaction")))
+	body := []byte(`{"event_type":"TRANSACTION.SUCCESS","resource":{"algorithm":"AEAD_AES_256_GCM","ciphertext":"` +
+		ciphertext + `","associated_data":"transaction","nonce":"` + nonce + `"}}`)
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	// 测试中平台证书与商户私钥为同一密钥对
+	signature, err := signSHA256WithRSA(p.privateKey, timestamp+"\nnonce\n"+string(body)+"\n")
+	if err != nil {
+		t.Fatalf("签名失败: %v", err)
+	}
+	headers := map[string]string{
+		"wechatpay-timestamp": timestamp,
+		"Wechatpay-Nonce":     "nonce",
+		"Wechatpay-Signature": signature,
+	}
+
+	n, err := p.VerifyNotify(context.Background(), headers, body)
+	if err != nil {
+		t.Fatalf("验签或解密失败: %v", err)
+	}
+	if n.OutTradeNo != "SN001" || n.TradeNo != "4200001" || n.AmountCents != 8880 || n.Status != TradeStatusSuccess {
+		t.Errorf("回调内容解析错误: %+v", n)
+	}
+
+	// 过期的回调拒绝处理
+	p.now = func() time.Time { return time.Now().Add(time.Hour) }
+	if _, err := p.VerifyNotify(context.Background(), headers, body); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("过期回调应返回ErrInvalidSignature，实际: %v", err)
+	}
+}
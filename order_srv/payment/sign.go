@@ -0,0 +1,111 @@
+package payment
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// parsePrivateKey 解析RSA私钥，支持PEM（PKCS1/PKCS8）和不带头尾的base64格式
+func parsePrivateKey(key string) (*rsa.PrivateKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if k, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return k, nil
+	}
+	k, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("解析私钥失败: %w", err)
+	}
+	rsaKey, ok := k.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("私钥不是RSA类型")
+	}
+	return rsaKey, nil
+}
+
+// parsePublicKey 解析RSA公钥，支持公钥和证书两种格式
+func parsePublicKey(key string) (*rsa.PublicKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var pub interface{}
+	if cert, err := x509.ParseCertificate(der); err == nil {
+		pub = cert.PublicKey
+	} else if pub, err = x509.ParsePKIXPublicKey(der); err != nil {
+		if pub, err = x509.ParsePKCS1PublicKey(der); err != nil {
+			return nil, fmt.Errorf("解析公钥失败: %w", err)
+		}
+	}
+	rsaKey, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("公钥不是RSA类型")
+	}
+	return rsaKey, nil
+}
+
+func decodeKey(key string) ([]byte, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, errors.New("密钥为空")
+	}
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		return block.Bytes, nil
+	}
+	der, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("密钥格式错误: %w", err)
+	}
+	return der, nil
+}
+
+// signSHA256WithRSA 计算SHA256WithRSA签名，返回base64编码
+func signSHA256WithRSA(key *rsa.PrivateKey, content string) (string, error) {
+	hashed := sha256.Sum256([]byte(content))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// verifySHA256WithRSA 校验SHA256WithRSA签名，签名不匹配时返回ErrInvalidSignature
+func verifySHA256WithRSA(key *rsa.PublicKey, content, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	hashed := sha256.Sum256([]byte(content))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], sig); err != nil {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// nonceStr 生成随机字符串
+func nonceStr() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// headerValue 忽略大小写读取请求头
+func headerValue(headers map[string]string, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
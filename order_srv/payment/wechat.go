@@ -0,0 +1,334 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"order_srv/config"
+)
+
+const (
+	wechatBaseUrl    = "https://api.mch.weixin.qq.com"
+	wechatAuthSchema = "WECHATPAY2-SHA256-RSA2048"
+	// 回调和响应的时间戳与本地时间相差超过该值时拒绝，防止重放
+	wechatMaxClockSkew = 5 * time.Minute
+)
+
+// WechatProvider 微信支付APIv3 Native支付，回调内容使用APIv3密钥AES-GCM加密
+type WechatProvider struct {
+	cfg         config.WechatConfig
+	privateKey  *rsa.PrivateKey
+	platformKey *rsa.PublicKey
+	now         func() time.Time
+}
+
+// NewWechatProvider 创建微信支付渠道
+func NewWechatProvider(cfg config.WechatConfig) (*WechatProvider, error) {
+	if cfg.AppId == "" || cfg.MchId == "" || cfg.SerialNo == "" {
+		return nil, errors.New("微信支付app_id、mch_id和serial_no不能为空")
+	}
+	if len(cfg.ApiV3Key) != 32 {
+		return nil, errors.New("微信支付APIv3密钥必须为32位")
+	}
+	privateKey, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("微信支付商户私钥: %w", err)
+	}
+	platformKey, err := parsePublicKey(cfg.PlatformCert)
+	if err != nil {
+		return nil, fmt.Errorf("微信支付平台证书: %w", err)
+	}
+	if cfg.BaseUrl == "" {
+		cfg.BaseUrl = wechatBaseUrl
+	}
+	return &WechatProvider{cfg: cfg, privateKey: privateKey, platformKey: platformKey, now: time.Now}, nil
+}
+
+func (p *WechatProvider) Name() string {
+	return PayTypeWechat
+}
+
+// wechatAmount 微信支付金额，单位为分
+type wechatAmount struct {
+	Total    int64  `json:"total,omitempty"`
+	Refund   int64  `json:"refund,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+// wechatTransaction 支付订单信息，查询接口和回调解密后的内容格式一致
+type wechatTransaction struct {
+	OutTradeNo    string       `json:"out_trade_no"`
+	TransactionId string       `json:"transaction_id"`
+	TradeState    string       `json:"trade_state"`
+	SuccessTime   string       `json:"success_time"`
+	Amount        wechatAmount `json:"amount"`
+}
+
+func (t *wechatTransaction) status() string {
+	switch t.TradeState {
+	case "SUCCESS":
+		return TradeStatusSuccess
+	case "REFUND":
+		return TradeStatusRefunded
+	case "CLOSED", "REVOKED", "PAYERROR":
+		return TradeStatusClosed
+	default:
+		return TradeStatusPending
+	}
+}
+
+func (t *wechatTransaction) paidAt() time.Time {
+	paidAt, _ := time.Parse(time.RFC3339, t.SuccessTime)
+	return paidAt
+}
+
+func (p *WechatProvider) CreatePayment(ctx context.Context, req *CreateRequest) (*CreateResult, error) {
+	body := map[string]interface{}{
+		"appid":        p.cfg.AppId,
+		"mchid":        p.cfg.MchId,
+		"description":  req.Subject,
+		"out_trade_no": req.OutTradeNo,
+		"notify_url":   p.cfg.NotifyUrl,
+		"amount":       wechatAmount{Total: req.AmountCents, Currency: "CNY"},
+	}
+	if !req.ExpireAt.IsZero() {
+		body["time_expire"] = req.ExpireAt.Format(time.RFC3339)
+	}
+	if req.ClientIP != "" {
+		body["scene_info"] = map[string]string{"payer_client_ip": req.ClientIP}
+	}
+
+	var resp struct {
+		CodeUrl string `json:"code_url"`
+	}
+	if _, err := p.call(ctx, http.MethodPost, "/v3/pay/transactions/native", body, &resp); err != nil {
+		return nil, err
+	}
+	return &CreateResult{PayUrl: resp.CodeUrl}, nil
+}
+
+func (p *WechatProvider) VerifyNotify(ctx context.Context, headers map[string]string, body []byte) (*Notification, error) {
+	if err := p.verifySignature(headers, body); err != nil {
+		return nil, err
+	}
+
+	var notify struct {
+		EventType string `json:"event_type"`
+		Resource  struct {
+			Algorithm      string `json:"algorithm"`
+			Ciphertext     string `json:"ciphertext"`
+			AssociatedData string `json:"associated_data"`
+			Nonce          string `json:"nonce"`
+		} `json:"resource"`
+	}
+	if err := json.Unmarshal(body, &notify); err != nil {
+		return nil, fmt.Errorf("解析回调内容失败: %w", err)
+	}
+	if notify.Resource.Algorithm != "AEAD_AES_256_GCM" {
+		return nil, fmt.Errorf("不支持的回调加密算法: %s", notify.Resource.Algorithm)
+	}
+	plaintext, err := p.decrypt(notify.Resource.Ciphertext, notify.Resource.Nonce, notify.Resource.AssociatedData)
+	if err != nil {
+		return nil, err
+	}
+
+	var tx wechatTransaction
+	if err := json.Unmarshal(plaintext, &tx); err != nil {
+		return nil, fmt.Errorf("解析回调资源失败: %w", err)
+	}
+	return &Notification{
+		OutTradeNo:  tx.OutTradeNo,
+		TradeNo:     tx.TransactionId,
+		AmountCents: tx.Amount.Total,
+		Status:      tx.status(),
+		PaidAt:      tx.paidAt(),
+		Raw:         string(plaintext),
+	}, nil
+}
+
+func (p *WechatProvider) NotifyReply(success bool) string {
+	if success {
+		return `{"code":"SUCCESS","message":"成功"}`
+	}
+	return `{"code":"FAIL","message":"失败"}`
+}
+
+func (p *WechatProvider) QueryPayment(ctx context.Context, outTradeNo string) (*QueryResult, error) {
+	path := "/v3/pay/transactions/out-trade-no/" + url.PathEscape(outTradeNo) + "?mchid=" + url.QueryEscape(p.cfg.MchId)
+	var tx wechatTransaction
+	code, err := p.call(ctx, http.MethodGet, path, nil, &tx)
+	if err != nil {
+		if code == http.StatusNotFound {
+			return nil, ErrTradeNotFound
+		}
+		return nil, err
+	}
+	return &QueryResult{
+		OutTradeNo:  tx.OutTradeNo,
+		TradeNo:     tx.TransactionId,
+		AmountCents: tx.Amount.Total,
+		Status:      tx.status(),
+		PaidAt:      tx.paidAt(),
+	}, nil
+}
+
+func (p *WechatProvider) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	body := map[string]interface{}{
+		"out_trade_no":  req.OutTradeNo,
+		"out_refund_no": req.OutRefundNo,
+		"reason":        req.Reason,
+		"amount":        wechatAmount{Refund: req.RefundCents, Total: req.TotalCents, Currency: "CNY"},
+	}
+	var resp wechatRefund
+	if _, err := p.call(ctx, http.MethodPost, "/v3/refund/domestic/refunds", body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.result(), nil
+}
+
+func (p *WechatProvider) QueryRefund(ctx context.Context, outTradeNo, outRefundNo string) (*RefundResult, error) {
+	var resp wechatRefund
+	code, err := p.call(ctx, http.MethodGet, "/v3/refund/domestic/refunds/"+url.PathEscape(outRefundNo), nil, &resp)
+	if err != nil {
+		if code == http.StatusNotFound {
+			return nil, ErrRefundNotFound
+		}
+		return nil, err
+	}
+	return resp.result(), nil
+}
+
+// wechatRefund 退款单信息，申请退款和查询退款接口返回的格式一致
+type wechatRefund struct {
+	RefundId string       `json:"refund_id"`
+	Status   string       `json:"status"`
+	Amount   wechatAmount `json:"amount"`
+}
+
+// result 微信退款状态SUCCESS、CLOSED、PROCESSING、ABNORMAL与统一的退款状态一致
+func (r *wechatRefund) result() *RefundResult {
+	return &RefundResult{
+		RefundNo:    r.RefundId,
+		RefundCents: r.Amount.Refund,
+		Status:      r.Status,
+	}
+}
+
+// call 调用微信支付接口，校验应答签名后解析到out，返回HTTP状态码
+func (p *WechatProvider) call(ctx context.Context, method, path string, body interface{}, out interface{}) (int, error) {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return 0, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.cfg.BaseUrl+path, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	auth, err := p.authorization(method, path, payload)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("请求微信支付失败: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, fmt.Errorf("读取微信支付响应失败: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var e struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(respBody, &e)
+		return resp.StatusCode, fmt.Errorf("微信支付接口错误: %d %s %s", resp.StatusCode, e.Code, e.Message)
+	}
+
+	headers := make(map[string]string, len(resp.Header))
+	for k := range resp.Header {
+		headers[k] = resp.Header.Get(k)
+	}
+	if err := p.verifySignature(headers, respBody); err != nil {
+		return resp.StatusCode, err
+	}
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("解析微信支付响应失败: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// authorization 生成请求签名，签名串为 方法\nURL\n时间戳\n随机串\n请求体\n
+func (p *WechatProvider) authorization(method, path string, body []byte) (string, error) {
+	timestamp := strconv.FormatInt(p.now().Unix(), 10)
+	nonce := nonceStr()
+	message := method + "\n" + path + "\n" + timestamp + "\n" + nonce + "\n" + string(body) + "\n"
+	signature, err := signSHA256WithRSA(p.privateKey, message)
+	if err != nil {
+		return "", fmt.Errorf("微信支付请求签名失败: %w", err)
+	}
+	return fmt.Sprintf(`%s mchid="%s",nonce_str="%s",signature="%s",timestamp="%s",serial_no="%s"`,
+		wechatAuthSchema, p.cfg.MchId, nonce, signature, timestamp, p.cfg.SerialNo), nil
+}
+
+// verifySignature 使用平台证书校验应答和回调签名，签名串为 时间戳\n随机串\n报文\n
+func (p *WechatProvider) verifySignature(headers map[string]string, body []byte) error {
+	timestamp := headerValue(headers, "Wechatpay-Timestamp")
+	nonce := headerValue(headers, "Wechatpay-Nonce")
+	signature := headerValue(headers, "Wechatpay-Signature")
+	if timestamp == "" || nonce == "" || signature == "" {
+		return ErrInvalidSignature
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if skew := p.now().Sub(time.Unix(ts, 0)); skew > wechatMaxClockSkew || skew < -wechatMaxClockSkew {
+		return ErrInvalidSignature
+	}
+	return verifySHA256WithRSA(p.platformKey, timestamp+"\n"+nonce+"\n"+string(body)+"\n", signature)
+}
+
+// decrypt 使用APIv3密钥解密回调资源
+func (p *WechatProvider) decrypt(ciphertext, nonce, associatedData string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("回调密文格式错误: %w", err)
+	}
+	block, err := aes.NewCipher([]byte(p.cfg.ApiV3Key))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, []byte(nonce), data, []byte(associatedData))
+	if err != nil {
+		return nil, fmt.Errorf("解密回调资源失败: %w", err)
+	}
+	return plaintext, nil
+}
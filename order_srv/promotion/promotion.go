@@ -0,0 +1,295 @@
+// Package promotion 订单计价：店铺促销活动、用户优惠券和运费，金额单位均为分
+package promotion
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 优惠类型
+const (
+	TypeFixed         = "FIXED"          // 立减，可设置使用门槛
+	TypePercent       = "PERCENT"        // 折扣，可设置使用门槛和最高优惠
+	TypeFullReduction = "FULL_REDUCTION" // 满减，满Threshold减Amount
+)
+
+// 适用范围
+const (
+	ScopeAll      = "ALL"      // 全部商品
+	ScopeCategory = "CATEGORY" // 指定分类
+	ScopeBrand    = "BRAND"    // 指定品牌
+)
+
+// 优惠来源
+const (
+	SourcePromotion = "PROMOTION"
+	SourceCoupon    = "COUPON"
+)
+
+// ErrCouponNotApplicable 购物车中适用的商品未达到优惠券使用条件
+var ErrCouponNotApplicable = errors.New("未达到优惠券使用条件")
+
+// Rule 优惠规则
+type Rule struct {
+	Type        string
+	Threshold   int64 // 使用门槛，适用商品金额达到该值才可优惠，0表示无门槛
+	Amount      int64 // 立减和满减的优惠金额
+	Percent     int32 // 折扣百分比，85表示85折
+	MaxDiscount int64 // 折扣的最高优惠金额，0表示不限
+	Scope       string
+	ScopeId     int32 // 分类ID或品牌ID
+}
+
+// Validate 校验规则配置
+func (r Rule) Validate() error {
+	switch r.Type {
+	case TypeFixed:
+		if r.Amount <= 0 {
+			return fmt.Errorf("立减金额必须大于0")
+		}
+	case TypePercent:
+		if r.Percent <= 0 || r.Percent >= 100 {
+			return fmt.Errorf("折扣必须在1到99之间")
+		}
+	case TypeFullReduction:
+		if r.Threshold <= 0 || r.Amount <= 0 {
+			return fmt.Errorf("满减门槛和优惠金额必须大于0")
+		}
+		if r.Amount >= r.Threshold {
+			return fmt.Errorf("满减优惠金额必须小于门槛")
+		}
+	default:
+		return fmt.Errorf("不支持的优惠类型: %s", r.Type)
+	}
+	if r.Threshold < 0 || r.MaxDiscount < 0 {
+		return fmt.Errorf("门槛和最高优惠不能为负数")
+	}
+	switch r.Scope {
+	case ScopeAll:
+	case ScopeCategory, ScopeBrand:
+		if r.ScopeId <= 0 {
+			return fmt.Errorf("指定分类或品牌时必须提供ID")
+		}
+	default:
+		return fmt.Errorf("不支持的适用范围: %s", r.Scope)
+	}
+	return nil
+}
+
+// Matches 商品是否在规则的适用范围内
+func (r Rule) Matches(item Item) bool {
+	switch r.Scope {
+	case ScopeAll:
+		return true
+	case ScopeBrand:
+		return item.BrandId == r.ScopeId
+	case ScopeCategory:
+		for _, id := range item.CategoryIds {
+			if id == r.ScopeId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Discount 适用商品金额为base时的优惠金额，未达到门槛返回0，优惠不超过base
+func (r Rule) Discount(base int64) int64 {
+	if base <= 0 || base < r.Threshold {
+		return 0
+	}
+	var d int64
+	switch r.Type {
+	case TypeFixed, TypeFullReduction:
+		d = r.Amount
+	case TypePercent:
+		d = base * int64(100-r.Percent) / 100
+		if r.MaxDiscount > 0 && d > r.MaxDiscount {
+			d = r.MaxDiscount
+		}
+	}
+	if d > base {
+		d = base
+	}
+	return d
+}
+
+// Item 参与计算的商品
+type Item struct {
+	GoodsId     int32
+	BrandId     int32
+	CategoryIds []int32
+	Price       int64 // 单价
+	Nums        int32
+	ShipFree    bool // 商品包邮，不计入运费
+}
+
+// Total 商品小计
+func (i Item) Total() int64 {
+	return i.Price * int64(i.Nums)
+}
+
+// Promotion 店铺促销活动，同一商品只参与一个活动
+type Promotion struct {
+	Id   int32
+	Name string
+	Rule
+}
+
+// Coupon 用户优惠券，可与促销活动叠加，在活动优惠后的金额上计算
+type Coupon struct {
+	Id   int32
+	Name string
+	Rule
+}
+
+// ShippingRule 运费规则，订单中有不包邮的商品时收取固定运费，不包邮商品优惠后金额达到门槛时免运费
+type ShippingRule struct {
+	Fee           int64 // 运费
+	FreeThreshold int64 // 满额包邮门槛，0表示不设门槛
+}
+
+// Discount 优惠明细
+type Discount struct {
+	Source   string
+	SourceId int32
+	Name     string
+	Amount   int64
+}
+
+// Result 计算结果
+type Result struct {
+	GoodsTotal        int64
+	PromotionDiscount int64
+	CouponDiscount    int64
+	ShippingFee       int64
+	Payable           int64
+	Discounts         []Discount
+	// ItemDiscounts 每个商品分摊的优惠金额，与输入商品顺序一致，用于按商品售后退款
+	ItemDiscounts []int64
+}
+
+// Calculate 计算商品的优惠和运费，coupon为空表示不使用优惠券
+// 促销活动每轮选择优惠最多的一个，参与的商品不再参与其他活动，直到没有可用活动
+// 优惠券不满足使用条件时返回ErrCouponNotApplicable
+func Calculate(items []Item, promotions []Promotion, coupon *Coupon, shipping ShippingRule) (*Result, error) {
+	res := &Result{ItemDiscounts: make([]int64, len(items))}
+	net := make([]int64, len(items))
+	for i, item := range items {
+		net[i] = item.Total()
+		res.GoodsTotal += net[i]
+	}
+
+	claimed := make([]bool, len(items))
+	used := make([]bool, len(promotions))
+	for {
+		best, bestDiscount := -1, int64(0)
+		var bestItems []int
+		for p := range promotions {
+			if used[p] {
+				continue
+			}
+			eligible, base := matchItems(items, net, promotions[p].Rule, claimed)
+			if d := promotions[p].Discount(base); d > bestDiscount {
+				best, bestDiscount, bestItems = p, d, eligible
+			}
+		}
+		if best < 0 {
+			break
+		}
+		used[best] = true
+		for _, i := range bestItems {
+			claimed[i] = true
+		}
+		allocate(bestItems, net, bestDiscount, res.ItemDiscounts)
+		res.PromotionDiscount += bestDiscount
+		res.Discounts = append(res.Discounts, Discount{
+			Source:   SourcePromotion,
+			SourceId: promotions[best].Id,
+			Name:     promotions[best].Name,
+			Amount:   bestDiscount,
+		})
+	}
+
+	if coupon != nil {
+		eligible, base := matchItems(items, net, coupon.Rule, nil)
+		d := coupon.Discount(base)
+		if d <= 0 {
+			return nil, ErrCouponNotApplicable
+		}
+		allocate(eligible, net, d, res.ItemDiscounts)
+		res.CouponDiscount = d
+		res.Discounts = append(res.Discounts, Discount{
+			Source:   SourceCoupon,
+			SourceId: coupon.Id,
+			Name:     coupon.Name,
+			Amount:   d,
+		})
+	}
+
+	res.ShippingFee = shippingFee(items, net, shipping)
+	res.Payable = res.GoodsTotal - res.PromotionDiscount - res.CouponDiscount + res.ShippingFee
+	return res, nil
+}
+
+// shippingFee 按优惠后的金额计算运费，全部商品包邮时不收运费
+func shippingFee(items []Item, net []int64, rule ShippingRule) int64 {
+	charged := false
+	var base int64
+	for i, item := range items {
+		if !item.ShipFree {
+			charged = true
+			base += net[i]
+		}
+	}
+	if !charged || rule.Fee <= 0 {
+		return 0
+	}
+	if rule.FreeThreshold > 0 && base >= rule.FreeThreshold {
+		return 0
+	}
+	return rule.Fee
+}
+
+// matchItems 返回适用规则且未被占用的商品及其当前金额合计
+func matchItems(items []Item, net []int64, rule Rule, claimed []bool) ([]int, int64) {
+	var eligible []int
+	var base int64
+	for i, item := range items {
+		if claimed != nil && claimed[i] {
+			continue
+		}
+		if rule.Matches(item) && net[i] > 0 {
+			eligible = append(eligible, i)
+			base += net[i]
+		}
+	}
+	return eligible, base
+}
+
+// allocate 按当前金额比例将优惠分摊到商品，取整产生的尾差逐分分给仍有余额的商品
+func allocate(indices []int, net []int64, amount int64, itemDiscounts []int64) {
+	var base int64
+	for _, i := range indices {
+		base += net[i]
+	}
+	remaining := amount
+	for _, i := range indices {
+		share := amount * net[i] / base
+		net[i] -= share
+		itemDiscounts[i] += share
+		remaining -= share
+	}
+	for remaining > 0 {
+		for _, i := range indices {
+			if remaining == 0 {
+				break
+			}
+			if net[i] > 0 {
+				net[i]--
+				itemDiscounts[i]++
+				remaining--
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package promotion
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestRuleDiscount 测试各类型优惠的门槛和封顶
+func TestRuleDiscount(t *testing.T) {
+	cases := []struct {
+		name string
+		rule Rule
+		base int64
+		want int64
+	}{
+		{"立减", Rule{Type: TypeFixed, Amount: 500}, 3000, 500},
+		{"立减不超过金额", Rule{Type: TypeFixed, Amount: 500}, 300, 300},
+		{"满减未达门槛", Rule{Type: TypeFullReduction, Threshold: 10000, Amount: 1000}, 9999, 0},
+		{"满减", Rule{Type: TypeFullReduction, Threshold: 10000, Amount: 1000}, 10000, 1000},
+		{"折扣", Rule{Type: TypePercent, Percent: 85}, 10000, 1500},
+		{"折扣封顶", Rule{Type: TypePercent, Percent: 50, MaxDiscount: 2000}, 10000, 2000},
+		{"折扣向下取整", Rule{Type: TypePercent, Percent: 90}, 999, 99},
+	}
+	for _, c := range cases {
+		if got := c.rule.Discount(c.base); got != c.want {
+			t.Errorf("%s: 期望优惠 %d，实际 %d", c.name, c.want, got)
+		}
+	}
+}
+
+// TestRuleValidate 测试规则配置校验
+func TestRuleValidate(t *testing.T) {
+	valid := []Rule{
+		{Type: TypeFixed, Amount: 100, Scope: ScopeAll},
+		{Type: TypePercent, Percent: 90, Scope: ScopeBrand, ScopeId: 1},
+		{Type: TypeFullReduction, Threshold: 1000, Amount: 100, Scope: ScopeCategory, ScopeId: 2},
+	}
+	for _, r := range valid {
+		if err := r.Validate(); err != nil {
+			t.Errorf("规则应有效: %+v，错误: %v", r, err)
+		}
+	}
+	invalid := []Rule{
+		{Type: "UNKNOWN", Scope: ScopeAll},
+		{Type: TypeFixed, Scope: ScopeAll},
+		{Type: TypePercent, Percent: 100, Scope: ScopeAll},
+		{Type: TypeFullReduction, Threshold: 100, Amount: 100, Scope: ScopeAll},
+		{Type: TypeFixed, Amount: 100, Scope: ScopeBrand},
+		{Type: TypeFixed, Amount: 100, Scope: "SHOP"},
+	}
+	for _, r := range invalid {
+		if err := r.Validate(); err == nil {
+			t.Errorf("规则应无效: %+v", r)
+		}
+	}
+}
+
+// TestCalculate 测试促销活动择优、优惠券叠加和分摊
+func TestCalculate(t *testing.T) {
+	items := []Item{
+		{GoodsId: 1, BrandId: 10, CategoryIds: []int32{100}, Price: 3000, Nums: 2},
+		{GoodsId: 2, BrandId: 20, CategoryIds: []int32{100}, Price: 4000, Nums: 1},
+		{GoodsId: 3, BrandId: 30, CategoryIds: []int32{200}, Price: 1001, Nums: 1},
+	}
+	promotions := []Promotion{
+		{Id: 1, Name: "品牌立减", Rule: Rule{Type: TypeFixed, Amount: 500, Scope: ScopeBrand, ScopeId: 10}},
+		{Id: 2, Name: "分类满减", Rule: Rule{Type: TypeFullReduction, Threshold: 10000, Amount: 1500, Scope: ScopeCategory, ScopeId: 100}},
+		{Id: 3, Name: "分类折扣", Rule: Rule{Type: TypePercent, Percent: 90, Scope: ScopeCategory, ScopeId: 200}},
+	}
+	coupon := &Coupon{Id: 9, Name: "全场券", Rule: Rule{Type: TypeFullReduction, Threshold: 8000, Amount: 1000, Scope: ScopeAll}}
+
+	res, err := Calculate(items, promotions, coupon, ShippingRule{Fee: 800, FreeThreshold: 5000})
+	if err != nil {
+		t.Fatalf("计算优惠失败: %v", err)
+	}
+	if res.GoodsTotal != 11001 {
+		t.Errorf("商品总额期望 11001，实际 %d", res.GoodsTotal)
+	}
+	// 分类满减优惠最多，占用商品1和2，品牌立减不再可用；商品3参与分类折扣
+	if res.PromotionDiscount != 1500+100 {
+		t.Errorf("活动优惠期望 1600，实际 %d", res.PromotionDiscount)
+	}
+	if len(res.Discounts) != 3 || res.Discounts[0].SourceId != 2 || res.Discounts[1].SourceId != 3 {
+		t.Errorf("优惠明细不正确: %+v", res.Discounts)
+	}
+	if res.CouponDiscount != 1000 {
+		t.Errorf("优惠券优惠期望 1000，实际 %d", res.CouponDiscount)
+	}
+	// 优惠后金额超过包邮门槛，免运费
+	if res.ShippingFee != 0 || res.Payable != 11001-1600-1000 {
+		t.Errorf("应付金额期望 8401，实际 %d，运费 %d", res.Payable, res.ShippingFee)
+	}
+	var allocated int64
+	for i, d := range res.ItemDiscounts {
+		if d > items[i].Total() {
+			t.Errorf("商品%d分摊优惠 %d 超过小计", items[i].GoodsId, d)
+		}
+		allocated += d
+	}
+	if allocated != res.PromotionDiscount+res.CouponDiscount {
+		t.Errorf("分摊合计 %d 与优惠合计不一致", allocated)
+	}
+}
+
+// TestCalculateCouponNotApplicable 测试优惠券未达到门槛
+func TestCalculateCouponNotApplicable(t *testing.T) {
+	items := []Item{{GoodsId: 1, BrandId: 10, Price: 1000, Nums: 1}}
+	coupon := &Coupon{Id: 1, Rule: Rule{Type: TypeFixed, Amount: 100, Scope: ScopeBrand, ScopeId: 20}}
+	if _, err := Calculate(items, nil, coupon, ShippingRule{}); !errors.Is(err, ErrCouponNotApplicable) {
+		t.Errorf("期望 ErrCouponNotApplicable，实际 %v", err)
+	}
+
+	res, err := Calculate(items, nil, nil, ShippingRule{})
+	if err != nil || res.Payable != 1000 || len(res.Discounts) != 0 {
+		t.Errorf("无优惠时应付金额应为商品总额，结果: %+v，错误: %v", res, err)
+	}
+}
+
+// TestAllocateRounding 测试分摊取整时尾差不超过商品金额
+func TestAllocateRounding(t *testing.T) {
+	net := []int64{1, 1, 1}
+	discounts := make([]int64, 3)
+	allocate([]int{0, 1, 2}, net, 2, discounts)
+	if discounts[0]+discounts[1]+discounts[2] != 2 {
+		t.Errorf("分摊合计不正确: %v", discounts)
+	}
+	for i, d := range discounts {
+		if d > 1 {
+			t.Errorf("商品%d分摊 %d 超过金额", i, d)
+		}
+	}
+}
+
+// TestShippingFee 测试包邮商品、满额包邮和运费计入应付金额
+func TestShippingFee(t *testing.T) {
+	rule := ShippingRule{Fee: 1000, FreeThreshold: 9900}
+	cases := []struct {
+		name  string
+		items []Item
+		want  int64
+	}{
+		{"全部包邮", []Item{{GoodsId: 1, Price: 100, Nums: 1, ShipFree: true}}, 0},
+		{"未达门槛", []Item{{GoodsId: 1, Price: 5000, Nums: 1}, {GoodsId: 2, Price: 8000, Nums: 1, ShipFree: true}}, 1000},
+		{"达到门槛", []Item{{GoodsId: 1, Price: 3300, Nums: 3}}, 0},
+	}
+	for _, c := range cases {
+		res, err := Calculate(c.items, nil, nil, rule)
+		if err != nil {
+			t.Fatalf("%s: 计算失败: %v", c.name, err)
+		}
+		if res.ShippingFee != c.want {
+			t.Errorf("%s: 运费期望 %d，实际 %d", c.name, c.want, res.ShippingFee)
+		}
+		if res.Payable != res.GoodsTotal+res.ShippingFee {
+			t.Errorf("%s: 应付金额 %d 未包含运费", c.name, res.Payable)
+		}
+	}
+
+	// 优惠后低于包邮门槛时收取运费
+	items := []Item{{GoodsId: 1, Price: 10000, Nums: 1}}
+	coupon := &Coupon{Id: 1, Rule: Rule{Type: TypeFixed, Amount: 500, Scope: ScopeAll}}
+	res, err := Calculate(items, nil, coupon, rule)
+	if err != nil {
+		t.Fatalf("计算失败: %v", err)
+	}
+	if res.ShippingFee != 1000 || res.Payable != 10000-500+1000 {
+		t.Errorf("运费期望 1000，应付期望 10500，实际运费 %d，应付 %d", res.ShippingFee, res.Payable)
+	}
+}
@@ -24,16 +24,17 @@ const (
 
 // 商品相关 message
 type GoodsFilterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pages         int32                  `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
-	PagePerNums   int32                  `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
-	BrandId       int32                  `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
-	CategoryId    int32                  `protobuf:"varint,4,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
-	Keywords      string                 `protobuf:"bytes,5,opt,name=keywords,proto3" json:"keywords,omitempty"`
-	IsHot         bool                   `protobuf:"varint,6,opt,name=isHot,proto3" json:"isHot,omitempty"`
-	IsNew         bool                   `protobuf:"varint,7,opt,name=isNew,proto3" json:"isNew,omitempty"`
-	OnSale        bool                   `protobuf:"varint,8,opt,name=onSale,proto3" json:"onSale,omitempty"`
-	IsTab         bool                   `protobuf:"varint,9,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Pages         int32                   `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int32                   `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	BrandId       int32                   `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	CategoryId    int32                   `protobuf:"varint,4,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	Keywords      string                  `protobuf:"bytes,5,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	IsHot         bool                    `protobuf:"varint,6,opt,name=isHot,proto3" json:"isHot,omitempty"`
+	IsNew         bool                    `protobuf:"varint,7,opt,name=isNew,proto3" json:"isNew,omitempty"`
+	OnSale        bool                    `protobuf:"varint,8,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	IsTab         bool                    `protobuf:"varint,9,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Attributes    []*GoodsAttributeFilter `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty"` // 属性筛选，多个条件同时满足
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +132,209 @@ func (x *GoodsFilterRequest) GetIsTab() bool {
 	return false
 }
 
+func (x *GoodsFilterRequest) GetAttributes() []*GoodsAttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GoodsAttributeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttributeId   int32                  `protobuf:"varint,1,opt,name=attributeId,proto3" json:"attributeId,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`             // 属性值，满足其一即可
+	MinValue      *float64               `protobuf:"fixed64,3,opt,name=minValue,proto3,oneof" json:"minValue,omitempty"` // 数值型属性最小值
+	MaxValue      *float64               `protobuf:"fixed64,4,opt,name=maxValue,proto3,oneof" json:"maxValue,omitempty"` // 数值型属性最大值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsAttributeFilter) Reset() {
+	*x = GoodsAttributeFilter{}
+	mi := &file_goods_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsAttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsAttributeFilter) ProtoMessage() {}
+
+func (x *GoodsAttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsAttributeFilter.ProtoReflect.Descriptor instead.
+func (*GoodsAttributeFilter) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GoodsAttributeFilter) GetAttributeId() int32 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *GoodsAttributeFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *GoodsAttributeFilter) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *GoodsAttributeFilter) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+type GoodsAttributeValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttributeId   int32                  `protobuf:"varint,1,opt,name=attributeId,proto3" json:"attributeId,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsAttributeValue) Reset() {
+	*x = GoodsAttributeValue{}
+	mi := &file_goods_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsAttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsAttributeValue) ProtoMessage() {}
+
+func (x *GoodsAttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsAttributeValue.ProtoReflect.Descriptor instead.
+func (*GoodsAttributeValue) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GoodsAttributeValue) GetAttributeId() int32 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *GoodsAttributeValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GoodsAttributeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttributeId   int32                  `protobuf:"varint,1,opt,name=attributeId,proto3" json:"attributeId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsAttributeInfo) Reset() {
+	*x = GoodsAttributeInfo{}
+	mi := &file_goods_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsAttributeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsAttributeInfo) ProtoMessage() {}
+
+func (x *GoodsAttributeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsAttributeInfo.ProtoReflect.Descriptor instead.
+func (*GoodsAttributeInfo) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GoodsAttributeInfo) GetAttributeId() int32 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *GoodsAttributeInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsAttributeInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GoodsAttributeInfo) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *GoodsAttributeInfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type GoodsListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -141,7 +345,7 @@ type GoodsListResponse struct {
 
 func (x *GoodsListResponse) Reset() {
 	*x = GoodsListResponse{}
-	mi := &file_goods_proto_msgTypes[1]
+	mi := &file_goods_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -153,7 +357,7 @@ func (x *GoodsListResponse) String() string {
 func (*GoodsListResponse) ProtoMessage() {}
 
 func (x *GoodsListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[1]
+	mi := &file_goods_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +370,7 @@ func (x *GoodsListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoodsListResponse.ProtoReflect.Descriptor instead.
 func (*GoodsListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{1}
+	return file_goods_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GoodsListResponse) GetTotal() int32 {
@@ -203,13 +407,16 @@ type GoodsInfoResponse struct {
 	ShipFree        bool                   `protobuf:"varint,16,opt,name=shipFree,proto3" json:"shipFree,omitempty"`
 	BrandId         int32                  `protobuf:"varint,17,opt,name=brandId,proto3" json:"brandId,omitempty"`
 	CategoryIds     []int32                `protobuf:"varint,18,rep,packed,name=categoryIds,proto3" json:"categoryIds,omitempty"`
+	Attributes      []*GoodsAttributeInfo  `protobuf:"bytes,19,rep,name=attributes,proto3" json:"attributes,omitempty"`    // 商品属性，仅商品详情返回
+	AvgRating       float32                `protobuf:"fixed32,20,opt,name=avgRating,proto3" json:"avgRating,omitempty"`    // 平均评分，来自用户操作服务，取不到时为0，批量查询不返回
+	RatingCount     int32                  `protobuf:"varint,21,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"` // 评价数
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GoodsInfoResponse) Reset() {
 	*x = GoodsInfoResponse{}
-	mi := &file_goods_proto_msgTypes[2]
+	mi := &file_goods_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -221,7 +428,7 @@ func (x *GoodsInfoResponse) String() string {
 func (*GoodsInfoResponse) ProtoMessage() {}
 
 func (x *GoodsInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[2]
+	mi := &file_goods_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +441,7 @@ func (x *GoodsInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoodsInfoResponse.ProtoReflect.Descriptor instead.
 func (*GoodsInfoResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{2}
+	return file_goods_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GoodsInfoResponse) GetId() int32 {
@@ -363,6 +570,27 @@ func (x *GoodsInfoResponse) GetCategoryIds() []int32 {
 	return nil
 }
 
+func (x *GoodsInfoResponse) GetAttributes() []*GoodsAttributeInfo {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetAvgRating() float32 {
+	if x != nil {
+		return x.AvgRating
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
 type CreateGoodsInfo struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -383,13 +611,14 @@ type CreateGoodsInfo struct {
 	ShipFree        bool                   `protobuf:"varint,16,opt,name=shipFree,proto3" json:"shipFree,omitempty"`
 	BrandId         int32                  `protobuf:"varint,17,opt,name=brandId,proto3" json:"brandId,omitempty"`
 	CategoryIds     []int32                `protobuf:"varint,18,rep,packed,name=categoryIds,proto3" json:"categoryIds,omitempty"`
+	Attributes      []*GoodsAttributeValue `protobuf:"bytes,19,rep,name=attributes,proto3" json:"attributes,omitempty"` // 商品属性，更新时为空表示不修改
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateGoodsInfo) Reset() {
 	*x = CreateGoodsInfo{}
-	mi := &file_goods_proto_msgTypes[3]
+	mi := &file_goods_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +630,7 @@ func (x *CreateGoodsInfo) String() string {
 func (*CreateGoodsInfo) ProtoMessage() {}
 
 func (x *CreateGoodsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[3]
+	mi := &file_goods_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +643,7 @@ func (x *CreateGoodsInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGoodsInfo.ProtoReflect.Descriptor instead.
 func (*CreateGoodsInfo) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{3}
+	return file_goods_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateGoodsInfo) GetId() int32 {
@@ -543,6 +772,13 @@ func (x *CreateGoodsInfo) GetCategoryIds() []int32 {
 	return nil
 }
 
+func (x *CreateGoodsInfo) GetAttributes() []*GoodsAttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type DeleteGoodsInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -552,7 +788,7 @@ type DeleteGoodsInfo struct {
 
 func (x *DeleteGoodsInfo) Reset() {
 	*x = DeleteGoodsInfo{}
-	mi := &file_goods_proto_msgTypes[4]
+	mi := &file_goods_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -564,7 +800,7 @@ func (x *DeleteGoodsInfo) String() string {
 func (*DeleteGoodsInfo) ProtoMessage() {}
 
 func (x *DeleteGoodsInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[4]
+	mi := &file_goods_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -577,7 +813,7 @@ func (x *DeleteGoodsInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteGoodsInfo.ProtoReflect.Descriptor instead.
 func (*DeleteGoodsInfo) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{4}
+	return file_goods_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteGoodsInfo) GetId() int32 {
@@ -596,7 +832,7 @@ type GoodInfoRequest struct {
 
 func (x *GoodInfoRequest) Reset() {
 	*x = GoodInfoRequest{}
-	mi := &file_goods_proto_msgTypes[5]
+	mi := &file_goods_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +844,7 @@ func (x *GoodInfoRequest) String() string {
 func (*GoodInfoRequest) ProtoMessage() {}
 
 func (x *GoodInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[5]
+	mi := &file_goods_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +857,7 @@ func (x *GoodInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoodInfoRequest.ProtoReflect.Descriptor instead.
 func (*GoodInfoRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{5}
+	return file_goods_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GoodInfoRequest) GetId() int32 {
@@ -640,7 +876,7 @@ type BatchGoodsIdInfo struct {
 
 func (x *BatchGoodsIdInfo) Reset() {
 	*x = BatchGoodsIdInfo{}
-	mi := &file_goods_proto_msgTypes[6]
+	mi := &file_goods_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -652,7 +888,7 @@ func (x *BatchGoodsIdInfo) String() string {
 func (*BatchGoodsIdInfo) ProtoMessage() {}
 
 func (x *BatchGoodsIdInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[6]
+	mi := &file_goods_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,7 +901,7 @@ func (x *BatchGoodsIdInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchGoodsIdInfo.ProtoReflect.Descriptor instead.
 func (*BatchGoodsIdInfo) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{6}
+	return file_goods_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BatchGoodsIdInfo) GetId() []int32 {
@@ -686,7 +922,7 @@ type CategoryListRequest struct {
 
 func (x *CategoryListRequest) Reset() {
 	*x = CategoryListRequest{}
-	mi := &file_goods_proto_msgTypes[7]
+	mi := &file_goods_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +934,7 @@ func (x *CategoryListRequest) String() string {
 func (*CategoryListRequest) ProtoMessage() {}
 
 func (x *CategoryListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[7]
+	mi := &file_goods_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +947,7 @@ func (x *CategoryListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryListRequest.ProtoReflect.Descriptor instead.
 func (*CategoryListRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{7}
+	return file_goods_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CategoryListRequest) GetId() int32 {
@@ -741,7 +977,7 @@ type CategoryInfoRequest struct {
 
 func (x *CategoryInfoRequest) Reset() {
 	*x = CategoryInfoRequest{}
-	mi := &file_goods_proto_msgTypes[8]
+	mi := &file_goods_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -753,7 +989,7 @@ func (x *CategoryInfoRequest) String() string {
 func (*CategoryInfoRequest) ProtoMessage() {}
 
 func (x *CategoryInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[8]
+	mi := &file_goods_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +1002,7 @@ func (x *CategoryInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryInfoRequest.ProtoReflect.Descriptor instead.
 func (*CategoryInfoRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{8}
+	return file_goods_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CategoryInfoRequest) GetId() int32 {
@@ -813,7 +1049,7 @@ type DeleteCategoryRequest struct {
 
 func (x *DeleteCategoryRequest) Reset() {
 	*x = DeleteCategoryRequest{}
-	mi := &file_goods_proto_msgTypes[9]
+	mi := &file_goods_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -825,7 +1061,7 @@ func (x *DeleteCategoryRequest) String() string {
 func (*DeleteCategoryRequest) ProtoMessage() {}
 
 func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[9]
+	mi := &file_goods_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1074,7 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{9}
+	return file_goods_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteCategoryRequest) GetId() int32 {
@@ -861,7 +1097,7 @@ type CategoryInfoResponse struct {
 
 func (x *CategoryInfoResponse) Reset() {
 	*x = CategoryInfoResponse{}
-	mi := &file_goods_proto_msgTypes[10]
+	mi := &file_goods_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -873,7 +1109,7 @@ func (x *CategoryInfoResponse) String() string {
 func (*CategoryInfoResponse) ProtoMessage() {}
 
 func (x *CategoryInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[10]
+	mi := &file_goods_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1122,7 @@ func (x *CategoryInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryInfoResponse.ProtoReflect.Descriptor instead.
 func (*CategoryInfoResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{10}
+	return file_goods_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CategoryInfoResponse) GetId() int32 {
@@ -935,7 +1171,7 @@ type CategoryListResponse struct {
 
 func (x *CategoryListResponse) Reset() {
 	*x = CategoryListResponse{}
-	mi := &file_goods_proto_msgTypes[11]
+	mi := &file_goods_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1183,7 @@ func (x *CategoryListResponse) String() string {
 func (*CategoryListResponse) ProtoMessage() {}
 
 func (x *CategoryListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[11]
+	mi := &file_goods_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1196,7 @@ func (x *CategoryListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryListResponse.ProtoReflect.Descriptor instead.
 func (*CategoryListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{11}
+	return file_goods_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CategoryListResponse) GetTotal() int32 {
@@ -995,7 +1231,7 @@ type SubCategoryListResponse struct {
 
 func (x *SubCategoryListResponse) Reset() {
 	*x = SubCategoryListResponse{}
-	mi := &file_goods_proto_msgTypes[12]
+	mi := &file_goods_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1007,7 +1243,7 @@ func (x *SubCategoryListResponse) String() string {
 func (*SubCategoryListResponse) ProtoMessage() {}
 
 func (x *SubCategoryListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[12]
+	mi := &file_goods_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1256,7 @@ func (x *SubCategoryListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubCategoryListResponse.ProtoReflect.Descriptor instead.
 func (*SubCategoryListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{12}
+	return file_goods_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SubCategoryListResponse) GetTotal() int32 {
@@ -1045,31 +1281,29 @@ func (x *SubCategoryListResponse) GetSubCategories() []*CategoryInfoResponse {
 }
 
 // 品牌相关 message
-type BrandFilterRequest struct {
+// 分类属性模板相关 message
+type CategoryAttributeFilterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pages         int32                  `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
-	PagePerNums   int32                  `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // 品牌名称，支持模糊查询
-	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"` // 品牌描述，支持模糊查询
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrandFilterRequest) Reset() {
-	*x = BrandFilterRequest{}
-	mi := &file_goods_proto_msgTypes[13]
+func (x *CategoryAttributeFilterRequest) Reset() {
+	*x = CategoryAttributeFilterRequest{}
+	mi := &file_goods_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrandFilterRequest) String() string {
+func (x *CategoryAttributeFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrandFilterRequest) ProtoMessage() {}
+func (*CategoryAttributeFilterRequest) ProtoMessage() {}
 
-func (x *BrandFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[13]
+func (x *CategoryAttributeFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,9 +1314,310 @@ func (x *BrandFilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrandFilterRequest.ProtoReflect.Descriptor instead.
-func (*BrandFilterRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CategoryAttributeFilterRequest.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeFilterRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CategoryAttributeFilterRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type CategoryAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // text、number、enum、bool
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"` // 可选值，enum类型必填
+	Required      bool                   `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	Sort          int32                  `protobuf:"varint,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttributeRequest) Reset() {
+	*x = CategoryAttributeRequest{}
+	mi := &file_goods_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributeRequest) ProtoMessage() {}
+
+func (x *CategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CategoryAttributeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryAttributeRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryAttributeRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CategoryAttributeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CategoryAttributeRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CategoryAttributeRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CategoryAttributeRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type CategoryAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Options       []string               `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	Required      bool                   `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	Sort          int32                  `protobuf:"varint,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttributeResponse) Reset() {
+	*x = CategoryAttributeResponse{}
+	mi := &file_goods_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributeResponse) ProtoMessage() {}
+
+func (x *CategoryAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributeResponse.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CategoryAttributeResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryAttributeResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryAttributeResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryAttributeResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CategoryAttributeResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CategoryAttributeResponse) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CategoryAttributeResponse) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CategoryAttributeResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type CategoryAttributeListResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Total         int32                        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*CategoryAttributeResponse `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryAttributeListResponse) Reset() {
+	*x = CategoryAttributeListResponse{}
+	mi := &file_goods_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAttributeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAttributeListResponse) ProtoMessage() {}
+
+func (x *CategoryAttributeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAttributeListResponse.ProtoReflect.Descriptor instead.
+func (*CategoryAttributeListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CategoryAttributeListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CategoryAttributeListResponse) GetData() []*CategoryAttributeResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BrandFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         int32                  `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int32                  `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`          // 品牌名称，支持模糊查询
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`          // 品牌描述，支持模糊查询
+	SortBy        string                 `protobuf:"bytes,5,opt,name=sortBy,proto3" json:"sortBy,omitempty"`      // 排序字段：id、name、goods_num、created_at，默认id
+	SortDesc      bool                   `protobuf:"varint,6,opt,name=sortDesc,proto3" json:"sortDesc,omitempty"` // 是否倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandFilterRequest) Reset() {
+	*x = BrandFilterRequest{}
+	mi := &file_goods_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandFilterRequest) ProtoMessage() {}
+
+func (x *BrandFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandFilterRequest.ProtoReflect.Descriptor instead.
+func (*BrandFilterRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BrandFilterRequest) GetPages() int32 {
@@ -1106,38 +1641,536 @@ func (x *BrandFilterRequest) GetName() string {
 	return ""
 }
 
-func (x *BrandFilterRequest) GetDesc() string {
-	if x != nil {
-		return x.Desc
+func (x *BrandFilterRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *BrandFilterRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *BrandFilterRequest) GetSortDesc() bool {
+	if x != nil {
+		return x.SortDesc
+	}
+	return false
+}
+
+type BrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandRequest) Reset() {
+	*x = BrandRequest{}
+	mi := &file_goods_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandRequest) ProtoMessage() {}
+
+func (x *BrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandRequest.ProtoReflect.Descriptor instead.
+func (*BrandRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BrandRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BrandRequest) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BrandRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type BrandInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	GoodsNum      int32                  `protobuf:"varint,5,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"` // 在售商品数，仅品牌列表返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandInfoResponse) Reset() {
+	*x = BrandInfoResponse{}
+	mi := &file_goods_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandInfoResponse) ProtoMessage() {}
+
+func (x *BrandInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandInfoResponse.ProtoReflect.Descriptor instead.
+func (*BrandInfoResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BrandInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrandInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BrandInfoResponse) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BrandInfoResponse) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *BrandInfoResponse) GetGoodsNum() int32 {
+	if x != nil {
+		return x.GoodsNum
+	}
+	return 0
+}
+
+type BrandListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*BrandInfoResponse   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandListResponse) Reset() {
+	*x = BrandListResponse{}
+	mi := &file_goods_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandListResponse) ProtoMessage() {}
+
+func (x *BrandListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandListResponse.ProtoReflect.Descriptor instead.
+func (*BrandListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BrandListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BrandListResponse) GetData() []*BrandInfoResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MergeBrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      int32                  `protobuf:"varint,1,opt,name=sourceId,proto3" json:"sourceId,omitempty"` // 被合并的品牌ID，合并后删除
+	TargetId      int32                  `protobuf:"varint,2,opt,name=targetId,proto3" json:"targetId,omitempty"` // 保留的品牌ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeBrandRequest) Reset() {
+	*x = MergeBrandRequest{}
+	mi := &file_goods_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeBrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeBrandRequest) ProtoMessage() {}
+
+func (x *MergeBrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeBrandRequest.ProtoReflect.Descriptor instead.
+func (*MergeBrandRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MergeBrandRequest) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *MergeBrandRequest) GetTargetId() int32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type MergeBrandResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GoodsNum         int32                  `protobuf:"varint,1,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`                 // 迁移的商品数
+	CategoryBrandNum int32                  `protobuf:"varint,2,opt,name=categoryBrandNum,proto3" json:"categoryBrandNum,omitempty"` // 迁移的分类品牌关联数
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MergeBrandResponse) Reset() {
+	*x = MergeBrandResponse{}
+	mi := &file_goods_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeBrandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeBrandResponse) ProtoMessage() {}
+
+func (x *MergeBrandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeBrandResponse.ProtoReflect.Descriptor instead.
+func (*MergeBrandResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MergeBrandResponse) GetGoodsNum() int32 {
+	if x != nil {
+		return x.GoodsNum
+	}
+	return 0
+}
+
+func (x *MergeBrandResponse) GetCategoryBrandNum() int32 {
+	if x != nil {
+		return x.CategoryBrandNum
+	}
+	return 0
+}
+
+// 轮播图相关 message
+type BannerFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     string                 `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`    // 投放位置，为空时默认home
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"` // 当前分类页的分类ID，0表示不限
+	BrandId       int32                  `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`       // 当前品牌页的品牌ID，0表示不限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BannerFilterRequest) Reset() {
+	*x = BannerFilterRequest{}
+	mi := &file_goods_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BannerFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BannerFilterRequest) ProtoMessage() {}
+
+func (x *BannerFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BannerFilterRequest.ProtoReflect.Descriptor instead.
+func (*BannerFilterRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BannerFilterRequest) GetPlacement() string {
+	if x != nil {
+		return x.Placement
+	}
+	return ""
+}
+
+func (x *BannerFilterRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BannerFilterRequest) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+type BannerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Image string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Url   string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Index int32                  `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// 以下字段更新时不传保持不变，传0或空串表示清除
+	Placement     *string `protobuf:"bytes,5,opt,name=placement,proto3,oneof" json:"placement,omitempty"`    // 投放位置：home、category等，为空时默认home
+	CategoryId    *int32  `protobuf:"varint,6,opt,name=categoryId,proto3,oneof" json:"categoryId,omitempty"` // 定向分类ID，0表示不定向
+	BrandId       *int32  `protobuf:"varint,7,opt,name=brandId,proto3,oneof" json:"brandId,omitempty"`       // 定向品牌ID，0表示不定向
+	StartTime     *int64  `protobuf:"varint,8,opt,name=startTime,proto3,oneof" json:"startTime,omitempty"`   // 开始时间（Unix秒），0表示不限
+	EndTime       *int64  `protobuf:"varint,9,opt,name=endTime,proto3,oneof" json:"endTime,omitempty"`       // 结束时间（Unix秒），0表示不限
+	Enabled       *bool   `protobuf:"varint,10,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`      // 是否启用，新建时不传默认启用，更新时不传保持不变
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BannerRequest) Reset() {
+	*x = BannerRequest{}
+	mi := &file_goods_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BannerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BannerRequest) ProtoMessage() {}
+
+func (x *BannerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BannerRequest.ProtoReflect.Descriptor instead.
+func (*BannerRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BannerRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BannerRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *BannerRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BannerRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BannerRequest) GetPlacement() string {
+	if x != nil && x.Placement != nil {
+		return *x.Placement
+	}
+	return ""
+}
+
+func (x *BannerRequest) GetCategoryId() int32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *BannerRequest) GetBrandId() int32 {
+	if x != nil && x.BrandId != nil {
+		return *x.BrandId
+	}
+	return 0
+}
+
+func (x *BannerRequest) GetStartTime() int64 {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return 0
+}
+
+func (x *BannerRequest) GetEndTime() int64 {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return 0
+}
+
+func (x *BannerRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
 	}
-	return ""
+	return false
 }
 
-type BrandRequest struct {
+type BannerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
-	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Index         int32                  `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Placement     string                 `protobuf:"bytes,5,opt,name=placement,proto3" json:"placement,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,6,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId       int32                  `protobuf:"varint,7,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	StartTime     int64                  `protobuf:"varint,8,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       int64                  `protobuf:"varint,9,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Enabled       bool                   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrandRequest) Reset() {
-	*x = BrandRequest{}
-	mi := &file_goods_proto_msgTypes[14]
+func (x *BannerResponse) Reset() {
+	*x = BannerResponse{}
+	mi := &file_goods_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrandRequest) String() string {
+func (x *BannerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrandRequest) ProtoMessage() {}
+func (*BannerResponse) ProtoMessage() {}
 
-func (x *BrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[14]
+func (x *BannerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,64 +2181,104 @@ func (x *BrandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrandRequest.ProtoReflect.Descriptor instead.
-func (*BrandRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use BannerResponse.ProtoReflect.Descriptor instead.
+func (*BannerResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *BrandRequest) GetId() int32 {
+func (x *BannerResponse) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *BrandRequest) GetName() string {
+func (x *BannerResponse) GetImage() string {
 	if x != nil {
-		return x.Name
+		return x.Image
 	}
 	return ""
 }
 
-func (x *BrandRequest) GetLogo() string {
+func (x *BannerResponse) GetUrl() string {
 	if x != nil {
-		return x.Logo
+		return x.Url
 	}
 	return ""
 }
 
-func (x *BrandRequest) GetDesc() string {
+func (x *BannerResponse) GetIndex() int32 {
 	if x != nil {
-		return x.Desc
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BannerResponse) GetPlacement() string {
+	if x != nil {
+		return x.Placement
 	}
 	return ""
 }
 
-type BrandInfoResponse struct {
+func (x *BannerResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BannerResponse) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *BannerResponse) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *BannerResponse) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *BannerResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type BannerListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
-	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*BannerResponse      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrandInfoResponse) Reset() {
-	*x = BrandInfoResponse{}
-	mi := &file_goods_proto_msgTypes[15]
+func (x *BannerListResponse) Reset() {
+	*x = BannerListResponse{}
+	mi := &file_goods_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrandInfoResponse) String() string {
+func (x *BannerListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrandInfoResponse) ProtoMessage() {}
+func (*BannerListResponse) ProtoMessage() {}
 
-func (x *BrandInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[15]
+func (x *BannerListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,62 +2289,47 @@ func (x *BrandInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrandInfoResponse.ProtoReflect.Descriptor instead.
-func (*BrandInfoResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use BannerListResponse.ProtoReflect.Descriptor instead.
+func (*BannerListResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *BrandInfoResponse) GetId() int32 {
+func (x *BannerListResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
 	return 0
 }
 
-func (x *BrandInfoResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *BrandInfoResponse) GetLogo() string {
-	if x != nil {
-		return x.Logo
-	}
-	return ""
-}
-
-func (x *BrandInfoResponse) GetDesc() string {
+func (x *BannerListResponse) GetData() []*BannerResponse {
 	if x != nil {
-		return x.Desc
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type BrandListResponse struct {
+type BannerClickRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Data          []*BrandInfoResponse   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 轮播图ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrandListResponse) Reset() {
-	*x = BrandListResponse{}
-	mi := &file_goods_proto_msgTypes[16]
+func (x *BannerClickRequest) Reset() {
+	*x = BannerClickRequest{}
+	mi := &file_goods_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrandListResponse) String() string {
+func (x *BannerClickRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrandListResponse) ProtoMessage() {}
+func (*BannerClickRequest) ProtoMessage() {}
 
-func (x *BrandListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[16]
+func (x *BannerClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,51 +2340,43 @@ func (x *BrandListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrandListResponse.ProtoReflect.Descriptor instead.
-func (*BrandListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use BannerClickRequest.ProtoReflect.Descriptor instead.
+func (*BannerClickRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BrandListResponse) GetTotal() int32 {
+func (x *BannerClickRequest) GetId() int32 {
 	if x != nil {
-		return x.Total
+		return x.Id
 	}
 	return 0
 }
 
-func (x *BrandListResponse) GetData() []*BrandInfoResponse {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-// 轮播图相关 message
-type BannerRequest struct {
+type BannerClickStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Index         int32                  `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Ids           []int32                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`      // 轮播图ID，为空时统计全部
+	Placement     string                 `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`  // 投放位置，为空时不限
+	StartTime     int64                  `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"` // 统计开始时间（Unix秒），0表示不限
+	EndTime       int64                  `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`     // 统计结束时间（Unix秒），0表示不限
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BannerRequest) Reset() {
-	*x = BannerRequest{}
-	mi := &file_goods_proto_msgTypes[17]
+func (x *BannerClickStatsRequest) Reset() {
+	*x = BannerClickStatsRequest{}
+	mi := &file_goods_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BannerRequest) String() string {
+func (x *BannerClickStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BannerRequest) ProtoMessage() {}
+func (*BannerClickStatsRequest) ProtoMessage() {}
 
-func (x *BannerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[17]
+func (x *BannerClickStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1337,64 +2387,63 @@ func (x *BannerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BannerRequest.ProtoReflect.Descriptor instead.
-func (*BannerRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use BannerClickStatsRequest.ProtoReflect.Descriptor instead.
+func (*BannerClickStatsRequest) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *BannerRequest) GetId() int32 {
+func (x *BannerClickStatsRequest) GetIds() []int32 {
 	if x != nil {
-		return x.Id
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-func (x *BannerRequest) GetImage() string {
+func (x *BannerClickStatsRequest) GetPlacement() string {
 	if x != nil {
-		return x.Image
+		return x.Placement
 	}
 	return ""
 }
 
-func (x *BannerRequest) GetUrl() string {
+func (x *BannerClickStatsRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.Url
+		return x.StartTime
 	}
-	return ""
+	return 0
 }
 
-func (x *BannerRequest) GetIndex() int32 {
+func (x *BannerClickStatsRequest) GetEndTime() int64 {
 	if x != nil {
-		return x.Index
+		return x.EndTime
 	}
 	return 0
 }
 
-type BannerResponse struct {
+type BannerClickStat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Index         int32                  `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	BannerId      int32                  `protobuf:"varint,1,opt,name=bannerId,proto3" json:"bannerId,omitempty"`
+	Placement     string                 `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	ClickNum      int64                  `protobuf:"varint,3,opt,name=clickNum,proto3" json:"clickNum,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BannerResponse) Reset() {
-	*x = BannerResponse{}
-	mi := &file_goods_proto_msgTypes[18]
+func (x *BannerClickStat) Reset() {
+	*x = BannerClickStat{}
+	mi := &file_goods_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BannerResponse) String() string {
+func (x *BannerClickStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BannerResponse) ProtoMessage() {}
+func (*BannerClickStat) ProtoMessage() {}
 
-func (x *BannerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[18]
+func (x *BannerClickStat) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,62 +2454,55 @@ func (x *BannerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BannerResponse.ProtoReflect.Descriptor instead.
-func (*BannerResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BannerClickStat.ProtoReflect.Descriptor instead.
+func (*BannerClickStat) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *BannerResponse) GetId() int32 {
+func (x *BannerClickStat) GetBannerId() int32 {
 	if x != nil {
-		return x.Id
+		return x.BannerId
 	}
 	return 0
 }
 
-func (x *BannerResponse) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
-}
-
-func (x *BannerResponse) GetUrl() string {
+func (x *BannerClickStat) GetPlacement() string {
 	if x != nil {
-		return x.Url
+		return x.Placement
 	}
 	return ""
 }
 
-func (x *BannerResponse) GetIndex() int32 {
+func (x *BannerClickStat) GetClickNum() int64 {
 	if x != nil {
-		return x.Index
+		return x.ClickNum
 	}
 	return 0
 }
 
-type BannerListResponse struct {
+type BannerClickStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Data          []*BannerResponse      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Data          []*BannerClickStat     `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BannerListResponse) Reset() {
-	*x = BannerListResponse{}
-	mi := &file_goods_proto_msgTypes[19]
+func (x *BannerClickStatsResponse) Reset() {
+	*x = BannerClickStatsResponse{}
+	mi := &file_goods_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BannerListResponse) String() string {
+func (x *BannerClickStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BannerListResponse) ProtoMessage() {}
+func (*BannerClickStatsResponse) ProtoMessage() {}
 
-func (x *BannerListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[19]
+func (x *BannerClickStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goods_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,19 +2513,19 @@ func (x *BannerListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BannerListResponse.ProtoReflect.Descriptor instead.
-func (*BannerListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use BannerClickStatsResponse.ProtoReflect.Descriptor instead.
+func (*BannerClickStatsResponse) Descriptor() ([]byte, []int) {
+	return file_goods_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *BannerListResponse) GetTotal() int32 {
+func (x *BannerClickStatsResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *BannerListResponse) GetData() []*BannerResponse {
+func (x *BannerClickStatsResponse) GetData() []*BannerClickStat {
 	if x != nil {
 		return x.Data
 	}
@@ -1501,7 +2543,7 @@ type CategoryBrandFilterRequest struct {
 
 func (x *CategoryBrandFilterRequest) Reset() {
 	*x = CategoryBrandFilterRequest{}
-	mi := &file_goods_proto_msgTypes[20]
+	mi := &file_goods_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1513,7 +2555,7 @@ func (x *CategoryBrandFilterRequest) String() string {
 func (*CategoryBrandFilterRequest) ProtoMessage() {}
 
 func (x *CategoryBrandFilterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[20]
+	mi := &file_goods_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +2568,7 @@ func (x *CategoryBrandFilterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandFilterRequest.ProtoReflect.Descriptor instead.
 func (*CategoryBrandFilterRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{20}
+	return file_goods_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CategoryBrandFilterRequest) GetPages() int32 {
@@ -1554,7 +2596,7 @@ type CategoryBrandRequest struct {
 
 func (x *CategoryBrandRequest) Reset() {
 	*x = CategoryBrandRequest{}
-	mi := &file_goods_proto_msgTypes[21]
+	mi := &file_goods_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1566,7 +2608,7 @@ func (x *CategoryBrandRequest) String() string {
 func (*CategoryBrandRequest) ProtoMessage() {}
 
 func (x *CategoryBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[21]
+	mi := &file_goods_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,7 +2621,7 @@ func (x *CategoryBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandRequest.ProtoReflect.Descriptor instead.
 func (*CategoryBrandRequest) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{21}
+	return file_goods_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CategoryBrandRequest) GetId() int32 {
@@ -1614,7 +2656,7 @@ type CategoryBrandResponse struct {
 
 func (x *CategoryBrandResponse) Reset() {
 	*x = CategoryBrandResponse{}
-	mi := &file_goods_proto_msgTypes[22]
+	mi := &file_goods_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1626,7 +2668,7 @@ func (x *CategoryBrandResponse) String() string {
 func (*CategoryBrandResponse) ProtoMessage() {}
 
 func (x *CategoryBrandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[22]
+	mi := &file_goods_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1639,7 +2681,7 @@ func (x *CategoryBrandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandResponse.ProtoReflect.Descriptor instead.
 func (*CategoryBrandResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{22}
+	return file_goods_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CategoryBrandResponse) GetId() int32 {
@@ -1673,7 +2715,7 @@ type CategoryBrandListResponse struct {
 
 func (x *CategoryBrandListResponse) Reset() {
 	*x = CategoryBrandListResponse{}
-	mi := &file_goods_proto_msgTypes[23]
+	mi := &file_goods_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1685,7 +2727,7 @@ func (x *CategoryBrandListResponse) String() string {
 func (*CategoryBrandListResponse) ProtoMessage() {}
 
 func (x *CategoryBrandListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goods_proto_msgTypes[23]
+	mi := &file_goods_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,7 +2740,7 @@ func (x *CategoryBrandListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategoryBrandListResponse.ProtoReflect.Descriptor instead.
 func (*CategoryBrandListResponse) Descriptor() ([]byte, []int) {
-	return file_goods_proto_rawDescGZIP(), []int{23}
+	return file_goods_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CategoryBrandListResponse) GetTotal() int32 {
@@ -1719,7 +2761,7 @@ var File_goods_proto protoreflect.FileDescriptor
 
 const file_goods_proto_rawDesc = "" +
 	"\n" +
-	"\vgoods.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xfc\x01\n" +
+	"\vgoods.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xb3\x02\n" +
 	"\x12GoodsFilterRequest\x12\x14\n" +
 	"\x05pages\x18\x01 \x01(\x05R\x05pages\x12 \n" +
 	"\vpagePerNums\x18\x02 \x01(\x05R\vpagePerNums\x12\x18\n" +
@@ -1731,10 +2773,30 @@ const file_goods_proto_rawDesc = "" +
 	"\x05isHot\x18\x06 \x01(\bR\x05isHot\x12\x14\n" +
 	"\x05isNew\x18\a \x01(\bR\x05isNew\x12\x16\n" +
 	"\x06onSale\x18\b \x01(\bR\x06onSale\x12\x14\n" +
-	"\x05isTab\x18\t \x01(\bR\x05isTab\"Q\n" +
+	"\x05isTab\x18\t \x01(\bR\x05isTab\x125\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2\x15.GoodsAttributeFilterR\n" +
+	"attributes\"\xac\x01\n" +
+	"\x14GoodsAttributeFilter\x12 \n" +
+	"\vattributeId\x18\x01 \x01(\x05R\vattributeId\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\x12\x1f\n" +
+	"\bminValue\x18\x03 \x01(\x01H\x00R\bminValue\x88\x01\x01\x12\x1f\n" +
+	"\bmaxValue\x18\x04 \x01(\x01H\x01R\bmaxValue\x88\x01\x01B\v\n" +
+	"\t_minValueB\v\n" +
+	"\t_maxValue\"M\n" +
+	"\x13GoodsAttributeValue\x12 \n" +
+	"\vattributeId\x18\x01 \x01(\x05R\vattributeId\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x88\x01\n" +
+	"\x12GoodsAttributeInfo\x12 \n" +
+	"\vattributeId\x18\x01 \x01(\x05R\vattributeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\"Q\n" +
 	"\x11GoodsListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12&\n" +
-	"\x04data\x18\x02 \x03(\v2\x12.GoodsInfoResponseR\x04data\"\xfd\x03\n" +
+	"\x04data\x18\x02 \x03(\v2\x12.GoodsInfoResponseR\x04data\"\xf2\x04\n" +
 	"\x11GoodsInfoResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -1758,7 +2820,12 @@ const file_goods_proto_rawDesc = "" +
 	"\x06onSale\x18\x0f \x01(\bR\x06onSale\x12\x1a\n" +
 	"\bshipFree\x18\x10 \x01(\bR\bshipFree\x12\x18\n" +
 	"\abrandId\x18\x11 \x01(\x05R\abrandId\x12 \n" +
-	"\vcategoryIds\x18\x12 \x03(\x05R\vcategoryIds\"\xfb\x03\n" +
+	"\vcategoryIds\x18\x12 \x03(\x05R\vcategoryIds\x123\n" +
+	"\n" +
+	"attributes\x18\x13 \x03(\v2\x13.GoodsAttributeInfoR\n" +
+	"attributes\x12\x1c\n" +
+	"\tavgRating\x18\x14 \x01(\x02R\tavgRating\x12 \n" +
+	"\vratingCount\x18\x15 \x01(\x05R\vratingCount\"\xb1\x04\n" +
 	"\x0fCreateGoodsInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -1782,7 +2849,10 @@ const file_goods_proto_rawDesc = "" +
 	"\x06onSale\x18\x0f \x01(\bR\x06onSale\x12\x1a\n" +
 	"\bshipFree\x18\x10 \x01(\bR\bshipFree\x12\x18\n" +
 	"\abrandId\x18\x11 \x01(\x05R\abrandId\x12 \n" +
-	"\vcategoryIds\x18\x12 \x03(\x05R\vcategoryIds\"!\n" +
+	"\vcategoryIds\x18\x12 \x03(\x05R\vcategoryIds\x124\n" +
+	"\n" +
+	"attributes\x18\x13 \x03(\v2\x14.GoodsAttributeValueR\n" +
+	"attributes\"!\n" +
 	"\x0fDeleteGoodsInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"!\n" +
 	"\x0fGoodInfoRequest\x12\x0e\n" +
@@ -1813,38 +2883,125 @@ const file_goods_proto_rawDesc = "" +
 	"\x17SubCategoryListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12)\n" +
 	"\x04info\x18\x02 \x01(\v2\x15.CategoryInfoResponseR\x04info\x12;\n" +
-	"\rsubCategories\x18\x03 \x03(\v2\x15.CategoryInfoResponseR\rsubCategories\"t\n" +
+	"\rsubCategories\x18\x03 \x03(\v2\x15.CategoryInfoResponseR\rsubCategories\"@\n" +
+	"\x1eCategoryAttributeFilterRequest\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x01 \x01(\x05R\n" +
+	"categoryId\"\xd0\x01\n" +
+	"\x18CategoryAttributeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x12\n" +
+	"\x04sort\x18\b \x01(\x05R\x04sort\"\xd1\x01\n" +
+	"\x19CategoryAttributeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x12\n" +
+	"\x04sort\x18\b \x01(\x05R\x04sort\"e\n" +
+	"\x1dCategoryAttributeListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12.\n" +
+	"\x04data\x18\x02 \x03(\v2\x1a.CategoryAttributeResponseR\x04data\"\xa8\x01\n" +
 	"\x12BrandFilterRequest\x12\x14\n" +
 	"\x05pages\x18\x01 \x01(\x05R\x05pages\x12 \n" +
 	"\vpagePerNums\x18\x02 \x01(\x05R\vpagePerNums\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
-	"\x04desc\x18\x04 \x01(\tR\x04desc\"Z\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x16\n" +
+	"\x06sortBy\x18\x05 \x01(\tR\x06sortBy\x12\x1a\n" +
+	"\bsortDesc\x18\x06 \x01(\bR\bsortDesc\"Z\n" +
 	"\fBrandRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04logo\x18\x03 \x01(\tR\x04logo\x12\x12\n" +
-	"\x04desc\x18\x04 \x01(\tR\x04desc\"_\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\"{\n" +
 	"\x11BrandInfoResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04logo\x18\x03 \x01(\tR\x04logo\x12\x12\n" +
-	"\x04desc\x18\x04 \x01(\tR\x04desc\"Q\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x1a\n" +
+	"\bgoodsNum\x18\x05 \x01(\x05R\bgoodsNum\"Q\n" +
 	"\x11BrandListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12&\n" +
-	"\x04data\x18\x02 \x03(\v2\x12.BrandInfoResponseR\x04data\"]\n" +
+	"\x04data\x18\x02 \x03(\v2\x12.BrandInfoResponseR\x04data\"K\n" +
+	"\x11MergeBrandRequest\x12\x1a\n" +
+	"\bsourceId\x18\x01 \x01(\x05R\bsourceId\x12\x1a\n" +
+	"\btargetId\x18\x02 \x01(\x05R\btargetId\"\\\n" +
+	"\x12MergeBrandResponse\x12\x1a\n" +
+	"\bgoodsNum\x18\x01 \x01(\x05R\bgoodsNum\x12*\n" +
+	"\x10categoryBrandNum\x18\x02 \x01(\x05R\x10categoryBrandNum\"m\n" +
+	"\x13BannerFilterRequest\x12\x1c\n" +
+	"\tplacement\x18\x01 \x01(\tR\tplacement\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x18\n" +
+	"\abrandId\x18\x03 \x01(\x05R\abrandId\"\xf4\x02\n" +
 	"\rBannerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05image\x18\x02 \x01(\tR\x05image\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
-	"\x05index\x18\x04 \x01(\x05R\x05index\"^\n" +
+	"\x05index\x18\x04 \x01(\x05R\x05index\x12!\n" +
+	"\tplacement\x18\x05 \x01(\tH\x00R\tplacement\x88\x01\x01\x12#\n" +
+	"\n" +
+	"categoryId\x18\x06 \x01(\x05H\x01R\n" +
+	"categoryId\x88\x01\x01\x12\x1d\n" +
+	"\abrandId\x18\a \x01(\x05H\x02R\abrandId\x88\x01\x01\x12!\n" +
+	"\tstartTime\x18\b \x01(\x03H\x03R\tstartTime\x88\x01\x01\x12\x1d\n" +
+	"\aendTime\x18\t \x01(\x03H\x04R\aendTime\x88\x01\x01\x12\x1d\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bH\x05R\aenabled\x88\x01\x01B\f\n" +
+	"\n" +
+	"_placementB\r\n" +
+	"\v_categoryIdB\n" +
+	"\n" +
+	"\b_brandIdB\f\n" +
+	"\n" +
+	"_startTimeB\n" +
+	"\n" +
+	"\b_endTimeB\n" +
+	"\n" +
+	"\b_enabled\"\x88\x02\n" +
 	"\x0eBannerResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05image\x18\x02 \x01(\tR\x05image\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
-	"\x05index\x18\x04 \x01(\x05R\x05index\"O\n" +
+	"\x05index\x18\x04 \x01(\x05R\x05index\x12\x1c\n" +
+	"\tplacement\x18\x05 \x01(\tR\tplacement\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x06 \x01(\x05R\n" +
+	"categoryId\x12\x18\n" +
+	"\abrandId\x18\a \x01(\x05R\abrandId\x12\x1c\n" +
+	"\tstartTime\x18\b \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\t \x01(\x03R\aendTime\x12\x18\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bR\aenabled\"O\n" +
 	"\x12BannerListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12#\n" +
-	"\x04data\x18\x02 \x03(\v2\x0f.BannerResponseR\x04data\"T\n" +
+	"\x04data\x18\x02 \x03(\v2\x0f.BannerResponseR\x04data\"$\n" +
+	"\x12BannerClickRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x81\x01\n" +
+	"\x17BannerClickStatsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\x12\x1c\n" +
+	"\tplacement\x18\x02 \x01(\tR\tplacement\x12\x1c\n" +
+	"\tstartTime\x18\x03 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x04 \x01(\x03R\aendTime\"g\n" +
+	"\x0fBannerClickStat\x12\x1a\n" +
+	"\bbannerId\x18\x01 \x01(\x05R\bbannerId\x12\x1c\n" +
+	"\tplacement\x18\x02 \x01(\tR\tplacement\x12\x1a\n" +
+	"\bclickNum\x18\x03 \x01(\x03R\bclickNum\"V\n" +
+	"\x18BannerClickStatsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12$\n" +
+	"\x04data\x18\x02 \x03(\v2\x10.BannerClickStatR\x04data\"T\n" +
 	"\x1aCategoryBrandFilterRequest\x12\x14\n" +
 	"\x05pages\x18\x01 \x01(\x05R\x05pages\x12 \n" +
 	"\vpagePerNums\x18\x02 \x01(\x05R\vpagePerNums\"`\n" +
@@ -1862,7 +3019,7 @@ const file_goods_proto_rawDesc = "" +
 	"\abrandId\x18\x03 \x01(\x05R\abrandId\"]\n" +
 	"\x19CategoryBrandListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12*\n" +
-	"\x04data\x18\x02 \x03(\v2\x16.CategoryBrandResponseR\x04data2\xb0\v\n" +
+	"\x04data\x18\x02 \x03(\v2\x16.CategoryBrandResponseR\x04data2\xb8\x0f\n" +
 	"\x05Goods\x124\n" +
 	"\tGoodsList\x12\x13.GoodsFilterRequest\x1a\x12.GoodsListResponse\x126\n" +
 	"\rBatchGetGoods\x12\x11.BatchGoodsIdInfo\x1a\x12.GoodsListResponse\x123\n" +
@@ -1874,16 +3031,24 @@ const file_goods_proto_rawDesc = "" +
 	"\x0eGetSubCategory\x12\x14.CategoryListRequest\x1a\x18.SubCategoryListResponse\x12=\n" +
 	"\x0eCreateCategory\x12\x14.CategoryInfoRequest\x1a\x15.CategoryInfoResponse\x12@\n" +
 	"\x0eDeleteCategory\x12\x16.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
-	"\x0eUpdateCategory\x12\x14.CategoryInfoRequest\x1a\x16.google.protobuf.Empty\x124\n" +
+	"\x0eUpdateCategory\x12\x14.CategoryInfoRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\x15CategoryAttributeList\x12\x1f.CategoryAttributeFilterRequest\x1a\x1e.CategoryAttributeListResponse\x12P\n" +
+	"\x17CreateCategoryAttribute\x12\x19.CategoryAttributeRequest\x1a\x1a.CategoryAttributeResponse\x12L\n" +
+	"\x17UpdateCategoryAttribute\x12\x19.CategoryAttributeRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x17DeleteCategoryAttribute\x12\x19.CategoryAttributeRequest\x1a\x16.google.protobuf.Empty\x124\n" +
 	"\tBrandList\x12\x13.BrandFilterRequest\x1a\x12.BrandListResponse\x120\n" +
 	"\vCreateBrand\x12\r.BrandRequest\x1a\x12.BrandInfoResponse\x124\n" +
 	"\vDeleteBrand\x12\r.BrandRequest\x1a\x16.google.protobuf.Empty\x124\n" +
-	"\vUpdateBrand\x12\r.BrandRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\vUpdateBrand\x12\r.BrandRequest\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\n" +
+	"MergeBrand\x12\x12.MergeBrandRequest\x1a\x13.MergeBrandResponse\x127\n" +
 	"\n" +
-	"BannerList\x12\x16.google.protobuf.Empty\x1a\x13.BannerListResponse\x12/\n" +
+	"BannerList\x12\x14.BannerFilterRequest\x1a\x13.BannerListResponse\x12/\n" +
 	"\fCreateBanner\x12\x0e.BannerRequest\x1a\x0f.BannerResponse\x126\n" +
 	"\fDeleteBanner\x12\x0e.BannerRequest\x1a\x16.google.protobuf.Empty\x126\n" +
-	"\fUpdateBanner\x12\x0e.BannerRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\fUpdateBanner\x12\x0e.BannerRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\x11RecordBannerClick\x12\x13.BannerClickRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x10BannerClickStats\x12\x18.BannerClickStatsRequest\x1a\x19.BannerClickStatsResponse\x12L\n" +
 	"\x11CategoryBrandList\x12\x1b.CategoryBrandFilterRequest\x1a\x1a.CategoryBrandListResponse\x12@\n" +
 	"\x14GetCategoryBrandList\x12\x14.CategoryInfoRequest\x1a\x12.BrandListResponse\x12D\n" +
 	"\x13CreateCategoryBrand\x12\x15.CategoryBrandRequest\x1a\x16.CategoryBrandResponse\x12D\n" +
@@ -1902,95 +3067,128 @@ func file_goods_proto_rawDescGZIP() []byte {
 	return file_goods_proto_rawDescData
 }
 
-var file_goods_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_goods_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_goods_proto_goTypes = []any{
-	(*GoodsFilterRequest)(nil),         // 0: GoodsFilterRequest
-	(*GoodsListResponse)(nil),          // 1: GoodsListResponse
-	(*GoodsInfoResponse)(nil),          // 2: GoodsInfoResponse
-	(*CreateGoodsInfo)(nil),            // 3: CreateGoodsInfo
-	(*DeleteGoodsInfo)(nil),            // 4: DeleteGoodsInfo
-	(*GoodInfoRequest)(nil),            // 5: GoodInfoRequest
-	(*BatchGoodsIdInfo)(nil),           // 6: BatchGoodsIdInfo
-	(*CategoryListRequest)(nil),        // 7: CategoryListRequest
-	(*CategoryInfoRequest)(nil),        // 8: CategoryInfoRequest
-	(*DeleteCategoryRequest)(nil),      // 9: DeleteCategoryRequest
-	(*CategoryInfoResponse)(nil),       // 10: CategoryInfoResponse
-	(*CategoryListResponse)(nil),       // 11: CategoryListResponse
-	(*SubCategoryListResponse)(nil),    // 12: SubCategoryListResponse
-	(*BrandFilterRequest)(nil),         // 13: BrandFilterRequest
-	(*BrandRequest)(nil),               // 14: BrandRequest
-	(*BrandInfoResponse)(nil),          // 15: BrandInfoResponse
-	(*BrandListResponse)(nil),          // 16: BrandListResponse
-	(*BannerRequest)(nil),              // 17: BannerRequest
-	(*BannerResponse)(nil),             // 18: BannerResponse
-	(*BannerListResponse)(nil),         // 19: BannerListResponse
-	(*CategoryBrandFilterRequest)(nil), // 20: CategoryBrandFilterRequest
-	(*CategoryBrandRequest)(nil),       // 21: CategoryBrandRequest
-	(*CategoryBrandResponse)(nil),      // 22: CategoryBrandResponse
-	(*CategoryBrandListResponse)(nil),  // 23: CategoryBrandListResponse
-	(*emptypb.Empty)(nil),              // 24: google.protobuf.Empty
+	(*GoodsFilterRequest)(nil),             // 0: GoodsFilterRequest
+	(*GoodsAttributeFilter)(nil),           // 1: GoodsAttributeFilter
+	(*GoodsAttributeValue)(nil),            // 2: GoodsAttributeValue
+	(*GoodsAttributeInfo)(nil),             // 3: GoodsAttributeInfo
+	(*GoodsListResponse)(nil),              // 4: GoodsListResponse
+	(*GoodsInfoResponse)(nil),              // 5: GoodsInfoResponse
+	(*CreateGoodsInfo)(nil),                // 6: CreateGoodsInfo
+	(*DeleteGoodsInfo)(nil),                // 7: DeleteGoodsInfo
+	(*GoodInfoRequest)(nil),                // 8: GoodInfoRequest
+	(*BatchGoodsIdInfo)(nil),               // 9: BatchGoodsIdInfo
+	(*CategoryListRequest)(nil),            // 10: CategoryListRequest
+	(*CategoryInfoRequest)(nil),            // 11: CategoryInfoRequest
+	(*DeleteCategoryRequest)(nil),          // 12: DeleteCategoryRequest
+	(*CategoryInfoResponse)(nil),           // 13: CategoryInfoResponse
+	(*CategoryListResponse)(nil),           // 14: CategoryListResponse
+	(*SubCategoryListResponse)(nil),        // 15: SubCategoryListResponse
+	(*CategoryAttributeFilterRequest)(nil), // 16: CategoryAttributeFilterRequest
+	(*CategoryAttributeRequest)(nil),       // 17: CategoryAttributeRequest
+	(*CategoryAttributeResponse)(nil),      // 18: CategoryAttributeResponse
+	(*CategoryAttributeListResponse)(nil),  // 19: CategoryAttributeListResponse
+	(*BrandFilterRequest)(nil),             // 20: BrandFilterRequest
+	(*BrandRequest)(nil),                   // 21: BrandRequest
+	(*BrandInfoResponse)(nil),              // 22: BrandInfoResponse
+	(*BrandListResponse)(nil),              // 23: BrandListResponse
+	(*MergeBrandRequest)(nil),              // 24: MergeBrandRequest
+	(*MergeBrandResponse)(nil),             // 25: MergeBrandResponse
+	(*BannerFilterRequest)(nil),            // 26: BannerFilterRequest
+	(*BannerRequest)(nil),                  // 27: BannerRequest
+	(*BannerResponse)(nil),                 // 28: BannerResponse
+	(*BannerListResponse)(nil),             // 29: BannerListResponse
+	(*BannerClickRequest)(nil),             // 30: BannerClickRequest
+	(*BannerClickStatsRequest)(nil),        // 31: BannerClickStatsRequest
+	(*BannerClickStat)(nil),                // 32: BannerClickStat
+	(*BannerClickStatsResponse)(nil),       // 33: BannerClickStatsResponse
+	(*CategoryBrandFilterRequest)(nil),     // 34: CategoryBrandFilterRequest
+	(*CategoryBrandRequest)(nil),           // 35: CategoryBrandRequest
+	(*CategoryBrandResponse)(nil),          // 36: CategoryBrandResponse
+	(*CategoryBrandListResponse)(nil),      // 37: CategoryBrandListResponse
+	(*emptypb.Empty)(nil),                  // 38: google.protobuf.Empty
 }
 var file_goods_proto_depIdxs = []int32{
-	2,  // 0: GoodsListResponse.data:type_name -> GoodsInfoResponse
-	10, // 1: CategoryListResponse.data:type_name -> CategoryInfoResponse
-	10, // 2: SubCategoryListResponse.info:type_name -> CategoryInfoResponse
-	10, // 3: SubCategoryListResponse.subCategories:type_name -> CategoryInfoResponse
-	15, // 4: BrandListResponse.data:type_name -> BrandInfoResponse
-	18, // 5: BannerListResponse.data:type_name -> BannerResponse
-	22, // 6: CategoryBrandListResponse.data:type_name -> CategoryBrandResponse
-	0,  // 7: Goods.GoodsList:input_type -> GoodsFilterRequest
-	6,  // 8: Goods.BatchGetGoods:input_type -> BatchGoodsIdInfo
-	3,  // 9: Goods.CreateGoods:input_type -> CreateGoodsInfo
-	4,  // 10: Goods.DeleteGoods:input_type -> DeleteGoodsInfo
-	3,  // 11: Goods.UpdateGoods:input_type -> CreateGoodsInfo
-	5,  // 12: Goods.GetGoodsDetail:input_type -> GoodInfoRequest
-	24, // 13: Goods.GetAllCategoriesList:input_type -> google.protobuf.Empty
-	7,  // 14: Goods.GetSubCategory:input_type -> CategoryListRequest
-	8,  // 15: Goods.CreateCategory:input_type -> CategoryInfoRequest
-	9,  // 16: Goods.DeleteCategory:input_type -> DeleteCategoryRequest
-	8,  // 17: Goods.UpdateCategory:input_type -> CategoryInfoRequest
-	13, // 18: Goods.BrandList:input_type -> BrandFilterRequest
-	14, // 19: Goods.CreateBrand:input_type -> BrandRequest
-	14, // 20: Goods.DeleteBrand:input_type -> BrandRequest
-	14, // 21: Goods.UpdateBrand:input_type -> BrandRequest
-	24, // 22: Goods.BannerList:input_type -> google.protobuf.Empty
-	17, // 23: Goods.CreateBanner:input_type -> BannerRequest
-	17, // 24: Goods.DeleteBanner:input_type -> BannerRequest
-	17, // 25: Goods.UpdateBanner:input_type -> BannerRequest
-	20, // 26: Goods.CategoryBrandList:input_type -> CategoryBrandFilterRequest
-	8,  // 27: Goods.GetCategoryBrandList:input_type -> CategoryInfoRequest
-	21, // 28: Goods.CreateCategoryBrand:input_type -> CategoryBrandRequest
-	21, // 29: Goods.DeleteCategoryBrand:input_type -> CategoryBrandRequest
-	21, // 30: Goods.UpdateCategoryBrand:input_type -> CategoryBrandRequest
-	1,  // 31: Goods.GoodsList:output_type -> GoodsListResponse
-	1,  // 32: Goods.BatchGetGoods:output_type -> GoodsListResponse
-	2,  // 33: Goods.CreateGoods:output_type -> GoodsInfoResponse
-	24, // 34: Goods.DeleteGoods:output_type -> google.protobuf.Empty
-	24, // 35: Goods.UpdateGoods:output_type -> google.protobuf.Empty
-	2,  // 36: Goods.GetGoodsDetail:output_type -> GoodsInfoResponse
-	11, // 37: Goods.GetAllCategoriesList:output_type -> CategoryListResponse
-	12, // 38: Goods.GetSubCategory:output_type -> SubCategoryListResponse
-	10, // 39: Goods.CreateCategory:output_type -> CategoryInfoResponse
-	24, // 40: Goods.DeleteCategory:output_type -> google.protobuf.Empty
-	24, // 41: Goods.UpdateCategory:output_type -> google.protobuf.Empty
-	16, // 42: Goods.BrandList:output_type -> BrandListResponse
-	15, // 43: Goods.CreateBrand:output_type -> BrandInfoResponse
-	24, // 44: Goods.DeleteBrand:output_type -> google.protobuf.Empty
-	24, // 45: Goods.UpdateBrand:output_type -> google.protobuf.Empty
-	19, // 46: Goods.BannerList:output_type -> BannerListResponse
-	18, // 47: Goods.CreateBanner:output_type -> BannerResponse
-	24, // 48: Goods.DeleteBanner:output_type -> google.protobuf.Empty
-	24, // 49: Goods.UpdateBanner:output_type -> google.protobuf.Empty
-	23, // 50: Goods.CategoryBrandList:output_type -> CategoryBrandListResponse
-	16, // 51: Goods.GetCategoryBrandList:output_type -> BrandListResponse
-	22, // 52: Goods.CreateCategoryBrand:output_type -> CategoryBrandResponse
-	24, // 53: Goods.DeleteCategoryBrand:output_type -> google.protobuf.Empty
-	24, // 54: Goods.UpdateCategoryBrand:output_type -> google.protobuf.Empty
-	31, // [31:55] is the sub-list for method output_type
-	7,  // [7:31] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	1,  // 0: GoodsFilterRequest.attributes:type_name -> GoodsAttributeFilter
+	5,  // 1: GoodsListResponse.data:type_name -> GoodsInfoResponse
+	3,  // 2: GoodsInfoResponse.attributes:type_name -> GoodsAttributeInfo
+	2,  // 3: CreateGoodsInfo.attributes:type_name -> GoodsAttributeValue
+	13, // 4: CategoryListResponse.data:type_name -> CategoryInfoResponse
+	13, // 5: SubCategoryListResponse.info:type_name -> CategoryInfoResponse
+	13, // 6: SubCategoryListResponse.subCategories:type_name -> CategoryInfoResponse
+	18, // 7: CategoryAttributeListResponse.data:type_name -> CategoryAttributeResponse
+	22, // 8: BrandListResponse.data:type_name -> BrandInfoResponse
+	28, // 9: BannerListResponse.data:type_name -> BannerResponse
+	32, // 10: BannerClickStatsResponse.data:type_name -> BannerClickStat
+	36, // 11: CategoryBrandListResponse.data:type_name -> CategoryBrandResponse
+	0,  // 12: Goods.GoodsList:input_type -> GoodsFilterRequest
+	9,  // 13: Goods.BatchGetGoods:input_type -> BatchGoodsIdInfo
+	6,  // 14: Goods.CreateGoods:input_type -> CreateGoodsInfo
+	7,  // 15: Goods.DeleteGoods:input_type -> DeleteGoodsInfo
+	6,  // 16: Goods.UpdateGoods:input_type -> CreateGoodsInfo
+	8,  // 17: Goods.GetGoodsDetail:input_type -> GoodInfoRequest
+	38, // 18: Goods.GetAllCategoriesList:input_type -> google.protobuf.Empty
+	10, // 19: Goods.GetSubCategory:input_type -> CategoryListRequest
+	11, // 20: Goods.CreateCategory:input_type -> CategoryInfoRequest
+	12, // 21: Goods.DeleteCategory:input_type -> DeleteCategoryRequest
+	11, // 22: Goods.UpdateCategory:input_type -> CategoryInfoRequest
+	16, // 23: Goods.CategoryAttributeList:input_type -> CategoryAttributeFilterRequest
+	17, // 24: Goods.CreateCategoryAttribute:input_type -> CategoryAttributeRequest
+	17, // 25: Goods.UpdateCategoryAttribute:input_type -> CategoryAttributeRequest
+	17, // 26: Goods.DeleteCategoryAttribute:input_type -> CategoryAttributeRequest
+	20, // 27: Goods.BrandList:input_type -> BrandFilterRequest
+	21, // 28: Goods.CreateBrand:input_type -> BrandRequest
+	21, // 29: Goods.DeleteBrand:input_type -> BrandRequest
+	21, // 30: Goods.UpdateBrand:input_type -> BrandRequest
+	24, // 31: Goods.MergeBrand:input_type -> MergeBrandRequest
+	26, // 32: Goods.BannerList:input_type -> BannerFilterRequest
+	27, // 33: Goods.CreateBanner:input_type -> BannerRequest
+	27, // 34: Goods.DeleteBanner:input_type -> BannerRequest
+	27, // 35: Goods.UpdateBanner:input_type -> BannerRequest
+	30, // 36: Goods.RecordBannerClick:input_type -> BannerClickRequest
+	31, // 37: Goods.BannerClickStats:input_type -> BannerClickStatsRequest
+	34, // 38: Goods.CategoryBrandList:input_type -> CategoryBrandFilterRequest
+	11, // 39: Goods.GetCategoryBrandList:input_type -> CategoryInfoRequest
+	35, // 40: Goods.CreateCategoryBrand:input_type -> CategoryBrandRequest
+	35, // 41: Goods.DeleteCategoryBrand:input_type -> CategoryBrandRequest
+	35, // 42: Goods.UpdateCategoryBrand:input_type -> CategoryBrandRequest
+	4,  // 43: Goods.GoodsList:output_type -> GoodsListResponse
+	4,  // 44: Goods.BatchGetGoods:output_type -> GoodsListResponse
+	5,  // 45: Goods.CreateGoods:output_type -> GoodsInfoResponse
+	38, // 46: Goods.DeleteGoods:output_type -> google.protobuf.Empty
+	38, // 47: Goods.UpdateGoods:output_type -> google.protobuf.Empty
+	5,  // 48: Goods.GetGoodsDetail:output_type -> GoodsInfoResponse
+	14, // 49: Goods.GetAllCategoriesList:output_type -> CategoryListResponse
+	15, // 50: Goods.GetSubCategory:output_type -> SubCategoryListResponse
+	13, // 51: Goods.CreateCategory:output_type -> CategoryInfoResponse
+	38, // 52: Goods.DeleteCategory:output_type -> google.protobuf.Empty
+	38, // 53: Goods.UpdateCategory:output_type -> google.protobuf.Empty
+	19, // 54: Goods.CategoryAttributeList:output_type -> CategoryAttributeListResponse
+	18, // 55: Goods.CreateCategoryAttribute:output_type -> CategoryAttributeResponse
+	38, // 56: Goods.UpdateCategoryAttribute:output_type -> google.protobuf.Empty
+	38, // 57: Goods.DeleteCategoryAttribute:output_type -> google.protobuf.Empty
+	23, // 58: Goods.BrandList:output_type -> BrandListResponse
+	22, // 59: Goods.CreateBrand:output_type -> BrandInfoResponse
+	38, // 60: Goods.DeleteBrand:output_type -> google.protobuf.Empty
+	38, // 61: Goods.UpdateBrand:output_type -> google.protobuf.Empty
+	25, // 62: Goods.MergeBrand:output_type -> MergeBrandResponse
+	29, // 63: Goods.BannerList:output_type -> BannerListResponse
+	28, // 64: Goods.CreateBanner:output_type -> BannerResponse
+	38, // 65: Goods.DeleteBanner:output_type -> google.protobuf.Empty
+	38, // 66: Goods.UpdateBanner:output_type -> google.protobuf.Empty
+	38, // 67: Goods.RecordBannerClick:output_type -> google.protobuf.Empty
+	33, // 68: Goods.BannerClickStats:output_type -> BannerClickStatsResponse
+	37, // 69: Goods.CategoryBrandList:output_type -> CategoryBrandListResponse
+	23, // 70: Goods.GetCategoryBrandList:output_type -> BrandListResponse
+	36, // 71: Goods.CreateCategoryBrand:output_type -> CategoryBrandResponse
+	38, // 72: Goods.DeleteCategoryBrand:output_type -> google.protobuf.Empty
+	38, // 73: Goods.UpdateCategoryBrand:output_type -> google.protobuf.Empty
+	43, // [43:74] is the sub-list for method output_type
+	12, // [12:43] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_goods_proto_init() }
@@ -1998,13 +3196,15 @@ func file_goods_proto_init() {
 	if File_goods_proto != nil {
 		return
 	}
+	file_goods_proto_msgTypes[1].OneofWrappers = []any{}
+	file_goods_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goods_proto_rawDesc), len(file_goods_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
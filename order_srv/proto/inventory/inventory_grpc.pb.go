@@ -20,10 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_SetInventory_FullMethodName = "/InventoryService/SetInventory"
-	InventoryService_GetInventory_FullMethodName = "/InventoryService/GetInventory"
-	InventoryService_Sell_FullMethodName         = "/InventoryService/Sell"
-	InventoryService_Reback_FullMethodName       = "/InventoryService/Reback"
+	InventoryService_SetInventory_FullMethodName      = "/InventoryService/SetInventory"
+	InventoryService_GetInventory_FullMethodName      = "/InventoryService/GetInventory"
+	InventoryService_BatchGetInventory_FullMethodName = "/InventoryService/BatchGetInventory"
+	InventoryService_Sell_FullMethodName              = "/InventoryService/Sell"
+	InventoryService_Reback_FullMethodName            = "/InventoryService/Reback"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -32,6 +33,7 @@ const (
 type InventoryServiceClient interface {
 	SetInventory(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetInventory(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*GoodsInvInfo, error)
+	BatchGetInventory(ctx context.Context, in *BatchInvRequest, opts ...grpc.CallOption) (*BatchInvResponse, error)
 	// 我们一般买东西的时候喜欢从购物车中去买 事务
 	Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 归还
@@ -66,6 +68,16 @@ func (c *inventoryServiceClient) GetInventory(ctx context.Context, in *GoodsInvI
 	return out, nil
 }
 
+func (c *inventoryServiceClient) BatchGetInventory(ctx context.Context, in *BatchInvRequest, opts ...grpc.CallOption) (*BatchInvResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchInvResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchGetInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -92,6 +104,7 @@ func (c *inventoryServiceClient) Reback(ctx context.Context, in *SellInfo, opts
 type InventoryServiceServer interface {
 	SetInventory(context.Context, *GoodsInvInfo) (*emptypb.Empty, error)
 	GetInventory(context.Context, *GoodsInvInfo) (*GoodsInvInfo, error)
+	BatchGetInventory(context.Context, *BatchInvRequest) (*BatchInvResponse, error)
 	// 我们一般买东西的时候喜欢从购物车中去买 事务
 	Sell(context.Context, *SellInfo) (*emptypb.Empty, error)
 	// 归还
@@ -112,6 +125,9 @@ func (UnimplementedInventoryServiceServer) SetInventory(context.Context, *GoodsI
 func (UnimplementedInventoryServiceServer) GetInventory(context.Context, *GoodsInvInfo) (*GoodsInvInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
 }
+func (UnimplementedInventoryServiceServer) BatchGetInventory(context.Context, *BatchInvRequest) (*BatchInvResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetInventory not implemented")
+}
 func (UnimplementedInventoryServiceServer) Sell(context.Context, *SellInfo) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sell not implemented")
 }
@@ -175,6 +191,24 @@ func _InventoryService_GetInventory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InventoryService_BatchGetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchInvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchGetInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchGetInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchGetInventory(ctx, req.(*BatchInvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _InventoryService_Sell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SellInfo)
 	if err := dec(in); err != nil {
@@ -226,6 +260,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInventory",
 			Handler:    _InventoryService_GetInventory_Handler,
 		},
+		{
+			MethodName: "BatchGetInventory",
+			Handler:    _InventoryService_BatchGetInventory_Handler,
+		},
 		{
 			MethodName: "Sell",
 			Handler:    _InventoryService_Sell_Handler,
@@ -76,12 +76,14 @@ func (x *OrderDelRequest) GetUserId() int32 {
 
 type OrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                       // 订单ID
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`              // 收货地址
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                    // 收货人姓名
-	Mobile        string                 `protobuf:"bytes,5,opt,name=mobile,proto3" json:"mobile,omitempty"`                // 收货人手机
-	Post          string                 `protobuf:"bytes,6,opt,name=post,proto3" json:"post,omitempty"`                    // 留言
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // 订单ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`                    // 收货地址
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                          // 收货人姓名
+	Mobile        string                 `protobuf:"bytes,5,opt,name=mobile,proto3" json:"mobile,omitempty"`                      // 收货人手机
+	Post          string                 `protobuf:"bytes,6,opt,name=post,proto3" json:"post,omitempty"`                          // 留言
+	CouponId      int32                  `protobuf:"varint,7,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"` // 使用的用户优惠券ID，0表示不使用
+	Items         []*OrderGoodsItem      `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`                        // 立即购买的商品，为空时使用购物车中选中的商品，不为空时不修改购物车
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,20 +160,43 @@ func (x *OrderRequest) GetPost() string {
 	return ""
 }
 
+func (x *OrderRequest) GetCouponId() int32 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *OrderRequest) GetItems() []*OrderGoodsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type OrderInfoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                         // 订单ID
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`   // 用户ID
-	OrderSn       string                 `protobuf:"bytes,3,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"` // 订单号
-	PayType       string                 `protobuf:"bytes,4,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"` // 支付方式
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                  // 订单状态
-	Post          string                 `protobuf:"bytes,6,opt,name=post,proto3" json:"post,omitempty"`                      // 留言
-	Total         float32                `protobuf:"fixed32,7,opt,name=total,proto3" json:"total,omitempty"`                  // 订单总价
-	Address       string                 `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`                // 收货地址
-	Name          string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`                      // 收货人姓名
-	Mobile        string                 `protobuf:"bytes,10,opt,name=mobile,proto3" json:"mobile,omitempty"`                 // 收货人手机
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                // 订单ID
+	UserId         int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // 用户ID
+	OrderSn        string                 `protobuf:"bytes,3,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"`                        // 订单号
+	PayType        string                 `protobuf:"bytes,4,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"`                        // 支付方式
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                                         // 订单状态
+	Post           string                 `protobuf:"bytes,6,opt,name=post,proto3" json:"post,omitempty"`                                             // 留言
+	Address        string                 `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`                                       // 收货地址
+	Name           string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`                                             // 收货人姓名
+	Mobile         string                 `protobuf:"bytes,10,opt,name=mobile,proto3" json:"mobile,omitempty"`                                        // 收货人手机
+	CouponId       int32                  `protobuf:"varint,12,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"`                   // 使用的用户优惠券ID
+	GoodsAmount    int64                  `protobuf:"varint,13,opt,name=goods_amount,json=goodsAmount,proto3" json:"goods_amount,omitempty"`          // 商品总额（分）
+	ShippingFee    int64                  `protobuf:"varint,14,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`          // 运费（分）
+	DiscountAmount int64                  `protobuf:"varint,15,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 优惠总金额（分）
+	PayAmount      int64                  `protobuf:"varint,16,opt,name=pay_amount,json=payAmount,proto3" json:"pay_amount,omitempty"`                // 应付金额（分）
+	ParentSn       string                 `protobuf:"bytes,17,opt,name=parent_sn,json=parentSn,proto3" json:"parent_sn,omitempty"`                    // 拆单时的父订单号，支付使用父订单号
+	Children       []*OrderInfoResponse   `protobuf:"bytes,18,rep,name=children,proto3" json:"children,omitempty"`                                    // 拆单后的子订单，仅下单时返回
+	TradeNo        string                 `protobuf:"bytes,19,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"`                       // 支付渠道交易号
+	AddTime        int64                  `protobuf:"varint,20,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`                      // 下单时间
+	PayTime        int64                  `protobuf:"varint,21,opt,name=pay_time,json=payTime,proto3" json:"pay_time,omitempty"`                      // 支付时间，未支付时为0
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OrderInfoResponse) Reset() {
@@ -246,13 +271,6 @@ func (x *OrderInfoResponse) GetPost() string {
 	return ""
 }
 
-func (x *OrderInfoResponse) GetTotal() float32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
 func (x *OrderInfoResponse) GetAddress() string {
 	if x != nil {
 		return x.Address
@@ -274,6 +292,76 @@ func (x *OrderInfoResponse) GetMobile() string {
 	return ""
 }
 
+func (x *OrderInfoResponse) GetCouponId() int32 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *OrderInfoResponse) GetGoodsAmount() int64 {
+	if x != nil {
+		return x.GoodsAmount
+	}
+	return 0
+}
+
+func (x *OrderInfoResponse) GetShippingFee() int64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+func (x *OrderInfoResponse) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *OrderInfoResponse) GetPayAmount() int64 {
+	if x != nil {
+		return x.PayAmount
+	}
+	return 0
+}
+
+func (x *OrderInfoResponse) GetParentSn() string {
+	if x != nil {
+		return x.ParentSn
+	}
+	return ""
+}
+
+func (x *OrderInfoResponse) GetChildren() []*OrderInfoResponse {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *OrderInfoResponse) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *OrderInfoResponse) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+func (x *OrderInfoResponse) GetPayTime() int64 {
+	if x != nil {
+		return x.PayTime
+	}
+	return 0
+}
+
 type OrderFilterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
@@ -395,16 +483,17 @@ func (x *OrderListResponse) GetData() []*OrderInfoResponse {
 }
 
 type OrderItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                    // order item ID
-	OrderId       int32                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`           // 订单ID
-	GoodsId       int32                  `protobuf:"varint,3,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`           // 商品ID
-	GoodsName     string                 `protobuf:"bytes,4,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"`      // 商品名称
-	GoodsImage    string                 `protobuf:"bytes,5,opt,name=goods_image,json=goodsImage,proto3" json:"goods_image,omitempty"`   // 商品图片
-	GoodsPrice    float32                `protobuf:"fixed32,6,opt,name=goods_price,json=goodsPrice,proto3" json:"goods_price,omitempty"` // 商品价格
-	Nums          int32                  `protobuf:"varint,7,opt,name=nums,proto3" json:"nums,omitempty"`                                // 商品数量
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                               // order item ID
+	OrderId        int32                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                      // 订单ID
+	GoodsId        int32                  `protobuf:"varint,3,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`                      // 商品ID
+	GoodsName      string                 `protobuf:"bytes,4,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"`                 // 商品名称
+	GoodsImage     string                 `protobuf:"bytes,5,opt,name=goods_image,json=goodsImage,proto3" json:"goods_image,omitempty"`              // 商品图片
+	Nums           int32                  `protobuf:"varint,7,opt,name=nums,proto3" json:"nums,omitempty"`                                           // 商品数量
+	Price          int64                  `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`                                         // 商品单价（分）
+	DiscountAmount int64                  `protobuf:"varint,9,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 分摊的优惠金额（分）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OrderItemResponse) Reset() {
@@ -472,16 +561,23 @@ func (x *OrderItemResponse) GetGoodsImage() string {
 	return ""
 }
 
-func (x *OrderItemResponse) GetGoodsPrice() float32 {
+func (x *OrderItemResponse) GetNums() int32 {
 	if x != nil {
-		return x.GoodsPrice
+		return x.Nums
 	}
 	return 0
 }
 
-func (x *OrderItemResponse) GetNums() int32 {
+func (x *OrderItemResponse) GetPrice() int64 {
 	if x != nil {
-		return x.Nums
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderItemResponse) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
 	}
 	return 0
 }
@@ -490,6 +586,7 @@ type OrderInfoDetailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderInfo     *OrderInfoResponse     `protobuf:"bytes,1,opt,name=order_info,json=orderInfo,proto3" json:"order_info,omitempty"` // 订单信息
 	Goods         []*OrderItemResponse   `protobuf:"bytes,2,rep,name=goods,proto3" json:"goods,omitempty"`                          // 订单商品列表
+	Discounts     []*DiscountInfo        `protobuf:"bytes,3,rep,name=discounts,proto3" json:"discounts,omitempty"`                  // 优惠明细
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -538,11 +635,20 @@ func (x *OrderInfoDetailResponse) GetGoods() []*OrderItemResponse {
 	return nil
 }
 
+func (x *OrderInfoDetailResponse) GetDiscounts() []*DiscountInfo {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
 type OrderStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                         // 订单ID
 	OrderSn       string                 `protobuf:"bytes,2,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"` // 订单号
 	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                  // 订单状态
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`                    // 操作人，为空时记为admin
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                  // 变更原因
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -598,27 +704,46 @@ func (x *OrderStatus) GetStatus() string {
 	return ""
 }
 
-type UserInfo struct {
+func (x *OrderStatus) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OrderStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type OrderStatusLogInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"` // 原状态，创建订单时为空
+	ToStatus      string                 `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`       // 新状态
+	Event         string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`                             // 触发事件
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`                             // 操作人，如 user:12、admin、system
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                           // 变更原因
+	AddTime       int64                  `protobuf:"varint,6,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`         // 变更时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserInfo) Reset() {
-	*x = UserInfo{}
+func (x *OrderStatusLogInfo) Reset() {
+	*x = OrderStatusLogInfo{}
 	mi := &file_proto_order_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInfo) String() string {
+func (x *OrderStatusLogInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfo) ProtoMessage() {}
+func (*OrderStatusLogInfo) ProtoMessage() {}
 
-func (x *UserInfo) ProtoReflect() protoreflect.Message {
+func (x *OrderStatusLogInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -630,46 +755,78 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
-func (*UserInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrderStatusLogInfo.ProtoReflect.Descriptor instead.
+func (*OrderStatusLogInfo) Descriptor() ([]byte, []int) {
 	return file_proto_order_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UserInfo) GetId() int32 {
+func (x *OrderStatusLogInfo) GetFromStatus() string {
 	if x != nil {
-		return x.Id
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusLogInfo) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusLogInfo) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *OrderStatusLogInfo) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *OrderStatusLogInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusLogInfo) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
 	}
 	return 0
 }
 
-type CartItemRequest struct {
+type JobLeaderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GoodsId       int32                  `protobuf:"varint,3,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
-	GoodsName     string                 `protobuf:"bytes,4,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"`
-	GoodsImage    string                 `protobuf:"bytes,5,opt,name=goods_image,json=goodsImage,proto3" json:"goods_image,omitempty"`
-	GoodsPrice    float32                `protobuf:"fixed32,6,opt,name=goods_price,json=goodsPrice,proto3" json:"goods_price,omitempty"` //占位，冗余
-	Nums          int32                  `protobuf:"varint,7,opt,name=nums,proto3" json:"nums,omitempty"`
-	Checked       bool                   `protobuf:"varint,8,opt,name=checked,proto3" json:"checked,omitempty"`
+	Leader        string                 `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`                      // 当前持有租约的实例，为空表示暂无主实例
+	LeaseTtl      int64                  `protobuf:"varint,2,opt,name=lease_ttl,json=leaseTtl,proto3" json:"lease_ttl,omitempty"` // 租约剩余时长（毫秒）
+	Instance      string                 `protobuf:"bytes,3,opt,name=instance,proto3" json:"instance,omitempty"`                  // 响应请求的实例
+	IsLeader      bool                   `protobuf:"varint,4,opt,name=is_leader,json=isLeader,proto3" json:"is_leader,omitempty"` // 响应请求的实例是否为主实例
+	Jobs          []string               `protobuf:"bytes,5,rep,name=jobs,proto3" json:"jobs,omitempty"`                          // 注册的后台任务
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CartItemRequest) Reset() {
-	*x = CartItemRequest{}
+func (x *JobLeaderResponse) Reset() {
+	*x = JobLeaderResponse{}
 	mi := &file_proto_order_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CartItemRequest) String() string {
+func (x *JobLeaderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CartItemRequest) ProtoMessage() {}
+func (*JobLeaderResponse) ProtoMessage() {}
 
-func (x *CartItemRequest) ProtoReflect() protoreflect.Message {
+func (x *JobLeaderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,89 +838,71 @@ func (x *CartItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CartItemRequest.ProtoReflect.Descriptor instead.
-func (*CartItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JobLeaderResponse.ProtoReflect.Descriptor instead.
+func (*JobLeaderResponse) Descriptor() ([]byte, []int) {
 	return file_proto_order_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CartItemRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *CartItemRequest) GetUserId() int32 {
+func (x *JobLeaderResponse) GetLeader() string {
 	if x != nil {
-		return x.UserId
+		return x.Leader
 	}
-	return 0
+	return ""
 }
 
-func (x *CartItemRequest) GetGoodsId() int32 {
+func (x *JobLeaderResponse) GetLeaseTtl() int64 {
 	if x != nil {
-		return x.GoodsId
+		return x.LeaseTtl
 	}
 	return 0
 }
 
-func (x *CartItemRequest) GetGoodsName() string {
-	if x != nil {
-		return x.GoodsName
-	}
-	return ""
-}
-
-func (x *CartItemRequest) GetGoodsImage() string {
+func (x *JobLeaderResponse) GetInstance() string {
 	if x != nil {
-		return x.GoodsImage
+		return x.Instance
 	}
 	return ""
 }
 
-func (x *CartItemRequest) GetGoodsPrice() float32 {
-	if x != nil {
-		return x.GoodsPrice
-	}
-	return 0
-}
-
-func (x *CartItemRequest) GetNums() int32 {
+func (x *JobLeaderResponse) GetIsLeader() bool {
 	if x != nil {
-		return x.Nums
+		return x.IsLeader
 	}
-	return 0
+	return false
 }
 
-func (x *CartItemRequest) GetChecked() bool {
+func (x *JobLeaderResponse) GetJobs() []string {
 	if x != nil {
-		return x.Checked
+		return x.Jobs
 	}
-	return false
+	return nil
 }
 
-type CartItemListResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Total         int32                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	CartItems     []*ShopCartInfoResponse `protobuf:"bytes,2,rep,name=cart_items,json=cartItems,proto3" json:"cart_items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type OutboxFilterRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Topic           string                 `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`                                             // 消息主题
+	MsgKey          string                 `protobuf:"bytes,2,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`                             // 业务唯一键
+	IncludeReplayed bool                   `protobuf:"varint,3,opt,name=include_replayed,json=includeReplayed,proto3" json:"include_replayed,omitempty"` // 是否包含已重放的死信
+	Page            int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                                              // 页码
+	PageSize        int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                      // 每页数量
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CartItemListResponse) Reset() {
-	*x = CartItemListResponse{}
+func (x *OutboxFilterRequest) Reset() {
+	*x = OutboxFilterRequest{}
 	mi := &file_proto_order_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CartItemListResponse) String() string {
+func (x *OutboxFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CartItemListResponse) ProtoMessage() {}
+func (*OutboxFilterRequest) ProtoMessage() {}
 
-func (x *CartItemListResponse) ProtoReflect() protoreflect.Message {
+func (x *OutboxFilterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -775,53 +914,76 @@ func (x *CartItemListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CartItemListResponse.ProtoReflect.Descriptor instead.
-func (*CartItemListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutboxFilterRequest.ProtoReflect.Descriptor instead.
+func (*OutboxFilterRequest) Descriptor() ([]byte, []int) {
 	return file_proto_order_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CartItemListResponse) GetTotal() int32 {
+func (x *OutboxFilterRequest) GetTopic() string {
 	if x != nil {
-		return x.Total
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *OutboxFilterRequest) GetMsgKey() string {
+	if x != nil {
+		return x.MsgKey
+	}
+	return ""
+}
+
+func (x *OutboxFilterRequest) GetIncludeReplayed() bool {
+	if x != nil {
+		return x.IncludeReplayed
+	}
+	return false
+}
+
+func (x *OutboxFilterRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *CartItemListResponse) GetCartItems() []*ShopCartInfoResponse {
+func (x *OutboxFilterRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.CartItems
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type ShopCartInfoResponse struct {
+type OutboxDeadLetterInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	GoodsId       int32                  `protobuf:"varint,3,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
-	GoodsName     string                 `protobuf:"bytes,4,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"`
-	GoodsImage    string                 `protobuf:"bytes,5,opt,name=goods_image,json=goodsImage,proto3" json:"goods_image,omitempty"`
-	GoodsPrice    float32                `protobuf:"fixed32,6,opt,name=goods_price,json=goodsPrice,proto3" json:"goods_price,omitempty"`
-	Nums          int32                  `protobuf:"varint,7,opt,name=nums,proto3" json:"nums,omitempty"`
-	Checked       bool                   `protobuf:"varint,8,opt,name=checked,proto3" json:"checked,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // 死信ID
+	MessageId     int32                  `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`           // 原发件箱消息ID
+	Topic         string                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`                                     // 消息主题
+	MsgKey        string                 `protobuf:"bytes,4,opt,name=msg_key,json=msgKey,proto3" json:"msg_key,omitempty"`                     // 业务唯一键
+	Payload       string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`                                 // 消息内容JSON
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`                              // 已投递次数
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`            // 最后一次投递错误
+	Replayed      bool                   `protobuf:"varint,8,opt,name=replayed,proto3" json:"replayed,omitempty"`                              // 是否已重放
+	AddTime       int64                  `protobuf:"varint,9,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`                 // 移入死信表时间
+	ReplayedTime  int64                  `protobuf:"varint,10,opt,name=replayed_time,json=replayedTime,proto3" json:"replayed_time,omitempty"` // 重放时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShopCartInfoResponse) Reset() {
-	*x = ShopCartInfoResponse{}
+func (x *OutboxDeadLetterInfo) Reset() {
+	*x = OutboxDeadLetterInfo{}
 	mi := &file_proto_order_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShopCartInfoResponse) String() string {
+func (x *OutboxDeadLetterInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShopCartInfoResponse) ProtoMessage() {}
+func (*OutboxDeadLetterInfo) ProtoMessage() {}
 
-func (x *ShopCartInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *OutboxDeadLetterInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_order_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,94 +995,4992 @@ func (x *ShopCartInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShopCartInfoResponse.ProtoReflect.Descriptor instead.
-func (*ShopCartInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutboxDeadLetterInfo.ProtoReflect.Descriptor instead.
+func (*OutboxDeadLetterInfo) Descriptor() ([]byte, []int) {
 	return file_proto_order_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ShopCartInfoResponse) GetId() int32 {
+func (x *OutboxDeadLetterInfo) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ShopCartInfoResponse) GetUserId() int32 {
+func (x *OutboxDeadLetterInfo) GetMessageId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.MessageId
 	}
 	return 0
 }
 
-func (x *ShopCartInfoResponse) GetGoodsId() int32 {
+func (x *OutboxDeadLetterInfo) GetTopic() string {
 	if x != nil {
-		return x.GoodsId
+		return x.Topic
 	}
-	return 0
+	return ""
 }
 
-func (x *ShopCartInfoResponse) GetGoodsName() string {
+func (x *OutboxDeadLetterInfo) GetMsgKey() string {
 	if x != nil {
-		return x.GoodsName
+		return x.MsgKey
 	}
 	return ""
 }
 
-func (x *ShopCartInfoResponse) GetGoodsImage() string {
+func (x *OutboxDeadLetterInfo) GetPayload() string {
 	if x != nil {
-		return x.GoodsImage
+		return x.Payload
 	}
 	return ""
 }
 
-func (x *ShopCartInfoResponse) GetGoodsPrice() float32 {
+func (x *OutboxDeadLetterInfo) GetAttempts() int32 {
 	if x != nil {
-		return x.GoodsPrice
+		return x.Attempts
 	}
 	return 0
 }
 
-func (x *ShopCartInfoResponse) GetNums() int32 {
+func (x *OutboxDeadLetterInfo) GetLastError() string {
 	if x != nil {
-		return x.Nums
+		return x.LastError
 	}
-	return 0
+	return ""
 }
 
-func (x *ShopCartInfoResponse) GetChecked() bool {
+func (x *OutboxDeadLetterInfo) GetReplayed() bool {
 	if x != nil {
-		return x.Checked
+		return x.Replayed
 	}
 	return false
 }
 
-var File_proto_order_proto protoreflect.FileDescriptor
-
-const file_proto_order_proto_rawDesc = "" +
+func (x *OutboxDeadLetterInfo) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+func (x *OutboxDeadLetterInfo) GetReplayedTime() int64 {
+	if x != nil {
+		return x.ReplayedTime
+	}
+	return 0
+}
+
+type OutboxDeadLetterListResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         int32                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*OutboxDeadLetterInfo `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboxDeadLetterListResponse) Reset() {
+	*x = OutboxDeadLetterListResponse{}
+	mi := &file_proto_order_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxDeadLetterListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxDeadLetterListResponse) ProtoMessage() {}
+
+func (x *OutboxDeadLetterListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxDeadLetterListResponse.ProtoReflect.Descriptor instead.
+func (*OutboxDeadLetterListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OutboxDeadLetterListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OutboxDeadLetterListResponse) GetData() []*OutboxDeadLetterInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type OutboxReplayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 死信ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutboxReplayRequest) Reset() {
+	*x = OutboxReplayRequest{}
+	mi := &file_proto_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutboxReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxReplayRequest) ProtoMessage() {}
+
+func (x *OutboxReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxReplayRequest.ProtoReflect.Descriptor instead.
+func (*OutboxReplayRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OutboxReplayRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type OrderTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 订单ID
+	OrderSn       string                 `protobuf:"bytes,2,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"`  // 订单号
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                   // 当前状态
+	Logs          []*OrderStatusLogInfo  `protobuf:"bytes,4,rep,name=logs,proto3" json:"logs,omitempty"`                       // 状态流转记录，按时间先后排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTimelineResponse) Reset() {
+	*x = OrderTimelineResponse{}
+	mi := &file_proto_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTimelineResponse) ProtoMessage() {}
+
+func (x *OrderTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTimelineResponse.ProtoReflect.Descriptor instead.
+func (*OrderTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *OrderTimelineResponse) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderTimelineResponse) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *OrderTimelineResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderTimelineResponse) GetLogs() []*OrderStatusLogInfo {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type UserInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	mi := &file_proto_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo) ProtoMessage() {}
+
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoodsId       int32                  `protobuf:"varint,3,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	GoodsName     string                 `protobuf:"bytes,4,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"`
+	GoodsImage    string                 `protobuf:"bytes,5,opt,name=goods_image,json=goodsImage,proto3" json:"goods_image,omitempty"`
+	Nums          int32                  `protobuf:"varint,7,opt,name=nums,proto3" json:"nums,omitempty"`
+	Checked       bool                   `protobuf:"varint,8,opt,name=checked,proto3" json:"checked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItemRequest) Reset() {
+	*x = CartItemRequest{}
+	mi := &file_proto_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemRequest) ProtoMessage() {}
+
+func (x *CartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemRequest.ProtoReflect.Descriptor instead.
+func (*CartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CartItemRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartItemRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartItemRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *CartItemRequest) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *CartItemRequest) GetGoodsImage() string {
+	if x != nil {
+		return x.GoodsImage
+	}
+	return ""
+}
+
+func (x *CartItemRequest) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+func (x *CartItemRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+type CartItemListResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Total         int32                   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	CartItems     []*ShopCartInfoResponse `protobuf:"bytes,2,rep,name=cart_items,json=cartItems,proto3" json:"cart_items,omitempty"`
+	GoodsDegraded bool                    `protobuf:"varint,3,opt,name=goods_degraded,json=goodsDegraded,proto3" json:"goods_degraded,omitempty"` // 商品服务不可用，未返回当前价格和上架状态
+	StockDegraded bool                    `protobuf:"varint,4,opt,name=stock_degraded,json=stockDegraded,proto3" json:"stock_degraded,omitempty"` // 库存服务不可用，未返回可用库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItemListResponse) Reset() {
+	*x = CartItemListResponse{}
+	mi := &file_proto_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemListResponse) ProtoMessage() {}
+
+func (x *CartItemListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemListResponse.ProtoReflect.Descriptor instead.
+func (*CartItemListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CartItemListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CartItemListResponse) GetCartItems() []*ShopCartInfoResponse {
+	if x != nil {
+		return x.CartItems
+	}
+	return nil
+}
+
+func (x *CartItemListResponse) GetGoodsDegraded() bool {
+	if x != nil {
+		return x.GoodsDegraded
+	}
+	return false
+}
+
+func (x *CartItemListResponse) GetStockDegraded() bool {
+	if x != nil {
+		return x.StockDegraded
+	}
+	return false
+}
+
+type ShopCartInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoodsId       int32                  `protobuf:"varint,3,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`
+	GoodsName     string                 `protobuf:"bytes,4,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"`
+	GoodsImage    string                 `protobuf:"bytes,5,opt,name=goods_image,json=goodsImage,proto3" json:"goods_image,omitempty"`
+	Nums          int32                  `protobuf:"varint,7,opt,name=nums,proto3" json:"nums,omitempty"`
+	Checked       bool                   `protobuf:"varint,8,opt,name=checked,proto3" json:"checked,omitempty"`
+	Price         int64                  `protobuf:"varint,9,opt,name=price,proto3" json:"price,omitempty"`                                    // 加购时的商品单价（分）
+	CurrentPrice  int64                  `protobuf:"varint,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"` // 当前售价（分）
+	PriceChanged  bool                   `protobuf:"varint,11,opt,name=price_changed,json=priceChanged,proto3" json:"price_changed,omitempty"` // 当前售价与加购时不同
+	PriceDelta    int64                  `protobuf:"varint,12,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`       // 当前售价减加购时价格（分），降价为负数
+	OnSale        bool                   `protobuf:"varint,13,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`                   // 是否上架
+	Stock         int32                  `protobuf:"varint,14,opt,name=stock,proto3" json:"stock,omitempty"`                                   // 可用库存
+	Available     bool                   `protobuf:"varint,15,opt,name=available,proto3" json:"available,omitempty"`                           // 上架且库存足够，可以下单
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShopCartInfoResponse) Reset() {
+	*x = ShopCartInfoResponse{}
+	mi := &file_proto_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShopCartInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShopCartInfoResponse) ProtoMessage() {}
+
+func (x *ShopCartInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShopCartInfoResponse.ProtoReflect.Descriptor instead.
+func (*ShopCartInfoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ShopCartInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *ShopCartInfoResponse) GetGoodsImage() string {
+	if x != nil {
+		return x.GoodsImage
+	}
+	return ""
+}
+
+func (x *ShopCartInfoResponse) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *ShopCartInfoResponse) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetCurrentPrice() int64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *ShopCartInfoResponse) GetPriceDelta() int64 {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *ShopCartInfoResponse) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ShopCartInfoResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type CartRefreshResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Updated       int32                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`                              // 更新了价格或商品信息的记录数
+	Removed       int32                   `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`                              // 移除的失效记录数
+	RemovedItems  []*ShopCartInfoResponse `protobuf:"bytes,3,rep,name=removed_items,json=removedItems,proto3" json:"removed_items,omitempty"` // 移除的记录
+	Cart          *CartItemListResponse   `protobuf:"bytes,4,opt,name=cart,proto3" json:"cart,omitempty"`                                     // 刷新后的购物车
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartRefreshResponse) Reset() {
+	*x = CartRefreshResponse{}
+	mi := &file_proto_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartRefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartRefreshResponse) ProtoMessage() {}
+
+func (x *CartRefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartRefreshResponse.ProtoReflect.Descriptor instead.
+func (*CartRefreshResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CartRefreshResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *CartRefreshResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *CartRefreshResponse) GetRemovedItems() []*ShopCartInfoResponse {
+	if x != nil {
+		return x.RemovedItems
+	}
+	return nil
+}
+
+func (x *CartRefreshResponse) GetCart() *CartItemListResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type PaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`   // 订单ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`      // 用户ID，提供时校验订单所属用户
+	PayType       string                 `protobuf:"bytes,3,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"`    // 支付渠道：alipay, wechat, mock
+	ClientIp      string                 `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 用户IP
+	ParentSn      string                 `protobuf:"bytes,5,opt,name=parent_sn,json=parentSn,proto3" json:"parent_sn,omitempty"` // 拆单后的父订单号，提供时按父订单支付，order_id可不填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentRequest) Reset() {
+	*x = PaymentRequest{}
+	mi := &file_proto_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRequest) ProtoMessage() {}
+
+func (x *PaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRequest.ProtoReflect.Descriptor instead.
+func (*PaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PaymentRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *PaymentRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PaymentRequest) GetPayType() string {
+	if x != nil {
+		return x.PayType
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *PaymentRequest) GetParentSn() string {
+	if x != nil {
+		return x.ParentSn
+	}
+	return ""
+}
+
+type PaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderSn       string                 `protobuf:"bytes,1,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"`                                                                                 // 订单号，即商户订单号
+	PayType       string                 `protobuf:"bytes,2,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"`                                                                                 // 支付渠道
+	PayUrl        string                 `protobuf:"bytes,4,opt,name=pay_url,json=payUrl,proto3" json:"pay_url,omitempty"`                                                                                    // 支付跳转链接或二维码内容
+	PayParams     map[string]string      `protobuf:"bytes,5,rep,name=pay_params,json=payParams,proto3" json:"pay_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 客户端调起支付的参数
+	ExpireTime    int64                  `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                                                                       // 支付截止时间
+	PayAmount     int64                  `protobuf:"varint,7,opt,name=pay_amount,json=payAmount,proto3" json:"pay_amount,omitempty"`                                                                          // 支付金额（分）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentResponse) Reset() {
+	*x = PaymentResponse{}
+	mi := &file_proto_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentResponse) ProtoMessage() {}
+
+func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentResponse.ProtoReflect.Descriptor instead.
+func (*PaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PaymentResponse) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetPayType() string {
+	if x != nil {
+		return x.PayType
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetPayUrl() string {
+	if x != nil {
+		return x.PayUrl
+	}
+	return ""
+}
+
+func (x *PaymentResponse) GetPayParams() map[string]string {
+	if x != nil {
+		return x.PayParams
+	}
+	return nil
+}
+
+func (x *PaymentResponse) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *PaymentResponse) GetPayAmount() int64 {
+	if x != nil {
+		return x.PayAmount
+	}
+	return 0
+}
+
+type PaymentNotifyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayType       string                 `protobuf:"bytes,1,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"`                                                            // 支付渠道
+	Headers       map[string]string      `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 回调请求头
+	Body          []byte                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`                                                                                 // 回调请求体原文
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentNotifyRequest) Reset() {
+	*x = PaymentNotifyRequest{}
+	mi := &file_proto_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentNotifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentNotifyRequest) ProtoMessage() {}
+
+func (x *PaymentNotifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentNotifyRequest.ProtoReflect.Descriptor instead.
+func (*PaymentNotifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PaymentNotifyRequest) GetPayType() string {
+	if x != nil {
+		return x.PayType
+	}
+	return ""
+}
+
+func (x *PaymentNotifyRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PaymentNotifyRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type PaymentNotifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`               // 是否处理成功
+	OrderSn       string                 `protobuf:"bytes,2,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"` // 订单号
+	Reply         string                 `protobuf:"bytes,3,opt,name=reply,proto3" json:"reply,omitempty"`                    // 需要原样返回给支付渠道的应答内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentNotifyResponse) Reset() {
+	*x = PaymentNotifyResponse{}
+	mi := &file_proto_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentNotifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentNotifyResponse) ProtoMessage() {}
+
+func (x *PaymentNotifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentNotifyResponse.ProtoReflect.Descriptor instead.
+func (*PaymentNotifyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PaymentNotifyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PaymentNotifyResponse) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *PaymentNotifyResponse) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+type RefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                  // 订单ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
+	OrderGoodsId  int32                  `protobuf:"varint,3,opt,name=order_goods_id,json=orderGoodsId,proto3" json:"order_goods_id,omitempty"` // 订单商品ID，0表示整单售后
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                                        // REFUND_ONLY(仅退款), RETURN_GOODS(退货退款)
+	Nums          int32                  `protobuf:"varint,6,opt,name=nums,proto3" json:"nums,omitempty"`                                       // 退货数量，0表示该商品可退的全部数量
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`                                    // 售后原因
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`                          // 问题描述
+	MessageId     int32                  `protobuf:"varint,9,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`            // 关联的售后留言ID
+	RefundAmount  int64                  `protobuf:"varint,10,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`  // 退款金额（分），0表示可退的全部金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundRequest) Reset() {
+	*x = RefundRequest{}
+	mi := &file_proto_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundRequest) ProtoMessage() {}
+
+func (x *RefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundRequest.ProtoReflect.Descriptor instead.
+func (*RefundRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RefundRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+type RefundAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`           // 售后单ID
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"` // 是否同意
+	Remark        string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`    // 审核备注，拒绝时必填
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundAuditRequest) Reset() {
+	*x = RefundAuditRequest{}
+	mi := &file_proto_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundAuditRequest) ProtoMessage() {}
+
+func (x *RefundAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundAuditRequest.ProtoReflect.Descriptor instead.
+func (*RefundAuditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RefundAuditRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefundAuditRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *RefundAuditRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type RefundOperateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                       // 售后单ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID，提供时校验售后单所属用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOperateRequest) Reset() {
+	*x = RefundOperateRequest{}
+	mi := &file_proto_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOperateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOperateRequest) ProtoMessage() {}
+
+func (x *RefundOperateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOperateRequest.ProtoReflect.Descriptor instead.
+func (*RefundOperateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RefundOperateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefundOperateRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RefundFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	OrderId       int32                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`    // 订单ID
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                      // 售后状态
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundFilterRequest) Reset() {
+	*x = RefundFilterRequest{}
+	mi := &file_proto_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundFilterRequest) ProtoMessage() {}
+
+func (x *RefundFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundFilterRequest.ProtoReflect.Descriptor instead.
+func (*RefundFilterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RefundFilterRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefundFilterRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RefundFilterRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RefundFilterRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RefundFilterRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RefundGoodsInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderGoodsId  int32                  `protobuf:"varint,1,opt,name=order_goods_id,json=orderGoodsId,proto3" json:"order_goods_id,omitempty"` // 订单商品ID
+	GoodsId       int32                  `protobuf:"varint,2,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`                  // 商品ID
+	Nums          int32                  `protobuf:"varint,3,opt,name=nums,proto3" json:"nums,omitempty"`                                       // 退货数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundGoodsInfo) Reset() {
+	*x = RefundGoodsInfo{}
+	mi := &file_proto_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundGoodsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundGoodsInfo) ProtoMessage() {}
+
+func (x *RefundGoodsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundGoodsInfo.ProtoReflect.Descriptor instead.
+func (*RefundGoodsInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RefundGoodsInfo) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *RefundGoodsInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *RefundGoodsInfo) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+type RefundInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                           // 售后单ID
+	RefundSn      string                 `protobuf:"bytes,2,opt,name=refund_sn,json=refundSn,proto3" json:"refund_sn,omitempty"`                // 售后单号
+	OrderId       int32                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                  // 订单ID
+	OrderSn       string                 `protobuf:"bytes,4,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"`                   // 订单号
+	UserId        int32                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
+	OrderGoodsId  int32                  `protobuf:"varint,6,opt,name=order_goods_id,json=orderGoodsId,proto3" json:"order_goods_id,omitempty"` // 订单商品ID
+	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                        // 售后类型
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                    // 售后状态
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`                                   // 售后原因
+	Description   string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`                         // 问题描述
+	AuditRemark   string                 `protobuf:"bytes,12,opt,name=audit_remark,json=auditRemark,proto3" json:"audit_remark,omitempty"`      // 审核备注
+	FailReason    string                 `protobuf:"bytes,13,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`         // 退款失败原因
+	MessageId     int32                  `protobuf:"varint,14,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`           // 关联的售后留言ID
+	Goods         []*RefundGoodsInfo     `protobuf:"bytes,15,rep,name=goods,proto3" json:"goods,omitempty"`                                     // 退货商品
+	AddTime       int64                  `protobuf:"varint,16,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`                 // 申请时间
+	RefundedTime  int64                  `protobuf:"varint,17,opt,name=refunded_time,json=refundedTime,proto3" json:"refunded_time,omitempty"`  // 退款成功时间
+	RefundAmount  int64                  `protobuf:"varint,18,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`  // 退款金额（分）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundInfoResponse) Reset() {
+	*x = RefundInfoResponse{}
+	mi := &file_proto_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundInfoResponse) ProtoMessage() {}
+
+func (x *RefundInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundInfoResponse.ProtoReflect.Descriptor instead.
+func (*RefundInfoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RefundInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefundInfoResponse) GetRefundSn() string {
+	if x != nil {
+		return x.RefundSn
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RefundInfoResponse) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefundInfoResponse) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *RefundInfoResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetAuditRemark() string {
+	if x != nil {
+		return x.AuditRemark
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetFailReason() string {
+	if x != nil {
+		return x.FailReason
+	}
+	return ""
+}
+
+func (x *RefundInfoResponse) GetMessageId() int32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *RefundInfoResponse) GetGoods() []*RefundGoodsInfo {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *RefundInfoResponse) GetAddTime() int64 {
+	if x != nil {
+		return x.AddTime
+	}
+	return 0
+}
+
+func (x *RefundInfoResponse) GetRefundedTime() int64 {
+	if x != nil {
+		return x.RefundedTime
+	}
+	return 0
+}
+
+func (x *RefundInfoResponse) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+type RefundListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*RefundInfoResponse  `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 售后单列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundListResponse) Reset() {
+	*x = RefundListResponse{}
+	mi := &file_proto_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundListResponse) ProtoMessage() {}
+
+func (x *RefundListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundListResponse.ProtoReflect.Descriptor instead.
+func (*RefundListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RefundListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RefundListResponse) GetData() []*RefundInfoResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CouponTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                         // 优惠券名称
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                         // FIXED(立减), PERCENT(折扣), FULL_REDUCTION(满减)
+	Threshold     int64                  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`                              // 使用门槛（分），0表示无门槛
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                                    // 立减和满减的优惠金额（分）
+	Percent       int32                  `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`                                  // 折扣百分比，85表示85折
+	MaxDiscount   int64                  `protobuf:"varint,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`       // 折扣最高优惠（分），0表示不限
+	Scope         string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`                                       // ALL(全部商品), CATEGORY(指定分类), BRAND(指定品牌)
+	ScopeId       int32                  `protobuf:"varint,8,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`                   // 分类ID或品牌ID
+	Total         int32                  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`                                      // 发放总量，0表示不限
+	PerUserLimit  int32                  `protobuf:"varint,10,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"` // 每人限领数量，0表示不限
+	ValidDays     int32                  `protobuf:"varint,11,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`            // 领取后有效天数，0表示以结束时间为准
+	StartTime     int64                  `protobuf:"varint,12,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`            // 发放开始时间
+	EndTime       int64                  `protobuf:"varint,13,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                  // 发放结束时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponTemplateRequest) Reset() {
+	*x = CouponTemplateRequest{}
+	mi := &file_proto_order_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateRequest) ProtoMessage() {}
+
+func (x *CouponTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CouponTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponTemplateRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CouponTemplateRequest) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CouponTemplateRequest) GetScopeId() int32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CouponTemplateRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type CouponTemplateInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                            // 模板ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                         // 优惠券名称
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                         // 优惠类型
+	Threshold     int64                  `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`                              // 使用门槛（分）
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`                                    // 优惠金额（分）
+	Percent       int32                  `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`                                  // 折扣百分比
+	MaxDiscount   int64                  `protobuf:"varint,7,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"`       // 折扣最高优惠（分）
+	Scope         string                 `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`                                       // 适用范围
+	ScopeId       int32                  `protobuf:"varint,9,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`                   // 分类ID或品牌ID
+	Total         int32                  `protobuf:"varint,10,opt,name=total,proto3" json:"total,omitempty"`                                     // 发放总量
+	Issued        int32                  `protobuf:"varint,11,opt,name=issued,proto3" json:"issued,omitempty"`                                   // 已发放数量
+	PerUserLimit  int32                  `protobuf:"varint,12,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"` // 每人限领数量
+	ValidDays     int32                  `protobuf:"varint,13,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`            // 领取后有效天数
+	StartTime     int64                  `protobuf:"varint,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`            // 发放开始时间
+	EndTime       int64                  `protobuf:"varint,15,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                  // 发放结束时间
+	Enabled       bool                   `protobuf:"varint,16,opt,name=enabled,proto3" json:"enabled,omitempty"`                                 // 是否启用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponTemplateInfo) Reset() {
+	*x = CouponTemplateInfo{}
+	mi := &file_proto_order_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponTemplateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateInfo) ProtoMessage() {}
+
+func (x *CouponTemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateInfo.ProtoReflect.Descriptor instead.
+func (*CouponTemplateInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CouponTemplateInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponTemplateInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CouponTemplateInfo) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *CouponTemplateInfo) GetScopeId() int32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetIssued() int32 {
+	if x != nil {
+		return x.Issued
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetPerUserLimit() int32 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CouponTemplateInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type CouponTemplateListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*CouponTemplateInfo  `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 模板列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponTemplateListResponse) Reset() {
+	*x = CouponTemplateListResponse{}
+	mi := &file_proto_order_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponTemplateListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponTemplateListResponse) ProtoMessage() {}
+
+func (x *CouponTemplateListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponTemplateListResponse.ProtoReflect.Descriptor instead.
+func (*CouponTemplateListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CouponTemplateListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CouponTemplateListResponse) GetData() []*CouponTemplateInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CouponIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int32                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // 优惠券模板ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponIssueRequest) Reset() {
+	*x = CouponIssueRequest{}
+	mi := &file_proto_order_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponIssueRequest) ProtoMessage() {}
+
+func (x *CouponIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponIssueRequest.ProtoReflect.Descriptor instead.
+func (*CouponIssueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CouponIssueRequest) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CouponIssueRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UserCouponFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                      // UNUSED(未使用), USED(已使用), EXPIRED(已过期)，为空表示全部
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCouponFilterRequest) Reset() {
+	*x = UserCouponFilterRequest{}
+	mi := &file_proto_order_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCouponFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponFilterRequest) ProtoMessage() {}
+
+func (x *UserCouponFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponFilterRequest.ProtoReflect.Descriptor instead.
+func (*UserCouponFilterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UserCouponFilterRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserCouponFilterRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserCouponFilterRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *UserCouponFilterRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type UserCouponInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // 用户优惠券ID
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 用户ID
+	TemplateId    int32                  `protobuf:"varint,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`    // 优惠券模板ID
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                   // 优惠券名称
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                                   // 优惠类型
+	Threshold     int64                  `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`                        // 使用门槛（分）
+	Amount        int64                  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`                              // 优惠金额（分）
+	Percent       int32                  `protobuf:"varint,8,opt,name=percent,proto3" json:"percent,omitempty"`                            // 折扣百分比
+	MaxDiscount   int64                  `protobuf:"varint,9,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"` // 折扣最高优惠（分）
+	Scope         string                 `protobuf:"bytes,10,opt,name=scope,proto3" json:"scope,omitempty"`                                // 适用范围
+	ScopeId       int32                  `protobuf:"varint,11,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`            // 分类ID或品牌ID
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`                              // UNUSED(未使用), USED(已使用), EXPIRED(已过期)
+	ExpireTime    int64                  `protobuf:"varint,13,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`   // 过期时间
+	OrderSn       string                 `protobuf:"bytes,14,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"`             // 核销订单号
+	UsedTime      int64                  `protobuf:"varint,15,opt,name=used_time,json=usedTime,proto3" json:"used_time,omitempty"`         // 核销时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCouponInfo) Reset() {
+	*x = UserCouponInfo{}
+	mi := &file_proto_order_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCouponInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponInfo) ProtoMessage() {}
+
+func (x *UserCouponInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponInfo.ProtoReflect.Descriptor instead.
+func (*UserCouponInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UserCouponInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetTemplateId() int32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserCouponInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserCouponInfo) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *UserCouponInfo) GetScopeId() int32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserCouponInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *UserCouponInfo) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *UserCouponInfo) GetUsedTime() int64 {
+	if x != nil {
+		return x.UsedTime
+	}
+	return 0
+}
+
+type UserCouponListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*UserCouponInfo      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 优惠券列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCouponListResponse) Reset() {
+	*x = UserCouponListResponse{}
+	mi := &file_proto_order_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCouponListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCouponListResponse) ProtoMessage() {}
+
+func (x *UserCouponListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCouponListResponse.ProtoReflect.Descriptor instead.
+func (*UserCouponListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UserCouponListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserCouponListResponse) GetData() []*UserCouponInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                   // 活动名称
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                   // FIXED(立减), PERCENT(折扣), FULL_REDUCTION(满减)
+	Threshold     int64                  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`                        // 使用门槛（分），0表示无门槛
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                              // 立减和满减的优惠金额（分）
+	Percent       int32                  `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`                            // 折扣百分比，85表示85折
+	MaxDiscount   int64                  `protobuf:"varint,6,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"` // 折扣最高优惠（分），0表示不限
+	Scope         string                 `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`                                 // ALL(全部商品), CATEGORY(指定分类), BRAND(指定品牌)
+	ScopeId       int32                  `protobuf:"varint,8,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`             // 分类ID或品牌ID
+	StartTime     int64                  `protobuf:"varint,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`       // 开始时间
+	EndTime       int64                  `protobuf:"varint,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`            // 结束时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionRequest) Reset() {
+	*x = PromotionRequest{}
+	mi := &file_proto_order_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionRequest) ProtoMessage() {}
+
+func (x *PromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionRequest.ProtoReflect.Descriptor instead.
+func (*PromotionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PromotionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromotionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PromotionRequest) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *PromotionRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PromotionRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PromotionRequest) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *PromotionRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *PromotionRequest) GetScopeId() int32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+func (x *PromotionRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PromotionRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type PromotionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // 活动ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                   // 活动名称
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                   // 优惠类型
+	Threshold     int64                  `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`                        // 使用门槛（分）
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`                              // 优惠金额（分）
+	Percent       int32                  `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`                            // 折扣百分比
+	MaxDiscount   int64                  `protobuf:"varint,7,opt,name=max_discount,json=maxDiscount,proto3" json:"max_discount,omitempty"` // 折扣最高优惠（分）
+	Scope         string                 `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`                                 // 适用范围
+	ScopeId       int32                  `protobuf:"varint,9,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`             // 分类ID或品牌ID
+	StartTime     int64                  `protobuf:"varint,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`      // 开始时间
+	EndTime       int64                  `protobuf:"varint,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`            // 结束时间
+	Enabled       bool                   `protobuf:"varint,12,opt,name=enabled,proto3" json:"enabled,omitempty"`                           // 是否启用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionInfo) Reset() {
+	*x = PromotionInfo{}
+	mi := &file_proto_order_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionInfo) ProtoMessage() {}
+
+func (x *PromotionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionInfo.ProtoReflect.Descriptor instead.
+func (*PromotionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PromotionInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromotionInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PromotionInfo) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetMaxDiscount() int64 {
+	if x != nil {
+		return x.MaxDiscount
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *PromotionInfo) GetScopeId() int32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *PromotionInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type PromotionFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // 只返回当前有效的
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionFilterRequest) Reset() {
+	*x = PromotionFilterRequest{}
+	mi := &file_proto_order_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionFilterRequest) ProtoMessage() {}
+
+func (x *PromotionFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionFilterRequest.ProtoReflect.Descriptor instead.
+func (*PromotionFilterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PromotionFilterRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *PromotionFilterRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PromotionFilterRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type PromotionListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	Data          []*PromotionInfo       `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`    // 活动列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionListResponse) Reset() {
+	*x = PromotionListResponse{}
+	mi := &file_proto_order_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionListResponse) ProtoMessage() {}
+
+func (x *PromotionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionListResponse.ProtoReflect.Descriptor instead.
+func (*PromotionListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PromotionListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PromotionListResponse) GetData() []*PromotionInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PriceCalculateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	CouponId      int32                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"` // 使用的用户优惠券ID，0表示不使用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceCalculateRequest) Reset() {
+	*x = PriceCalculateRequest{}
+	mi := &file_proto_order_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceCalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCalculateRequest) ProtoMessage() {}
+
+func (x *PriceCalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCalculateRequest.ProtoReflect.Descriptor instead.
+func (*PriceCalculateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PriceCalculateRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PriceCalculateRequest) GetCouponId() int32 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+type DiscountInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                      // PROMOTION(促销活动), COUPON(优惠券)
+	SourceId      int32                  `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // 活动ID或用户优惠券ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                          // 优惠名称
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                     // 优惠金额（分）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountInfo) Reset() {
+	*x = DiscountInfo{}
+	mi := &file_proto_order_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountInfo) ProtoMessage() {}
+
+func (x *DiscountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountInfo.ProtoReflect.Descriptor instead.
+func (*DiscountInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DiscountInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DiscountInfo) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *DiscountInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiscountInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PriceItemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`      // 商品ID
+	GoodsName     string                 `protobuf:"bytes,2,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"` // 商品名称
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`                         // 商品单价（分）
+	Nums          int32                  `protobuf:"varint,4,opt,name=nums,proto3" json:"nums,omitempty"`                           // 商品数量
+	Discount      int64                  `protobuf:"varint,5,opt,name=discount,proto3" json:"discount,omitempty"`                   // 分摊的优惠金额（分）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceItemInfo) Reset() {
+	*x = PriceItemInfo{}
+	mi := &file_proto_order_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceItemInfo) ProtoMessage() {}
+
+func (x *PriceItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceItemInfo.ProtoReflect.Descriptor instead.
+func (*PriceItemInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PriceItemInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *PriceItemInfo) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *PriceItemInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceItemInfo) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+func (x *PriceItemInfo) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+type CouponOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponId      int32                  `protobuf:"varint,1,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"` // 用户优惠券ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                          // 优惠券名称
+	Usable        bool                   `protobuf:"varint,3,opt,name=usable,proto3" json:"usable,omitempty"`                     // 当前购物车是否可用
+	Discount      int64                  `protobuf:"varint,4,opt,name=discount,proto3" json:"discount,omitempty"`                 // 使用该券可优惠的金额（分）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponOption) Reset() {
+	*x = CouponOption{}
+	mi := &file_proto_order_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponOption) ProtoMessage() {}
+
+func (x *CouponOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponOption.ProtoReflect.Descriptor instead.
+func (*CouponOption) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CouponOption) GetCouponId() int32 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *CouponOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponOption) GetUsable() bool {
+	if x != nil {
+		return x.Usable
+	}
+	return false
+}
+
+func (x *CouponOption) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+type PriceCalculateResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GoodsTotal        int64                  `protobuf:"varint,1,opt,name=goods_total,json=goodsTotal,proto3" json:"goods_total,omitempty"`                      // 商品总额（分）
+	PromotionDiscount int64                  `protobuf:"varint,2,opt,name=promotion_discount,json=promotionDiscount,proto3" json:"promotion_discount,omitempty"` // 活动优惠（分）
+	CouponDiscount    int64                  `protobuf:"varint,3,opt,name=coupon_discount,json=couponDiscount,proto3" json:"coupon_discount,omitempty"`          // 优惠券优惠（分）
+	Payable           int64                  `protobuf:"varint,4,opt,name=payable,proto3" json:"payable,omitempty"`                                              // 应付金额（分）
+	Items             []*PriceItemInfo       `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`                                                   // 商品明细
+	Discounts         []*DiscountInfo        `protobuf:"bytes,6,rep,name=discounts,proto3" json:"discounts,omitempty"`                                           // 优惠明细
+	Coupons           []*CouponOption        `protobuf:"bytes,7,rep,name=coupons,proto3" json:"coupons,omitempty"`                                               // 用户可选的优惠券
+	ShippingFee       int64                  `protobuf:"varint,8,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`                   // 运费（分）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PriceCalculateResponse) Reset() {
+	*x = PriceCalculateResponse{}
+	mi := &file_proto_order_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceCalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCalculateResponse) ProtoMessage() {}
+
+func (x *PriceCalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCalculateResponse.ProtoReflect.Descriptor instead.
+func (*PriceCalculateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PriceCalculateResponse) GetGoodsTotal() int64 {
+	if x != nil {
+		return x.GoodsTotal
+	}
+	return 0
+}
+
+func (x *PriceCalculateResponse) GetPromotionDiscount() int64 {
+	if x != nil {
+		return x.PromotionDiscount
+	}
+	return 0
+}
+
+func (x *PriceCalculateResponse) GetCouponDiscount() int64 {
+	if x != nil {
+		return x.CouponDiscount
+	}
+	return 0
+}
+
+func (x *PriceCalculateResponse) GetPayable() int64 {
+	if x != nil {
+		return x.Payable
+	}
+	return 0
+}
+
+func (x *PriceCalculateResponse) GetItems() []*PriceItemInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PriceCalculateResponse) GetDiscounts() []*DiscountInfo {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *PriceCalculateResponse) GetCoupons() []*CouponOption {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *PriceCalculateResponse) GetShippingFee() int64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+type OrderGoodsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"` // 商品ID
+	Nums          int32                  `protobuf:"varint,2,opt,name=nums,proto3" json:"nums,omitempty"`                      // 购买数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderGoodsItem) Reset() {
+	*x = OrderGoodsItem{}
+	mi := &file_proto_order_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderGoodsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderGoodsItem) ProtoMessage() {}
+
+func (x *OrderGoodsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderGoodsItem.ProtoReflect.Descriptor instead.
+func (*OrderGoodsItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *OrderGoodsItem) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *OrderGoodsItem) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+type OrderPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	CouponId      int32                  `protobuf:"varint,2,opt,name=coupon_id,json=couponId,proto3" json:"coupon_id,omitempty"` // 使用的用户优惠券ID，0表示不使用
+	Items         []*OrderGoodsItem      `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`                        // 指定商品，为空时使用购物车中选中的商品
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderPreviewRequest) Reset() {
+	*x = OrderPreviewRequest{}
+	mi := &file_proto_order_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderPreviewRequest) ProtoMessage() {}
+
+func (x *OrderPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderPreviewRequest.ProtoReflect.Descriptor instead.
+func (*OrderPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *OrderPreviewRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrderPreviewRequest) GetCouponId() int32 {
+	if x != nil {
+		return x.CouponId
+	}
+	return 0
+}
+
+func (x *OrderPreviewRequest) GetItems() []*OrderGoodsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PreviewItemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`         // 商品ID
+	GoodsName     string                 `protobuf:"bytes,2,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"`    // 商品名称
+	GoodsImage    string                 `protobuf:"bytes,3,opt,name=goods_image,json=goodsImage,proto3" json:"goods_image,omitempty"` // 商品图片
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                            // 当前售价（分）
+	Nums          int32                  `protobuf:"varint,5,opt,name=nums,proto3" json:"nums,omitempty"`                              // 购买数量
+	Discount      int64                  `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"`                      // 分摊的优惠金额（分）
+	OnSale        bool                   `protobuf:"varint,7,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`            // 是否上架
+	Stock         int32                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`                            // 当前库存
+	Available     bool                   `protobuf:"varint,9,opt,name=available,proto3" json:"available,omitempty"`                    // 是否可以下单
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`                          // 不可下单的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewItemInfo) Reset() {
+	*x = PreviewItemInfo{}
+	mi := &file_proto_order_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewItemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewItemInfo) ProtoMessage() {}
+
+func (x *PreviewItemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewItemInfo.ProtoReflect.Descriptor instead.
+func (*PreviewItemInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PreviewItemInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *PreviewItemInfo) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *PreviewItemInfo) GetGoodsImage() string {
+	if x != nil {
+		return x.GoodsImage
+	}
+	return ""
+}
+
+func (x *PreviewItemInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PreviewItemInfo) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+func (x *PreviewItemInfo) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *PreviewItemInfo) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *PreviewItemInfo) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *PreviewItemInfo) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *PreviewItemInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type OrderPreviewResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GoodsTotal        int64                  `protobuf:"varint,1,opt,name=goods_total,json=goodsTotal,proto3" json:"goods_total,omitempty"`                      // 商品总额（分），只统计可下单的商品
+	ShippingFee       int64                  `protobuf:"varint,2,opt,name=shipping_fee,json=shippingFee,proto3" json:"shipping_fee,omitempty"`                   // 运费（分）
+	PromotionDiscount int64                  `protobuf:"varint,3,opt,name=promotion_discount,json=promotionDiscount,proto3" json:"promotion_discount,omitempty"` // 活动优惠（分）
+	CouponDiscount    int64                  `protobuf:"varint,4,opt,name=coupon_discount,json=couponDiscount,proto3" json:"coupon_discount,omitempty"`          // 优惠券优惠（分）
+	Payable           int64                  `protobuf:"varint,5,opt,name=payable,proto3" json:"payable,omitempty"`                                              // 应付金额（分）
+	Items             []*PreviewItemInfo     `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                                                   // 商品明细
+	Discounts         []*DiscountInfo        `protobuf:"bytes,7,rep,name=discounts,proto3" json:"discounts,omitempty"`                                           // 优惠明细
+	Available         bool                   `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`                                          // 所有商品都可以下单
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrderPreviewResponse) Reset() {
+	*x = OrderPreviewResponse{}
+	mi := &file_proto_order_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderPreviewResponse) ProtoMessage() {}
+
+func (x *OrderPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderPreviewResponse.ProtoReflect.Descriptor instead.
+func (*OrderPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OrderPreviewResponse) GetGoodsTotal() int64 {
+	if x != nil {
+		return x.GoodsTotal
+	}
+	return 0
+}
+
+func (x *OrderPreviewResponse) GetShippingFee() int64 {
+	if x != nil {
+		return x.ShippingFee
+	}
+	return 0
+}
+
+func (x *OrderPreviewResponse) GetPromotionDiscount() int64 {
+	if x != nil {
+		return x.PromotionDiscount
+	}
+	return 0
+}
+
+func (x *OrderPreviewResponse) GetCouponDiscount() int64 {
+	if x != nil {
+		return x.CouponDiscount
+	}
+	return 0
+}
+
+func (x *OrderPreviewResponse) GetPayable() int64 {
+	if x != nil {
+		return x.Payable
+	}
+	return 0
+}
+
+func (x *OrderPreviewResponse) GetItems() []*PreviewItemInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderPreviewResponse) GetDiscounts() []*DiscountInfo {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *OrderPreviewResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type GuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 游客会话令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestCartRequest) Reset() {
+	*x = GuestCartRequest{}
+	mi := &file_proto_order_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestCartRequest) ProtoMessage() {}
+
+func (x *GuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestCartRequest.ProtoReflect.Descriptor instead.
+func (*GuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GuestCartRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type GuestCartItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 游客会话令牌
+	GoodsId       int32                  `protobuf:"varint,2,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`               // 商品ID
+	Nums          int32                  `protobuf:"varint,3,opt,name=nums,proto3" json:"nums,omitempty"`                                    // 商品数量
+	Checked       bool                   `protobuf:"varint,4,opt,name=checked,proto3" json:"checked,omitempty"`                              // 是否选中
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestCartItemRequest) Reset() {
+	*x = GuestCartItemRequest{}
+	mi := &file_proto_order_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestCartItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestCartItemRequest) ProtoMessage() {}
+
+func (x *GuestCartItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestCartItemRequest.ProtoReflect.Descriptor instead.
+func (*GuestCartItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GuestCartItemRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GuestCartItemRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *GuestCartItemRequest) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+func (x *GuestCartItemRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+type GuestCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 游客会话令牌
+	Cart          *CartItemListResponse  `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`                                     // 游客购物车
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestCartResponse) Reset() {
+	*x = GuestCartResponse{}
+	mi := &file_proto_order_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestCartResponse) ProtoMessage() {}
+
+func (x *GuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestCartResponse.ProtoReflect.Descriptor instead.
+func (*GuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GuestCartResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GuestCartResponse) GetCart() *CartItemListResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type MergeCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 游客会话令牌
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                  // 登录的用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartRequest) Reset() {
+	*x = MergeCartRequest{}
+	mi := &file_proto_order_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartRequest) ProtoMessage() {}
+
+func (x *MergeCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MergeCartRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *MergeCartRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type MergeCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`   // 新加入用户购物车的商品数
+	Merged        int32                  `protobuf:"varint,2,opt,name=merged,proto3" json:"merged,omitempty"` // 与用户购物车已有商品合并的商品数
+	Capped        int32                  `protobuf:"varint,3,opt,name=capped,proto3" json:"capped,omitempty"` // 超过单个商品最大数量被截断的商品数
+	Cart          *CartItemListResponse  `protobuf:"bytes,4,opt,name=cart,proto3" json:"cart,omitempty"`      // 合并后的用户购物车
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartResponse) Reset() {
+	*x = MergeCartResponse{}
+	mi := &file_proto_order_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartResponse) ProtoMessage() {}
+
+func (x *MergeCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartResponse.ProtoReflect.Descriptor instead.
+func (*MergeCartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MergeCartResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *MergeCartResponse) GetMerged() int32 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *MergeCartResponse) GetCapped() int32 {
+	if x != nil {
+		return x.Capped
+	}
+	return 0
+}
+
+func (x *MergeCartResponse) GetCart() *CartItemListResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type CartBatchCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Ids           []int32                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`              // 购物车记录ID，为空时设置用户的全部记录
+	Checked       bool                   `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`             // 选中状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartBatchCheckRequest) Reset() {
+	*x = CartBatchCheckRequest{}
+	mi := &file_proto_order_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBatchCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBatchCheckRequest) ProtoMessage() {}
+
+func (x *CartBatchCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBatchCheckRequest.ProtoReflect.Descriptor instead.
+func (*CartBatchCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CartBatchCheckRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartBatchCheckRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *CartBatchCheckRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+type CartBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Ids           []int32                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`              // 购物车记录ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartBatchRequest) Reset() {
+	*x = CartBatchRequest{}
+	mi := &file_proto_order_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBatchRequest) ProtoMessage() {}
+
+func (x *CartBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBatchRequest.ProtoReflect.Descriptor instead.
+func (*CartBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CartBatchRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartBatchRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type CartItemNums struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`     // 购物车记录ID
+	Nums          int32                  `protobuf:"varint,2,opt,name=nums,proto3" json:"nums,omitempty"` // 商品数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItemNums) Reset() {
+	*x = CartItemNums{}
+	mi := &file_proto_order_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItemNums) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItemNums) ProtoMessage() {}
+
+func (x *CartItemNums) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItemNums.ProtoReflect.Descriptor instead.
+func (*CartItemNums) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CartItemNums) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartItemNums) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+type CartBatchUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Items         []*CartItemNums        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                  // 要修改的记录和数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartBatchUpdateRequest) Reset() {
+	*x = CartBatchUpdateRequest{}
+	mi := &file_proto_order_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartBatchUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartBatchUpdateRequest) ProtoMessage() {}
+
+func (x *CartBatchUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartBatchUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CartBatchUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CartBatchUpdateRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartBatchUpdateRequest) GetItems() []*CartItemNums {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CartSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Affected      int32                  `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`                                // 本次操作影响的记录数
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                      // 购物车记录数
+	SelectedCount int32                  `protobuf:"varint,3,opt,name=selected_count,json=selectedCount,proto3" json:"selected_count,omitempty"` // 选中的记录数
+	SelectedNums  int32                  `protobuf:"varint,4,opt,name=selected_nums,json=selectedNums,proto3" json:"selected_nums,omitempty"`    // 选中的商品件数
+	SelectedTotal int64                  `protobuf:"varint,5,opt,name=selected_total,json=selectedTotal,proto3" json:"selected_total,omitempty"` // 选中商品按加购价格计算的总额（分），实际应付以下单预览为准
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartSummaryResponse) Reset() {
+	*x = CartSummaryResponse{}
+	mi := &file_proto_order_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartSummaryResponse) ProtoMessage() {}
+
+func (x *CartSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartSummaryResponse.ProtoReflect.Descriptor instead.
+func (*CartSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CartSummaryResponse) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetSelectedCount() int32 {
+	if x != nil {
+		return x.SelectedCount
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetSelectedNums() int32 {
+	if x != nil {
+		return x.SelectedNums
+	}
+	return 0
+}
+
+func (x *CartSummaryResponse) GetSelectedTotal() int64 {
+	if x != nil {
+		return x.SelectedTotal
+	}
+	return 0
+}
+
+type ShipGoodsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderGoodsId  int32                  `protobuf:"varint,1,opt,name=order_goods_id,json=orderGoodsId,proto3" json:"order_goods_id,omitempty"` // 订单商品ID
+	Nums          int32                  `protobuf:"varint,2,opt,name=nums,proto3" json:"nums,omitempty"`                                       // 发货数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipGoodsItem) Reset() {
+	*x = ShipGoodsItem{}
+	mi := &file_proto_order_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipGoodsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipGoodsItem) ProtoMessage() {}
+
+func (x *ShipGoodsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipGoodsItem.ProtoReflect.Descriptor instead.
+func (*ShipGoodsItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ShipGoodsItem) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *ShipGoodsItem) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+type OrderShipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int32                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`         // 订单ID
+	Carrier       string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`                         // 物流公司编码
+	TrackingNo    string                 `protobuf:"bytes,3,opt,name=tracking_no,json=trackingNo,proto3" json:"tracking_no,omitempty"` // 物流单号
+	Items         []*ShipGoodsItem       `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                             // 本次发货的商品，为空时发出全部未发货商品
+	ShippedAt     int64                  `protobuf:"varint,5,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`   // 发货时间，为0时使用当前时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderShipRequest) Reset() {
+	*x = OrderShipRequest{}
+	mi := &file_proto_order_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderShipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderShipRequest) ProtoMessage() {}
+
+func (x *OrderShipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderShipRequest.ProtoReflect.Descriptor instead.
+func (*OrderShipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *OrderShipRequest) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderShipRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *OrderShipRequest) GetTrackingNo() string {
+	if x != nil {
+		return x.TrackingNo
+	}
+	return ""
+}
+
+func (x *OrderShipRequest) GetItems() []*ShipGoodsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderShipRequest) GetShippedAt() int64 {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return 0
+}
+
+type ShipmentTrackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    int32                  `protobuf:"varint,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"` // 发货单ID
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                            // 物流状态
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`                        // 所在地点
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                  // 轨迹描述
+	EventTime     int64                  `protobuf:"varint,5,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`    // 轨迹发生时间，为0时使用当前时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentTrackRequest) Reset() {
+	*x = ShipmentTrackRequest{}
+	mi := &file_proto_order_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentTrackRequest) ProtoMessage() {}
+
+func (x *ShipmentTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentTrackRequest.ProtoReflect.Descriptor instead.
+func (*ShipmentTrackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ShipmentTrackRequest) GetShipmentId() int32 {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return 0
+}
+
+func (x *ShipmentTrackRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShipmentTrackRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ShipmentTrackRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShipmentTrackRequest) GetEventTime() int64 {
+	if x != nil {
+		return x.EventTime
+	}
+	return 0
+}
+
+type ShipmentGoodsInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderGoodsId  int32                  `protobuf:"varint,1,opt,name=order_goods_id,json=orderGoodsId,proto3" json:"order_goods_id,omitempty"` // 订单商品ID
+	GoodsId       int32                  `protobuf:"varint,2,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`                  // 商品ID
+	Nums          int32                  `protobuf:"varint,3,opt,name=nums,proto3" json:"nums,omitempty"`                                       // 发货数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentGoodsInfo) Reset() {
+	*x = ShipmentGoodsInfo{}
+	mi := &file_proto_order_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentGoodsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentGoodsInfo) ProtoMessage() {}
+
+func (x *ShipmentGoodsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentGoodsInfo.ProtoReflect.Descriptor instead.
+func (*ShipmentGoodsInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ShipmentGoodsInfo) GetOrderGoodsId() int32 {
+	if x != nil {
+		return x.OrderGoodsId
+	}
+	return 0
+}
+
+func (x *ShipmentGoodsInfo) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *ShipmentGoodsInfo) GetNums() int32 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+type ShipmentTrackInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                         // 物流状态
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`                     // 所在地点
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`               // 轨迹描述
+	EventTime     int64                  `protobuf:"varint,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"` // 轨迹发生时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentTrackInfo) Reset() {
+	*x = ShipmentTrackInfo{}
+	mi := &file_proto_order_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentTrackInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentTrackInfo) ProtoMessage() {}
+
+func (x *ShipmentTrackInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentTrackInfo.ProtoReflect.Descriptor instead.
+func (*ShipmentTrackInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ShipmentTrackInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShipmentTrackInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ShipmentTrackInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShipmentTrackInfo) GetEventTime() int64 {
+	if x != nil {
+		return x.EventTime
+	}
+	return 0
+}
+
+type ShipmentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // 发货单ID
+	OrderId       int32                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`         // 订单ID
+	OrderSn       string                 `protobuf:"bytes,3,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"`          // 订单号
+	Carrier       string                 `protobuf:"bytes,4,opt,name=carrier,proto3" json:"carrier,omitempty"`                         // 物流公司编码
+	TrackingNo    string                 `protobuf:"bytes,5,opt,name=tracking_no,json=trackingNo,proto3" json:"tracking_no,omitempty"` // 物流单号
+	ShippedAt     int64                  `protobuf:"varint,6,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`   // 发货时间
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                           // 最新物流状态
+	Goods         []*ShipmentGoodsInfo   `protobuf:"bytes,8,rep,name=goods,proto3" json:"goods,omitempty"`                             // 发货商品
+	Tracks        []*ShipmentTrackInfo   `protobuf:"bytes,9,rep,name=tracks,proto3" json:"tracks,omitempty"`                           // 物流轨迹，按时间先后排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentInfo) Reset() {
+	*x = ShipmentInfo{}
+	mi := &file_proto_order_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentInfo) ProtoMessage() {}
+
+func (x *ShipmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentInfo.ProtoReflect.Descriptor instead.
+func (*ShipmentInfo) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ShipmentInfo) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShipmentInfo) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ShipmentInfo) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *ShipmentInfo) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *ShipmentInfo) GetTrackingNo() string {
+	if x != nil {
+		return x.TrackingNo
+	}
+	return ""
+}
+
+func (x *ShipmentInfo) GetShippedAt() int64 {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return 0
+}
+
+func (x *ShipmentInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShipmentInfo) GetGoods() []*ShipmentGoodsInfo {
+	if x != nil {
+		return x.Goods
+	}
+	return nil
+}
+
+func (x *ShipmentInfo) GetTracks() []*ShipmentTrackInfo {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type ShipmentListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderStatus   string                 `protobuf:"bytes,1,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"` // 订单状态
+	Shipments     []*ShipmentInfo        `protobuf:"bytes,2,rep,name=shipments,proto3" json:"shipments,omitempty"`                        // 发货单，按发货时间先后排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentListResponse) Reset() {
+	*x = ShipmentListResponse{}
+	mi := &file_proto_order_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentListResponse) ProtoMessage() {}
+
+func (x *ShipmentListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentListResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ShipmentListResponse) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+func (x *ShipmentListResponse) GetShipments() []*ShipmentInfo {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+type OrderSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderSn       string                 `protobuf:"bytes,1,opt,name=order_sn,json=orderSn,proto3" json:"order_sn,omitempty"`         // 订单号，同时匹配拆单的父订单号
+	TradeNo       string                 `protobuf:"bytes,2,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"`         // 支付渠道交易号
+	Mobile        string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile,omitempty"`                          // 收货人手机
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                              // 收货人姓名，前缀匹配
+	UserId        int32                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`           // 用户ID
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                          // 订单状态
+	StartTime     int64                  `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`  // 下单时间起，包含
+	EndTime       int64                  `protobuf:"varint,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`        // 下单时间止，不包含
+	MinAmount     int64                  `protobuf:"varint,9,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`  // 应付金额下限（分），包含，0表示不限
+	MaxAmount     int64                  `protobuf:"varint,10,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // 应付金额上限（分），包含，0表示不限
+	GoodsId       int32                  `protobuf:"varint,11,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`       // 包含该商品的订单
+	SortBy        string                 `protobuf:"bytes,12,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`           // 排序字段：id(默认)、add_time、pay_time、pay_amount
+	Ascending     bool                   `protobuf:"varint,13,opt,name=ascending,proto3" json:"ascending,omitempty"`                  // 是否升序，默认降序
+	Cursor        string                 `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor,omitempty"`                         // 上一页返回的游标，为空时从第一条开始；导出时忽略
+	Limit         int32                  `protobuf:"varint,15,opt,name=limit,proto3" json:"limit,omitempty"`                          // 每页数量，默认20，最大100；导出时忽略
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderSearchRequest) Reset() {
+	*x = OrderSearchRequest{}
+	mi := &file_proto_order_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderSearchRequest) ProtoMessage() {}
+
+func (x *OrderSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderSearchRequest.ProtoReflect.Descriptor instead.
+func (*OrderSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *OrderSearchRequest) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *OrderSearchRequest) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *OrderSearchRequest) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *OrderSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderSearchRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OrderSearchRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderSearchRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *OrderSearchRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *OrderSearchRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *OrderSearchRequest) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *OrderSearchRequest) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *OrderSearchRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *OrderSearchRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *OrderSearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *OrderSearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type OrderSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*OrderInfoResponse   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`                               // 订单列表
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标，没有更多数据时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderSearchResponse) Reset() {
+	*x = OrderSearchResponse{}
+	mi := &file_proto_order_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderSearchResponse) ProtoMessage() {}
+
+func (x *OrderSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderSearchResponse.ProtoReflect.Descriptor instead.
+func (*OrderSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *OrderSearchResponse) GetData() []*OrderInfoResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OrderSearchResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SalesStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（下单日期），格式2006-01-02，包含
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期，包含
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`                        // 汇总周期：day(默认)、week、month
+	Statuses      []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`                    // 订单当前状态，为空时不限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalesStatsRequest) Reset() {
+	*x = SalesStatsRequest{}
+	mi := &file_proto_order_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesStatsRequest) ProtoMessage() {}
+
+func (x *SalesStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesStatsRequest.ProtoReflect.Descriptor instead.
+func (*SalesStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SalesStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SalesStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SalesStatsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SalesStatsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type SalesStatsItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart       string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`                      // 周期第一天
+	OrderCount        int64                  `protobuf:"varint,2,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`                        // 下单数
+	PaidCount         int64                  `protobuf:"varint,3,opt,name=paid_count,json=paidCount,proto3" json:"paid_count,omitempty"`                           // 已支付订单数
+	OrderAmount       int64                  `protobuf:"varint,4,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`                     // 下单金额（分）
+	Gmv               int64                  `protobuf:"varint,5,opt,name=gmv,proto3" json:"gmv,omitempty"`                                                        // 已支付订单金额（分）
+	ConversionRate    float64                `protobuf:"fixed64,6,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`           // 下单到支付的转化率
+	AverageOrderValue int64                  `protobuf:"varint,7,opt,name=average_order_value,json=averageOrderValue,proto3" json:"average_order_value,omitempty"` // 客单价（分），按已支付订单计算
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SalesStatsItem) Reset() {
+	*x = SalesStatsItem{}
+	mi := &file_proto_order_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesStatsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesStatsItem) ProtoMessage() {}
+
+func (x *SalesStatsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesStatsItem.ProtoReflect.Descriptor instead.
+func (*SalesStatsItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SalesStatsItem) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *SalesStatsItem) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *SalesStatsItem) GetPaidCount() int64 {
+	if x != nil {
+		return x.PaidCount
+	}
+	return 0
+}
+
+func (x *SalesStatsItem) GetOrderAmount() int64 {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return 0
+}
+
+func (x *SalesStatsItem) GetGmv() int64 {
+	if x != nil {
+		return x.Gmv
+	}
+	return 0
+}
+
+func (x *SalesStatsItem) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *SalesStatsItem) GetAverageOrderValue() int64 {
+	if x != nil {
+		return x.AverageOrderValue
+	}
+	return 0
+}
+
+type SalesStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SalesStatsItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                                 // 各周期数据，没有订单的周期为0
+	Total         *SalesStatsItem        `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`                                 // 合计
+	RefreshedAt   int64                  `protobuf:"varint,3,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"` // 汇总表最近刷新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalesStatsResponse) Reset() {
+	*x = SalesStatsResponse{}
+	mi := &file_proto_order_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesStatsResponse) ProtoMessage() {}
+
+func (x *SalesStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesStatsResponse.ProtoReflect.Descriptor instead.
+func (*SalesStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SalesStatsResponse) GetItems() []*SalesStatsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SalesStatsResponse) GetTotal() *SalesStatsItem {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *SalesStatsResponse) GetRefreshedAt() int64 {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return 0
+}
+
+type TopGoodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（下单日期），格式2006-01-02，包含
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期，包含
+	Statuses      []string               `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`                    // 订单当前状态，为空时只统计已支付的订单
+	SortBy        string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          // 排序：nums(销量，默认)、revenue(销售额)
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                         // 返回数量，默认10，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopGoodsRequest) Reset() {
+	*x = TopGoodsRequest{}
+	mi := &file_proto_order_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopGoodsRequest) ProtoMessage() {}
+
+func (x *TopGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopGoodsRequest.ProtoReflect.Descriptor instead.
+func (*TopGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TopGoodsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *TopGoodsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *TopGoodsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TopGoodsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *TopGoodsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type TopGoodsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int32                  `protobuf:"varint,1,opt,name=goods_id,json=goodsId,proto3" json:"goods_id,omitempty"`      // 商品ID
+	GoodsName     string                 `protobuf:"bytes,2,opt,name=goods_name,json=goodsName,proto3" json:"goods_name,omitempty"` // 商品名称
+	Nums          int64                  `protobuf:"varint,3,opt,name=nums,proto3" json:"nums,omitempty"`                           // 销量
+	Revenue       int64                  `protobuf:"varint,4,opt,name=revenue,proto3" json:"revenue,omitempty"`                     // 销售额（分），扣除分摊的优惠
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopGoodsItem) Reset() {
+	*x = TopGoodsItem{}
+	mi := &file_proto_order_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopGoodsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopGoodsItem) ProtoMessage() {}
+
+func (x *TopGoodsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopGoodsItem.ProtoReflect.Descriptor instead.
+func (*TopGoodsItem) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TopGoodsItem) GetGoodsId() int32 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *TopGoodsItem) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *TopGoodsItem) GetNums() int64 {
+	if x != nil {
+		return x.Nums
+	}
+	return 0
+}
+
+func (x *TopGoodsItem) GetRevenue() int64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+type TopGoodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TopGoodsItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`                                 // 商品排行
+	RefreshedAt   int64                  `protobuf:"varint,2,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"` // 汇总表最近刷新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopGoodsResponse) Reset() {
+	*x = TopGoodsResponse{}
+	mi := &file_proto_order_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopGoodsResponse) ProtoMessage() {}
+
+func (x *TopGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_order_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopGoodsResponse.ProtoReflect.Descriptor instead.
+func (*TopGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_order_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TopGoodsResponse) GetItems() []*TopGoodsItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TopGoodsResponse) GetRefreshedAt() int64 {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return 0
+}
+
+var File_proto_order_proto protoreflect.FileDescriptor
+
+const file_proto_order_proto_rawDesc = "" +
 	"\n" +
 	"\x11proto/order.proto\x1a\x1bgoogle/protobuf/empty.proto\":\n" +
 	"\x0fOrderDelRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\x91\x01\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xd5\x01\n" +
 	"\fOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x18\n" +
 	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x12\n" +
 	"\x04name\x18\x04 \x01(\tR\x04name\x12\x16\n" +
 	"\x06mobile\x18\x05 \x01(\tR\x06mobile\x12\x12\n" +
-	"\x04post\x18\x06 \x01(\tR\x04post\"\xfa\x01\n" +
+	"\x04post\x18\x06 \x01(\tR\x04post\x12\x1b\n" +
+	"\tcoupon_id\x18\a \x01(\x05R\bcouponId\x12%\n" +
+	"\x05items\x18\b \x03(\v2\x0f.OrderGoodsItemR\x05items\"\xb9\x04\n" +
 	"\x11OrderInfoResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
 	"\border_sn\x18\x03 \x01(\tR\aorderSn\x12\x19\n" +
 	"\bpay_type\x18\x04 \x01(\tR\apayType\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
-	"\x04post\x18\x06 \x01(\tR\x04post\x12\x14\n" +
-	"\x05total\x18\a \x01(\x02R\x05total\x12\x18\n" +
+	"\x04post\x18\x06 \x01(\tR\x04post\x12\x18\n" +
 	"\aaddress\x18\b \x01(\tR\aaddress\x12\x12\n" +
 	"\x04name\x18\t \x01(\tR\x04name\x12\x16\n" +
 	"\x06mobile\x18\n" +
-	" \x01(\tR\x06mobile\"v\n" +
+	" \x01(\tR\x06mobile\x12\x1b\n" +
+	"\tcoupon_id\x18\f \x01(\x05R\bcouponId\x12!\n" +
+	"\fgoods_amount\x18\r \x01(\x03R\vgoodsAmount\x12!\n" +
+	"\fshipping_fee\x18\x0e \x01(\x03R\vshippingFee\x12'\n" +
+	"\x0fdiscount_amount\x18\x0f \x01(\x03R\x0ediscountAmount\x12\x1d\n" +
+	"\n" +
+	"pay_amount\x18\x10 \x01(\x03R\tpayAmount\x12\x1b\n" +
+	"\tparent_sn\x18\x11 \x01(\tR\bparentSn\x12.\n" +
+	"\bchildren\x18\x12 \x03(\v2\x12.OrderInfoResponseR\bchildren\x12\x19\n" +
+	"\btrade_no\x18\x13 \x01(\tR\atradeNo\x12\x19\n" +
+	"\badd_time\x18\x14 \x01(\x03R\aaddTime\x12\x19\n" +
+	"\bpay_time\x18\x15 \x01(\x03R\apayTimeJ\x04\b\a\x10\bJ\x04\b\v\x10\f\"v\n" +
 	"\x12OrderFilterRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
@@ -928,7 +5988,7 @@ const file_proto_order_proto_rawDesc = "" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"Q\n" +
 	"\x11OrderListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12&\n" +
-	"\x04data\x18\x02 \x03(\v2\x12.OrderInfoResponseR\x04data\"\xce\x01\n" +
+	"\x04data\x18\x02 \x03(\v2\x12.OrderInfoResponseR\x04data\"\xf2\x01\n" +
 	"\x11OrderItemResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x05R\aorderId\x12\x19\n" +
@@ -936,20 +5996,67 @@ const file_proto_order_proto_rawDesc = "" +
 	"\n" +
 	"goods_name\x18\x04 \x01(\tR\tgoodsName\x12\x1f\n" +
 	"\vgoods_image\x18\x05 \x01(\tR\n" +
-	"goodsImage\x12\x1f\n" +
-	"\vgoods_price\x18\x06 \x01(\x02R\n" +
-	"goodsPrice\x12\x12\n" +
-	"\x04nums\x18\a \x01(\x05R\x04nums\"v\n" +
+	"goodsImage\x12\x12\n" +
+	"\x04nums\x18\a \x01(\x05R\x04nums\x12\x14\n" +
+	"\x05price\x18\b \x01(\x03R\x05price\x12'\n" +
+	"\x0fdiscount_amount\x18\t \x01(\x03R\x0ediscountAmountJ\x04\b\x06\x10\a\"\xa3\x01\n" +
 	"\x17OrderInfoDetailResponse\x121\n" +
 	"\n" +
 	"order_info\x18\x01 \x01(\v2\x12.OrderInfoResponseR\torderInfo\x12(\n" +
-	"\x05goods\x18\x02 \x03(\v2\x12.OrderItemResponseR\x05goods\"P\n" +
+	"\x05goods\x18\x02 \x03(\v2\x12.OrderItemResponseR\x05goods\x12+\n" +
+	"\tdiscounts\x18\x03 \x03(\v2\r.DiscountInfoR\tdiscounts\"~\n" +
 	"\vOrderStatus\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\border_sn\x18\x02 \x01(\tR\aorderSn\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"\x1a\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xb1\x01\n" +
+	"\x12OrderStatusLogInfo\x12\x1f\n" +
+	"\vfrom_status\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x02 \x01(\tR\btoStatus\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x19\n" +
+	"\badd_time\x18\x06 \x01(\x03R\aaddTime\"\x95\x01\n" +
+	"\x11JobLeaderResponse\x12\x16\n" +
+	"\x06leader\x18\x01 \x01(\tR\x06leader\x12\x1b\n" +
+	"\tlease_ttl\x18\x02 \x01(\x03R\bleaseTtl\x12\x1a\n" +
+	"\binstance\x18\x03 \x01(\tR\binstance\x12\x1b\n" +
+	"\tis_leader\x18\x04 \x01(\bR\bisLeader\x12\x12\n" +
+	"\x04jobs\x18\x05 \x03(\tR\x04jobs\"\xa0\x01\n" +
+	"\x13OutboxFilterRequest\x12\x14\n" +
+	"\x05topic\x18\x01 \x01(\tR\x05topic\x12\x17\n" +
+	"\amsg_key\x18\x02 \x01(\tR\x06msgKey\x12)\n" +
+	"\x10include_replayed\x18\x03 \x01(\bR\x0fincludeReplayed\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\xa5\x02\n" +
+	"\x14OutboxDeadLetterInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\x05R\tmessageId\x12\x14\n" +
+	"\x05topic\x18\x03 \x01(\tR\x05topic\x12\x17\n" +
+	"\amsg_key\x18\x04 \x01(\tR\x06msgKey\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12\x1a\n" +
+	"\breplayed\x18\b \x01(\bR\breplayed\x12\x19\n" +
+	"\badd_time\x18\t \x01(\x03R\aaddTime\x12#\n" +
+	"\rreplayed_time\x18\n" +
+	" \x01(\x03R\freplayedTime\"_\n" +
+	"\x1cOutboxDeadLetterListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12)\n" +
+	"\x04data\x18\x02 \x03(\v2\x15.OutboxDeadLetterInfoR\x04data\"%\n" +
+	"\x13OutboxReplayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x8e\x01\n" +
+	"\x15OrderTimelineResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x19\n" +
+	"\border_sn\x18\x02 \x01(\tR\aorderSn\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x04logs\x18\x04 \x03(\v2\x13.OrderStatusLogInfoR\x04logs\"\x1a\n" +
 	"\bUserInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xe4\x01\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xc9\x01\n" +
 	"\x0fCartItemRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
@@ -957,15 +6064,15 @@ const file_proto_order_proto_rawDesc = "" +
 	"\n" +
 	"goods_name\x18\x04 \x01(\tR\tgoodsName\x12\x1f\n" +
 	"\vgoods_image\x18\x05 \x01(\tR\n" +
-	"goodsImage\x12\x1f\n" +
-	"\vgoods_price\x18\x06 \x01(\x02R\n" +
-	"goodsPrice\x12\x12\n" +
+	"goodsImage\x12\x12\n" +
 	"\x04nums\x18\a \x01(\x05R\x04nums\x12\x18\n" +
-	"\achecked\x18\b \x01(\bR\achecked\"b\n" +
+	"\achecked\x18\b \x01(\bR\acheckedJ\x04\b\x06\x10\a\"\xb0\x01\n" +
 	"\x14CartItemListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x124\n" +
 	"\n" +
-	"cart_items\x18\x02 \x03(\v2\x15.ShopCartInfoResponseR\tcartItems\"\xe9\x01\n" +
+	"cart_items\x18\x02 \x03(\v2\x15.ShopCartInfoResponseR\tcartItems\x12%\n" +
+	"\x0egoods_degraded\x18\x03 \x01(\bR\rgoodsDegraded\x12%\n" +
+	"\x0estock_degraded\x18\x04 \x01(\bR\rstockDegraded\"\x9c\x03\n" +
 	"\x14ShopCartInfoResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
@@ -973,21 +6080,464 @@ const file_proto_order_proto_rawDesc = "" +
 	"\n" +
 	"goods_name\x18\x04 \x01(\tR\tgoodsName\x12\x1f\n" +
 	"\vgoods_image\x18\x05 \x01(\tR\n" +
-	"goodsImage\x12\x1f\n" +
-	"\vgoods_price\x18\x06 \x01(\x02R\n" +
-	"goodsPrice\x12\x12\n" +
+	"goodsImage\x12\x12\n" +
 	"\x04nums\x18\a \x01(\x05R\x04nums\x12\x18\n" +
-	"\achecked\x18\b \x01(\bR\achecked2\xfe\x03\n" +
+	"\achecked\x18\b \x01(\bR\achecked\x12\x14\n" +
+	"\x05price\x18\t \x01(\x03R\x05price\x12#\n" +
+	"\rcurrent_price\x18\n" +
+	" \x01(\x03R\fcurrentPrice\x12#\n" +
+	"\rprice_changed\x18\v \x01(\bR\fpriceChanged\x12\x1f\n" +
+	"\vprice_delta\x18\f \x01(\x03R\n" +
+	"priceDelta\x12\x17\n" +
+	"\aon_sale\x18\r \x01(\bR\x06onSale\x12\x14\n" +
+	"\x05stock\x18\x0e \x01(\x05R\x05stock\x12\x1c\n" +
+	"\tavailable\x18\x0f \x01(\bR\tavailableJ\x04\b\x06\x10\a\"\xb0\x01\n" +
+	"\x13CartRefreshResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x05R\aupdated\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\x05R\aremoved\x12:\n" +
+	"\rremoved_items\x18\x03 \x03(\v2\x15.ShopCartInfoResponseR\fremovedItems\x12)\n" +
+	"\x04cart\x18\x04 \x01(\v2\x15.CartItemListResponseR\x04cart\"\x99\x01\n" +
+	"\x0ePaymentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bpay_type\x18\x03 \x01(\tR\apayType\x12\x1b\n" +
+	"\tclient_ip\x18\x04 \x01(\tR\bclientIp\x12\x1b\n" +
+	"\tparent_sn\x18\x05 \x01(\tR\bparentSn\"\xa4\x02\n" +
+	"\x0fPaymentResponse\x12\x19\n" +
+	"\border_sn\x18\x01 \x01(\tR\aorderSn\x12\x19\n" +
+	"\bpay_type\x18\x02 \x01(\tR\apayType\x12\x17\n" +
+	"\apay_url\x18\x04 \x01(\tR\x06payUrl\x12>\n" +
+	"\n" +
+	"pay_params\x18\x05 \x03(\v2\x1f.PaymentResponse.PayParamsEntryR\tpayParams\x12\x1f\n" +
+	"\vexpire_time\x18\x06 \x01(\x03R\n" +
+	"expireTime\x12\x1d\n" +
+	"\n" +
+	"pay_amount\x18\a \x01(\x03R\tpayAmount\x1a<\n" +
+	"\x0ePayParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x03\x10\x04\"\xbf\x01\n" +
+	"\x14PaymentNotifyRequest\x12\x19\n" +
+	"\bpay_type\x18\x01 \x01(\tR\apayType\x12<\n" +
+	"\aheaders\x18\x02 \x03(\v2\".PaymentNotifyRequest.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\fR\x04body\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"b\n" +
+	"\x15PaymentNotifyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
+	"\border_sn\x18\x02 \x01(\tR\aorderSn\x12\x14\n" +
+	"\x05reply\x18\x03 \x01(\tR\x05reply\"\x95\x02\n" +
+	"\rRefundRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12$\n" +
+	"\x0eorder_goods_id\x18\x03 \x01(\x05R\forderGoodsId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04nums\x18\x06 \x01(\x05R\x04nums\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\t \x01(\x05R\tmessageId\x12#\n" +
+	"\rrefund_amount\x18\n" +
+	" \x01(\x03R\frefundAmountJ\x04\b\x05\x10\x06\"V\n" +
+	"\x12RefundAuditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x16\n" +
+	"\x06remark\x18\x03 \x01(\tR\x06remark\"?\n" +
+	"\x14RefundOperateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\x92\x01\n" +
+	"\x13RefundFilterRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x05R\aorderId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"f\n" +
+	"\x0fRefundGoodsInfo\x12$\n" +
+	"\x0eorder_goods_id\x18\x01 \x01(\x05R\forderGoodsId\x12\x19\n" +
+	"\bgoods_id\x18\x02 \x01(\x05R\agoodsId\x12\x12\n" +
+	"\x04nums\x18\x03 \x01(\x05R\x04nums\"\x92\x04\n" +
+	"\x12RefundInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\trefund_sn\x18\x02 \x01(\tR\brefundSn\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x05R\aorderId\x12\x19\n" +
+	"\border_sn\x18\x04 \x01(\tR\aorderSn\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x05R\x06userId\x12$\n" +
+	"\x0eorder_goods_id\x18\x06 \x01(\x05R\forderGoodsId\x12\x12\n" +
+	"\x04type\x18\a \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x12!\n" +
+	"\faudit_remark\x18\f \x01(\tR\vauditRemark\x12\x1f\n" +
+	"\vfail_reason\x18\r \x01(\tR\n" +
+	"failReason\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x0e \x01(\x05R\tmessageId\x12&\n" +
+	"\x05goods\x18\x0f \x03(\v2\x10.RefundGoodsInfoR\x05goods\x12\x19\n" +
+	"\badd_time\x18\x10 \x01(\x03R\aaddTime\x12#\n" +
+	"\rrefunded_time\x18\x11 \x01(\x03R\frefundedTime\x12#\n" +
+	"\rrefund_amount\x18\x12 \x01(\x03R\frefundAmountJ\x04\b\t\x10\n" +
+	"\"S\n" +
+	"\x12RefundListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12'\n" +
+	"\x04data\x18\x02 \x03(\v2\x13.RefundInfoResponseR\x04data\"\xf8\x02\n" +
+	"\x15CouponTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x03R\tthreshold\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x05R\apercent\x12!\n" +
+	"\fmax_discount\x18\x06 \x01(\x03R\vmaxDiscount\x12\x14\n" +
+	"\x05scope\x18\a \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\b \x01(\x05R\ascopeId\x12\x14\n" +
+	"\x05total\x18\t \x01(\x05R\x05total\x12$\n" +
+	"\x0eper_user_limit\x18\n" +
+	" \x01(\x05R\fperUserLimit\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\v \x01(\x05R\tvalidDays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\f \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\r \x01(\x03R\aendTime\"\xb7\x03\n" +
+	"\x12CouponTemplateInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x03R\tthreshold\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x18\n" +
+	"\apercent\x18\x06 \x01(\x05R\apercent\x12!\n" +
+	"\fmax_discount\x18\a \x01(\x03R\vmaxDiscount\x12\x14\n" +
+	"\x05scope\x18\b \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\t \x01(\x05R\ascopeId\x12\x14\n" +
+	"\x05total\x18\n" +
+	" \x01(\x05R\x05total\x12\x16\n" +
+	"\x06issued\x18\v \x01(\x05R\x06issued\x12$\n" +
+	"\x0eper_user_limit\x18\f \x01(\x05R\fperUserLimit\x12\x1d\n" +
+	"\n" +
+	"valid_days\x18\r \x01(\x05R\tvalidDays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x0e \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x0f \x01(\x03R\aendTime\x12\x18\n" +
+	"\aenabled\x18\x10 \x01(\bR\aenabled\"[\n" +
+	"\x1aCouponTemplateListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12'\n" +
+	"\x04data\x18\x02 \x03(\v2\x13.CouponTemplateInfoR\x04data\"N\n" +
+	"\x12CouponIssueRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x05R\n" +
+	"templateId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"{\n" +
+	"\x17UserCouponFilterRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x97\x03\n" +
+	"\x0eUserCouponInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\x05R\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x03R\tthreshold\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x18\n" +
+	"\apercent\x18\b \x01(\x05R\apercent\x12!\n" +
+	"\fmax_discount\x18\t \x01(\x03R\vmaxDiscount\x12\x14\n" +
+	"\x05scope\x18\n" +
+	" \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\v \x01(\x05R\ascopeId\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x1f\n" +
+	"\vexpire_time\x18\r \x01(\x03R\n" +
+	"expireTime\x12\x19\n" +
+	"\border_sn\x18\x0e \x01(\tR\aorderSn\x12\x1b\n" +
+	"\tused_time\x18\x0f \x01(\x03R\busedTime\"S\n" +
+	"\x16UserCouponListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12#\n" +
+	"\x04data\x18\x02 \x03(\v2\x0f.UserCouponInfoR\x04data\"\x98\x02\n" +
+	"\x10PromotionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x03R\tthreshold\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x05R\apercent\x12!\n" +
+	"\fmax_discount\x18\x06 \x01(\x03R\vmaxDiscount\x12\x14\n" +
+	"\x05scope\x18\a \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\b \x01(\x05R\ascopeId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\t \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\n" +
+	" \x01(\x03R\aendTime\"\xbf\x02\n" +
+	"\rPromotionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x03R\tthreshold\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x18\n" +
+	"\apercent\x18\x06 \x01(\x05R\apercent\x12!\n" +
+	"\fmax_discount\x18\a \x01(\x03R\vmaxDiscount\x12\x14\n" +
+	"\x05scope\x18\b \x01(\tR\x05scope\x12\x19\n" +
+	"\bscope_id\x18\t \x01(\x05R\ascopeId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\n" +
+	" \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\v \x01(\x03R\aendTime\x12\x18\n" +
+	"\aenabled\x18\f \x01(\bR\aenabled\"j\n" +
+	"\x16PromotionFilterRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"Q\n" +
+	"\x15PromotionListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\"\n" +
+	"\x04data\x18\x02 \x03(\v2\x0e.PromotionInfoR\x04data\"M\n" +
+	"\x15PriceCalculateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x05R\bcouponId\"o\n" +
+	"\fDiscountInfo\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\x05R\bsourceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\"\x8f\x01\n" +
+	"\rPriceItemInfo\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\x05R\agoodsId\x12\x1d\n" +
+	"\n" +
+	"goods_name\x18\x02 \x01(\tR\tgoodsName\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x12\n" +
+	"\x04nums\x18\x04 \x01(\x05R\x04nums\x12\x1a\n" +
+	"\bdiscount\x18\x05 \x01(\x03R\bdiscount\"s\n" +
+	"\fCouponOption\x12\x1b\n" +
+	"\tcoupon_id\x18\x01 \x01(\x05R\bcouponId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06usable\x18\x03 \x01(\bR\x06usable\x12\x1a\n" +
+	"\bdiscount\x18\x04 \x01(\x03R\bdiscount\"\xca\x02\n" +
+	"\x16PriceCalculateResponse\x12\x1f\n" +
+	"\vgoods_total\x18\x01 \x01(\x03R\n" +
+	"goodsTotal\x12-\n" +
+	"\x12promotion_discount\x18\x02 \x01(\x03R\x11promotionDiscount\x12'\n" +
+	"\x0fcoupon_discount\x18\x03 \x01(\x03R\x0ecouponDiscount\x12\x18\n" +
+	"\apayable\x18\x04 \x01(\x03R\apayable\x12$\n" +
+	"\x05items\x18\x05 \x03(\v2\x0e.PriceItemInfoR\x05items\x12+\n" +
+	"\tdiscounts\x18\x06 \x03(\v2\r.DiscountInfoR\tdiscounts\x12'\n" +
+	"\acoupons\x18\a \x03(\v2\r.CouponOptionR\acoupons\x12!\n" +
+	"\fshipping_fee\x18\b \x01(\x03R\vshippingFee\"?\n" +
+	"\x0eOrderGoodsItem\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\x05R\agoodsId\x12\x12\n" +
+	"\x04nums\x18\x02 \x01(\x05R\x04nums\"r\n" +
+	"\x13OrderPreviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tcoupon_id\x18\x02 \x01(\x05R\bcouponId\x12%\n" +
+	"\x05items\x18\x03 \x03(\v2\x0f.OrderGoodsItemR\x05items\"\x97\x02\n" +
+	"\x0fPreviewItemInfo\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\x05R\agoodsId\x12\x1d\n" +
+	"\n" +
+	"goods_name\x18\x02 \x01(\tR\tgoodsName\x12\x1f\n" +
+	"\vgoods_image\x18\x03 \x01(\tR\n" +
+	"goodsImage\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x12\n" +
+	"\x04nums\x18\x05 \x01(\x05R\x04nums\x12\x1a\n" +
+	"\bdiscount\x18\x06 \x01(\x03R\bdiscount\x12\x17\n" +
+	"\aon_sale\x18\a \x01(\bR\x06onSale\x12\x14\n" +
+	"\x05stock\x18\b \x01(\x05R\x05stock\x12\x1c\n" +
+	"\tavailable\x18\t \x01(\bR\tavailable\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\"\xbf\x02\n" +
+	"\x14OrderPreviewResponse\x12\x1f\n" +
+	"\vgoods_total\x18\x01 \x01(\x03R\n" +
+	"goodsTotal\x12!\n" +
+	"\fshipping_fee\x18\x02 \x01(\x03R\vshippingFee\x12-\n" +
+	"\x12promotion_discount\x18\x03 \x01(\x03R\x11promotionDiscount\x12'\n" +
+	"\x0fcoupon_discount\x18\x04 \x01(\x03R\x0ecouponDiscount\x12\x18\n" +
+	"\apayable\x18\x05 \x01(\x03R\apayable\x12&\n" +
+	"\x05items\x18\x06 \x03(\v2\x10.PreviewItemInfoR\x05items\x12+\n" +
+	"\tdiscounts\x18\a \x03(\v2\r.DiscountInfoR\tdiscounts\x12\x1c\n" +
+	"\tavailable\x18\b \x01(\bR\tavailable\"7\n" +
+	"\x10GuestCartRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\x84\x01\n" +
+	"\x14GuestCartItemRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x19\n" +
+	"\bgoods_id\x18\x02 \x01(\x05R\agoodsId\x12\x12\n" +
+	"\x04nums\x18\x03 \x01(\x05R\x04nums\x12\x18\n" +
+	"\achecked\x18\x04 \x01(\bR\achecked\"c\n" +
+	"\x11GuestCartResponse\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12)\n" +
+	"\x04cart\x18\x02 \x01(\v2\x15.CartItemListResponseR\x04cart\"P\n" +
+	"\x10MergeCartRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\x84\x01\n" +
+	"\x11MergeCartResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\x12\x16\n" +
+	"\x06merged\x18\x02 \x01(\x05R\x06merged\x12\x16\n" +
+	"\x06capped\x18\x03 \x01(\x05R\x06capped\x12)\n" +
+	"\x04cart\x18\x04 \x01(\v2\x15.CartItemListResponseR\x04cart\"\\\n" +
+	"\x15CartBatchCheckRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x05R\x03ids\x12\x18\n" +
+	"\achecked\x18\x03 \x01(\bR\achecked\"=\n" +
+	"\x10CartBatchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x05R\x03ids\"2\n" +
+	"\fCartItemNums\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04nums\x18\x02 \x01(\x05R\x04nums\"V\n" +
+	"\x16CartBatchUpdateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12#\n" +
+	"\x05items\x18\x02 \x03(\v2\r.CartItemNumsR\x05items\"\xba\x01\n" +
+	"\x13CartSummaryResponse\x12\x1a\n" +
+	"\baffected\x18\x01 \x01(\x05R\baffected\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12%\n" +
+	"\x0eselected_count\x18\x03 \x01(\x05R\rselectedCount\x12#\n" +
+	"\rselected_nums\x18\x04 \x01(\x05R\fselectedNums\x12%\n" +
+	"\x0eselected_total\x18\x05 \x01(\x03R\rselectedTotal\"I\n" +
+	"\rShipGoodsItem\x12$\n" +
+	"\x0eorder_goods_id\x18\x01 \x01(\x05R\forderGoodsId\x12\x12\n" +
+	"\x04nums\x18\x02 \x01(\x05R\x04nums\"\xad\x01\n" +
+	"\x10OrderShipRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x05R\aorderId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12\x1f\n" +
+	"\vtracking_no\x18\x03 \x01(\tR\n" +
+	"trackingNo\x12$\n" +
+	"\x05items\x18\x04 \x03(\v2\x0e.ShipGoodsItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"shipped_at\x18\x05 \x01(\x03R\tshippedAt\"\xac\x01\n" +
+	"\x14ShipmentTrackRequest\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\x05R\n" +
+	"shipmentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"event_time\x18\x05 \x01(\x03R\teventTime\"h\n" +
+	"\x11ShipmentGoodsInfo\x12$\n" +
+	"\x0eorder_goods_id\x18\x01 \x01(\x05R\forderGoodsId\x12\x19\n" +
+	"\bgoods_id\x18\x02 \x01(\x05R\agoodsId\x12\x12\n" +
+	"\x04nums\x18\x03 \x01(\x05R\x04nums\"\x88\x01\n" +
+	"\x11ShipmentTrackInfo\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"event_time\x18\x04 \x01(\x03R\teventTime\"\x9c\x02\n" +
+	"\fShipmentInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x05R\aorderId\x12\x19\n" +
+	"\border_sn\x18\x03 \x01(\tR\aorderSn\x12\x18\n" +
+	"\acarrier\x18\x04 \x01(\tR\acarrier\x12\x1f\n" +
+	"\vtracking_no\x18\x05 \x01(\tR\n" +
+	"trackingNo\x12\x1d\n" +
+	"\n" +
+	"shipped_at\x18\x06 \x01(\x03R\tshippedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12(\n" +
+	"\x05goods\x18\b \x03(\v2\x12.ShipmentGoodsInfoR\x05goods\x12*\n" +
+	"\x06tracks\x18\t \x03(\v2\x12.ShipmentTrackInfoR\x06tracks\"f\n" +
+	"\x14ShipmentListResponse\x12!\n" +
+	"\forder_status\x18\x01 \x01(\tR\vorderStatus\x12+\n" +
+	"\tshipments\x18\x02 \x03(\v2\r.ShipmentInfoR\tshipments\"\x9f\x03\n" +
+	"\x12OrderSearchRequest\x12\x19\n" +
+	"\border_sn\x18\x01 \x01(\tR\aorderSn\x12\x19\n" +
+	"\btrade_no\x18\x02 \x01(\tR\atradeNo\x12\x16\n" +
+	"\x06mobile\x18\x03 \x01(\tR\x06mobile\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x05R\x06userId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\a \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\b \x01(\x03R\aendTime\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\t \x01(\x03R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\n" +
+	" \x01(\x03R\tmaxAmount\x12\x19\n" +
+	"\bgoods_id\x18\v \x01(\x05R\agoodsId\x12\x17\n" +
+	"\asort_by\x18\f \x01(\tR\x06sortBy\x12\x1c\n" +
+	"\tascending\x18\r \x01(\bR\tascending\x12\x16\n" +
+	"\x06cursor\x18\x0e \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x0f \x01(\x05R\x05limit\"^\n" +
+	"\x13OrderSearchResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.OrderInfoResponseR\x04data\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x81\x01\n" +
+	"\x11SalesStatsRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\"\x81\x02\n" +
+	"\x0eSalesStatsItem\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12\x1f\n" +
+	"\vorder_count\x18\x02 \x01(\x03R\n" +
+	"orderCount\x12\x1d\n" +
+	"\n" +
+	"paid_count\x18\x03 \x01(\x03R\tpaidCount\x12!\n" +
+	"\forder_amount\x18\x04 \x01(\x03R\vorderAmount\x12\x10\n" +
+	"\x03gmv\x18\x05 \x01(\x03R\x03gmv\x12'\n" +
+	"\x0fconversion_rate\x18\x06 \x01(\x01R\x0econversionRate\x12.\n" +
+	"\x13average_order_value\x18\a \x01(\x03R\x11averageOrderValue\"\x85\x01\n" +
+	"\x12SalesStatsResponse\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.SalesStatsItemR\x05items\x12%\n" +
+	"\x05total\x18\x02 \x01(\v2\x0f.SalesStatsItemR\x05total\x12!\n" +
+	"\frefreshed_at\x18\x03 \x01(\x03R\vrefreshedAt\"\x96\x01\n" +
+	"\x0fTopGoodsRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\x12\x17\n" +
+	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"v\n" +
+	"\fTopGoodsItem\x12\x19\n" +
+	"\bgoods_id\x18\x01 \x01(\x05R\agoodsId\x12\x1d\n" +
+	"\n" +
+	"goods_name\x18\x02 \x01(\tR\tgoodsName\x12\x12\n" +
+	"\x04nums\x18\x03 \x01(\x03R\x04nums\x12\x18\n" +
+	"\arevenue\x18\x04 \x01(\x03R\arevenue\"Z\n" +
+	"\x10TopGoodsResponse\x12#\n" +
+	"\x05items\x18\x01 \x03(\v2\r.TopGoodsItemR\x05items\x12!\n" +
+	"\frefreshed_at\x18\x02 \x01(\x03R\vrefreshedAt2\x88\x15\n" +
 	"\fOrderService\x120\n" +
 	"\fCartItemList\x12\t.UserInfo\x1a\x15.CartItemListResponse\x126\n" +
 	"\vCartItemAdd\x12\x10.CartItemRequest\x1a\x15.ShopCartInfoResponse\x12:\n" +
 	"\x0eCartItemUpdate\x12\x10.CartItemRequest\x1a\x16.google.protobuf.Empty\x12:\n" +
-	"\x0eCartItemDelete\x12\x10.CartItemRequest\x1a\x16.google.protobuf.Empty\x120\n" +
+	"\x0eCartItemDelete\x12\x10.CartItemRequest\x1a\x16.google.protobuf.Empty\x122\n" +
+	"\x0fCartItemRefresh\x12\t.UserInfo\x1a\x14.CartRefreshResponse\x12B\n" +
+	"\x12CartItemBatchCheck\x12\x16.CartBatchCheckRequest\x1a\x14.CartSummaryResponse\x12>\n" +
+	"\x13CartItemBatchDelete\x12\x11.CartBatchRequest\x1a\x14.CartSummaryResponse\x12D\n" +
+	"\x13CartItemBatchUpdate\x12\x17.CartBatchUpdateRequest\x1a\x14.CartSummaryResponse\x126\n" +
+	"\rGuestCartList\x12\x11.GuestCartRequest\x1a\x12.GuestCartResponse\x129\n" +
+	"\fGuestCartAdd\x12\x15.GuestCartItemRequest\x1a\x12.GuestCartResponse\x12<\n" +
+	"\x0fGuestCartUpdate\x12\x15.GuestCartItemRequest\x1a\x12.GuestCartResponse\x12<\n" +
+	"\x0fGuestCartDelete\x12\x15.GuestCartItemRequest\x1a\x12.GuestCartResponse\x122\n" +
+	"\tMergeCart\x12\x11.MergeCartRequest\x1a\x12.MergeCartResponse\x120\n" +
 	"\vOrderCreate\x12\r.OrderRequest\x1a\x12.OrderInfoResponse\x124\n" +
 	"\tOrderList\x12\x13.OrderFilterRequest\x1a\x12.OrderListResponse\x126\n" +
 	"\vOrderDetail\x12\r.OrderRequest\x1a\x18.OrderInfoDetailResponse\x123\n" +
 	"\vOrderUpdate\x12\f.OrderStatus\x1a\x16.google.protobuf.Empty\x127\n" +
-	"\vOrderDelete\x12\x10.OrderDelRequest\x1a\x16.google.protobuf.EmptyB\tZ\a.;protob\x06proto3"
+	"\vOrderDelete\x12\x10.OrderDelRequest\x1a\x16.google.protobuf.Empty\x126\n" +
+	"\rOrderTimeline\x12\r.OrderRequest\x1a\x16.OrderTimelineResponse\x12;\n" +
+	"\fOrderPreview\x12\x14.OrderPreviewRequest\x1a\x15.OrderPreviewResponse\x128\n" +
+	"\vOrderSearch\x12\x13.OrderSearchRequest\x1a\x14.OrderSearchResponse\x12>\n" +
+	"\vOrderExport\x12\x13.OrderSearchRequest\x1a\x18.OrderInfoDetailResponse0\x01\x127\n" +
+	"\tJobLeader\x12\x16.google.protobuf.Empty\x1a\x12.JobLeaderResponse\x12K\n" +
+	"\x14OutboxDeadLetterList\x12\x14.OutboxFilterRequest\x1a\x1d.OutboxDeadLetterListResponse\x12<\n" +
+	"\fOutboxReplay\x12\x14.OutboxReplayRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x14CouponTemplateCreate\x12\x16.CouponTemplateRequest\x1a\x13.CouponTemplateInfo\x12J\n" +
+	"\x12CouponTemplateList\x12\x17.PromotionFilterRequest\x1a\x1b.CouponTemplateListResponse\x123\n" +
+	"\vCouponIssue\x12\x13.CouponIssueRequest\x1a\x0f.UserCouponInfo\x12C\n" +
+	"\x0eUserCouponList\x12\x18.UserCouponFilterRequest\x1a\x17.UserCouponListResponse\x124\n" +
+	"\x0fPromotionCreate\x12\x11.PromotionRequest\x1a\x0e.PromotionInfo\x12@\n" +
+	"\rPromotionList\x12\x17.PromotionFilterRequest\x1a\x16.PromotionListResponse\x12A\n" +
+	"\x0ePriceCalculate\x12\x16.PriceCalculateRequest\x1a\x17.PriceCalculateResponse\x122\n" +
+	"\rPaymentCreate\x12\x0f.PaymentRequest\x1a\x10.PaymentResponse\x12>\n" +
+	"\rPaymentNotify\x12\x15.PaymentNotifyRequest\x1a\x16.PaymentNotifyResponse\x123\n" +
+	"\fRefundCreate\x12\x0e.RefundRequest\x1a\x13.RefundInfoResponse\x127\n" +
+	"\vRefundAudit\x12\x13.RefundAuditRequest\x1a\x13.RefundInfoResponse\x12A\n" +
+	"\x13RefundConfirmReturn\x12\x15.RefundOperateRequest\x1a\x13.RefundInfoResponse\x12=\n" +
+	"\fRefundCancel\x12\x15.RefundOperateRequest\x1a\x16.google.protobuf.Empty\x127\n" +
+	"\n" +
+	"RefundList\x12\x14.RefundFilterRequest\x1a\x13.RefundListResponse\x12-\n" +
+	"\tOrderShip\x12\x11.OrderShipRequest\x1a\r.ShipmentInfo\x12A\n" +
+	"\x10ShipmentTrackAdd\x12\x15.ShipmentTrackRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\x11OrderShipmentList\x12\r.OrderRequest\x1a\x15.ShipmentListResponse\x12<\n" +
+	"\x13OrderConfirmReceipt\x12\r.OrderRequest\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\n" +
+	"SalesStats\x12\x12.SalesStatsRequest\x1a\x13.SalesStatsResponse\x12/\n" +
+	"\bTopGoods\x12\x10.TopGoodsRequest\x1a\x11.TopGoodsResponseB\tZ\a.;protob\x06proto3"
 
 var (
 	file_proto_order_proto_rawDescOnce sync.Once
@@ -1001,50 +6551,219 @@ func file_proto_order_proto_rawDescGZIP() []byte {
 	return file_proto_order_proto_rawDescData
 }
 
-var file_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_order_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_proto_order_proto_goTypes = []any{
-	(*OrderDelRequest)(nil),         // 0: OrderDelRequest
-	(*OrderRequest)(nil),            // 1: OrderRequest
-	(*OrderInfoResponse)(nil),       // 2: OrderInfoResponse
-	(*OrderFilterRequest)(nil),      // 3: OrderFilterRequest
-	(*OrderListResponse)(nil),       // 4: OrderListResponse
-	(*OrderItemResponse)(nil),       // 5: OrderItemResponse
-	(*OrderInfoDetailResponse)(nil), // 6: OrderInfoDetailResponse
-	(*OrderStatus)(nil),             // 7: OrderStatus
-	(*UserInfo)(nil),                // 8: UserInfo
-	(*CartItemRequest)(nil),         // 9: CartItemRequest
-	(*CartItemListResponse)(nil),    // 10: CartItemListResponse
-	(*ShopCartInfoResponse)(nil),    // 11: ShopCartInfoResponse
-	(*emptypb.Empty)(nil),           // 12: google.protobuf.Empty
+	(*OrderDelRequest)(nil),              // 0: OrderDelRequest
+	(*OrderRequest)(nil),                 // 1: OrderRequest
+	(*OrderInfoResponse)(nil),            // 2: OrderInfoResponse
+	(*OrderFilterRequest)(nil),           // 3: OrderFilterRequest
+	(*OrderListResponse)(nil),            // 4: OrderListResponse
+	(*OrderItemResponse)(nil),            // 5: OrderItemResponse
+	(*OrderInfoDetailResponse)(nil),      // 6: OrderInfoDetailResponse
+	(*OrderStatus)(nil),                  // 7: OrderStatus
+	(*OrderStatusLogInfo)(nil),           // 8: OrderStatusLogInfo
+	(*JobLeaderResponse)(nil),            // 9: JobLeaderResponse
+	(*OutboxFilterRequest)(nil),          // 10: OutboxFilterRequest
+	(*OutboxDeadLetterInfo)(nil),         // 11: OutboxDeadLetterInfo
+	(*OutboxDeadLetterListResponse)(nil), // 12: OutboxDeadLetterListResponse
+	(*OutboxReplayRequest)(nil),          // 13: OutboxReplayRequest
+	(*OrderTimelineResponse)(nil),        // 14: OrderTimelineResponse
+	(*UserInfo)(nil),                     // 15: UserInfo
+	(*CartItemRequest)(nil),              // 16: CartItemRequest
+	(*CartItemListResponse)(nil),         // 17: CartItemListResponse
+	(*ShopCartInfoResponse)(nil),         // 18: ShopCartInfoResponse
+	(*CartRefreshResponse)(nil),          // 19: CartRefreshResponse
+	(*PaymentRequest)(nil),               // 20: PaymentRequest
+	(*PaymentResponse)(nil),              // 21: PaymentResponse
+	(*PaymentNotifyRequest)(nil),         // 22: PaymentNotifyRequest
+	(*PaymentNotifyResponse)(nil),        // 23: PaymentNotifyResponse
+	(*RefundRequest)(nil),                // 24: RefundRequest
+	(*RefundAuditRequest)(nil),           // 25: RefundAuditRequest
+	(*RefundOperateRequest)(nil),         // 26: RefundOperateRequest
+	(*RefundFilterRequest)(nil),          // 27: RefundFilterRequest
+	(*RefundGoodsInfo)(nil),              // 28: RefundGoodsInfo
+	(*RefundInfoResponse)(nil),           // 29: RefundInfoResponse
+	(*RefundListResponse)(nil),           // 30: RefundListResponse
+	(*CouponTemplateRequest)(nil),        // 31: CouponTemplateRequest
+	(*CouponTemplateInfo)(nil),           // 32: CouponTemplateInfo
+	(*CouponTemplateListResponse)(nil),   // 33: CouponTemplateListResponse
+	(*CouponIssueRequest)(nil),           // 34: CouponIssueRequest
+	(*UserCouponFilterRequest)(nil),      // 35: UserCouponFilterRequest
+	(*UserCouponInfo)(nil),               // 36: UserCouponInfo
+	(*UserCouponListResponse)(nil),       // 37: UserCouponListResponse
+	(*PromotionRequest)(nil),             // 38: PromotionRequest
+	(*PromotionInfo)(nil),                // 39: PromotionInfo
+	(*PromotionFilterRequest)(nil),       // 40: PromotionFilterRequest
+	(*PromotionListResponse)(nil),        // 41: PromotionListResponse
+	(*PriceCalculateRequest)(nil),        // 42: PriceCalculateRequest
+	(*DiscountInfo)(nil),                 // 43: DiscountInfo
+	(*PriceItemInfo)(nil),                // 44: PriceItemInfo
+	(*CouponOption)(nil),                 // 45: CouponOption
+	(*PriceCalculateResponse)(nil),       // 46: PriceCalculateResponse
+	(*OrderGoodsItem)(nil),               // 47: OrderGoodsItem
+	(*OrderPreviewRequest)(nil),          // 48: OrderPreviewRequest
+	(*PreviewItemInfo)(nil),              // 49: PreviewItemInfo
+	(*OrderPreviewResponse)(nil),         // 50: OrderPreviewResponse
+	(*GuestCartRequest)(nil),             // 51: GuestCartRequest
+	(*GuestCartItemRequest)(nil),         // 52: GuestCartItemRequest
+	(*GuestCartResponse)(nil),            // 53: GuestCartResponse
+	(*MergeCartRequest)(nil),             // 54: MergeCartRequest
+	(*MergeCartResponse)(nil),            // 55: MergeCartResponse
+	(*CartBatchCheckRequest)(nil),        // 56: CartBatchCheckRequest
+	(*CartBatchRequest)(nil),             // 57: CartBatchRequest
+	(*CartItemNums)(nil),                 // 58: CartItemNums
+	(*CartBatchUpdateRequest)(nil),       // 59: CartBatchUpdateRequest
+	(*CartSummaryResponse)(nil),          // 60: CartSummaryResponse
+	(*ShipGoodsItem)(nil),                // 61: ShipGoodsItem
+	(*OrderShipRequest)(nil),             // 62: OrderShipRequest
+	(*ShipmentTrackRequest)(nil),         // 63: ShipmentTrackRequest
+	(*ShipmentGoodsInfo)(nil),            // 64: ShipmentGoodsInfo
+	(*ShipmentTrackInfo)(nil),            // 65: ShipmentTrackInfo
+	(*ShipmentInfo)(nil),                 // 66: ShipmentInfo
+	(*ShipmentListResponse)(nil),         // 67: ShipmentListResponse
+	(*OrderSearchRequest)(nil),           // 68: OrderSearchRequest
+	(*OrderSearchResponse)(nil),          // 69: OrderSearchResponse
+	(*SalesStatsRequest)(nil),            // 70: SalesStatsRequest
+	(*SalesStatsItem)(nil),               // 71: SalesStatsItem
+	(*SalesStatsResponse)(nil),           // 72: SalesStatsResponse
+	(*TopGoodsRequest)(nil),              // 73: TopGoodsRequest
+	(*TopGoodsItem)(nil),                 // 74: TopGoodsItem
+	(*TopGoodsResponse)(nil),             // 75: TopGoodsResponse
+	nil,                                  // 76: PaymentResponse.PayParamsEntry
+	nil,                                  // 77: PaymentNotifyRequest.HeadersEntry
+	(*emptypb.Empty)(nil),                // 78: google.protobuf.Empty
 }
 var file_proto_order_proto_depIdxs = []int32{
-	2,  // 0: OrderListResponse.data:type_name -> OrderInfoResponse
-	2,  // 1: OrderInfoDetailResponse.order_info:type_name -> OrderInfoResponse
-	5,  // 2: OrderInfoDetailResponse.goods:type_name -> OrderItemResponse
-	11, // 3: CartItemListResponse.cart_items:type_name -> ShopCartInfoResponse
-	8,  // 4: OrderService.CartItemList:input_type -> UserInfo
-	9,  // 5: OrderService.CartItemAdd:input_type -> CartItemRequest
-	9,  // 6: OrderService.CartItemUpdate:input_type -> CartItemRequest
-	9,  // 7: OrderService.CartItemDelete:input_type -> CartItemRequest
-	1,  // 8: OrderService.OrderCreate:input_type -> OrderRequest
-	3,  // 9: OrderService.OrderList:input_type -> OrderFilterRequest
-	1,  // 10: OrderService.OrderDetail:input_type -> OrderRequest
-	7,  // 11: OrderService.OrderUpdate:input_type -> OrderStatus
-	0,  // 12: OrderService.OrderDelete:input_type -> OrderDelRequest
-	10, // 13: OrderService.CartItemList:output_type -> CartItemListResponse
-	11, // 14: OrderService.CartItemAdd:output_type -> ShopCartInfoResponse
-	12, // 15: OrderService.CartItemUpdate:output_type -> google.protobuf.Empty
-	12, // 16: OrderService.CartItemDelete:output_type -> google.protobuf.Empty
-	2,  // 17: OrderService.OrderCreate:output_type -> OrderInfoResponse
-	4,  // 18: OrderService.OrderList:output_type -> OrderListResponse
-	6,  // 19: OrderService.OrderDetail:output_type -> OrderInfoDetailResponse
-	12, // 20: OrderService.OrderUpdate:output_type -> google.protobuf.Empty
-	12, // 21: OrderService.OrderDelete:output_type -> google.protobuf.Empty
-	13, // [13:22] is the sub-list for method output_type
-	4,  // [4:13] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	47, // 0: OrderRequest.items:type_name -> OrderGoodsItem
+	2,  // 1: OrderInfoResponse.children:type_name -> OrderInfoResponse
+	2,  // 2: OrderListResponse.data:type_name -> OrderInfoResponse
+	2,  // 3: OrderInfoDetailResponse.order_info:type_name -> OrderInfoResponse
+	5,  // 4: OrderInfoDetailResponse.goods:type_name -> OrderItemResponse
+	43, // 5: OrderInfoDetailResponse.discounts:type_name -> DiscountInfo
+	11, // 6: OutboxDeadLetterListResponse.data:type_name -> OutboxDeadLetterInfo
+	8,  // 7: OrderTimelineResponse.logs:type_name -> OrderStatusLogInfo
+	18, // 8: CartItemListResponse.cart_items:type_name -> ShopCartInfoResponse
+	18, // 9: CartRefreshResponse.removed_items:type_name -> ShopCartInfoResponse
+	17, // 10: CartRefreshResponse.cart:type_name -> CartItemListResponse
+	76, // 11: PaymentResponse.pay_params:type_name -> PaymentResponse.PayParamsEntry
+	77, // 12: PaymentNotifyRequest.headers:type_name -> PaymentNotifyRequest.HeadersEntry
+	28, // 13: RefundInfoResponse.goods:type_name -> RefundGoodsInfo
+	29, // 14: RefundListResponse.data:type_name -> RefundInfoResponse
+	32, // 15: CouponTemplateListResponse.data:type_name -> CouponTemplateInfo
+	36, // 16: UserCouponListResponse.data:type_name -> UserCouponInfo
+	39, // 17: PromotionListResponse.data:type_name -> PromotionInfo
+	44, // 18: PriceCalculateResponse.items:type_name -> PriceItemInfo
+	43, // 19: PriceCalculateResponse.discounts:type_name -> DiscountInfo
+	45, // 20: PriceCalculateResponse.coupons:type_name -> CouponOption
+	47, // 21: OrderPreviewRequest.items:type_name -> OrderGoodsItem
+	49, // 22: OrderPreviewResponse.items:type_name -> PreviewItemInfo
+	43, // 23: OrderPreviewResponse.discounts:type_name -> DiscountInfo
+	17, // 24: GuestCartResponse.cart:type_name -> CartItemListResponse
+	17, // 25: MergeCartResponse.cart:type_name -> CartItemListResponse
+	58, // 26: CartBatchUpdateRequest.items:type_name -> CartItemNums
+	61, // 27: OrderShipRequest.items:type_name -> ShipGoodsItem
+	64, // 28: ShipmentInfo.goods:type_name -> ShipmentGoodsInfo
+	65, // 29: ShipmentInfo.tracks:type_name -> ShipmentTrackInfo
+	66, // 30: ShipmentListResponse.shipments:type_name -> ShipmentInfo
+	2,  // 31: OrderSearchResponse.data:type_name -> OrderInfoResponse
+	71, // 32: SalesStatsResponse.items:type_name -> SalesStatsItem
+	71, // 33: SalesStatsResponse.total:type_name -> SalesStatsItem
+	74, // 34: TopGoodsResponse.items:type_name -> TopGoodsItem
+	15, // 35: OrderService.CartItemList:input_type -> UserInfo
+	16, // 36: OrderService.CartItemAdd:input_type -> CartItemRequest
+	16, // 37: OrderService.CartItemUpdate:input_type -> CartItemRequest
+	16, // 38: OrderService.CartItemDelete:input_type -> CartItemRequest
+	15, // 39: OrderService.CartItemRefresh:input_type -> UserInfo
+	56, // 40: OrderService.CartItemBatchCheck:input_type -> CartBatchCheckRequest
+	57, // 41: OrderService.CartItemBatchDelete:input_type -> CartBatchRequest
+	59, // 42: OrderService.CartItemBatchUpdate:input_type -> CartBatchUpdateRequest
+	51, // 43: OrderService.GuestCartList:input_type -> GuestCartRequest
+	52, // 44: OrderService.GuestCartAdd:input_type -> GuestCartItemRequest
+	52, // 45: OrderService.GuestCartUpdate:input_type -> GuestCartItemRequest
+	52, // 46: OrderService.GuestCartDelete:input_type -> GuestCartItemRequest
+	54, // 47: OrderService.MergeCart:input_type -> MergeCartRequest
+	1,  // 48: OrderService.OrderCreate:input_type -> OrderRequest
+	3,  // 49: OrderService.OrderList:input_type -> OrderFilterRequest
+	1,  // 50: OrderService.OrderDetail:input_type -> OrderRequest
+	7,  // 51: OrderService.OrderUpdate:input_type -> OrderStatus
+	0,  // 52: OrderService.OrderDelete:input_type -> OrderDelRequest
+	1,  // 53: OrderService.OrderTimeline:input_type -> OrderRequest
+	48, // 54: OrderService.OrderPreview:input_type -> OrderPreviewRequest
+	68, // 55: OrderService.OrderSearch:input_type -> OrderSearchRequest
+	68, // 56: OrderService.OrderExport:input_type -> OrderSearchRequest
+	78, // 57: OrderService.JobLeader:input_type -> google.protobuf.Empty
+	10, // 58: OrderService.OutboxDeadLetterList:input_type -> OutboxFilterRequest
+	13, // 59: OrderService.OutboxReplay:input_type -> OutboxReplayRequest
+	31, // 60: OrderService.CouponTemplateCreate:input_type -> CouponTemplateRequest
+	40, // 61: OrderService.CouponTemplateList:input_type -> PromotionFilterRequest
+	34, // 62: OrderService.CouponIssue:input_type -> CouponIssueRequest
+	35, // 63: OrderService.UserCouponList:input_type -> UserCouponFilterRequest
+	38, // 64: OrderService.PromotionCreate:input_type -> PromotionRequest
+	40, // 65: OrderService.PromotionList:input_type -> PromotionFilterRequest
+	42, // 66: OrderService.PriceCalculate:input_type -> PriceCalculateRequest
+	20, // 67: OrderService.PaymentCreate:input_type -> PaymentRequest
+	22, // 68: OrderService.PaymentNotify:input_type -> PaymentNotifyRequest
+	24, // 69: OrderService.RefundCreate:input_type -> RefundRequest
+	25, // 70: OrderService.RefundAudit:input_type -> RefundAuditRequest
+	26, // 71: OrderService.RefundConfirmReturn:input_type -> RefundOperateRequest
+	26, // 72: OrderService.RefundCancel:input_type -> RefundOperateRequest
+	27, // 73: OrderService.RefundList:input_type -> RefundFilterRequest
+	62, // 74: OrderService.OrderShip:input_type -> OrderShipRequest
+	63, // 75: OrderService.ShipmentTrackAdd:input_type -> ShipmentTrackRequest
+	1,  // 76: OrderService.OrderShipmentList:input_type -> OrderRequest
+	1,  // 77: OrderService.OrderConfirmReceipt:input_type -> OrderRequest
+	70, // 78: OrderService.SalesStats:input_type -> SalesStatsRequest
+	73, // 79: OrderService.TopGoods:input_type -> TopGoodsRequest
+	17, // 80: OrderService.CartItemList:output_type -> CartItemListResponse
+	18, // 81: OrderService.CartItemAdd:output_type -> ShopCartInfoResponse
+	78, // 82: OrderService.CartItemUpdate:output_type -> google.protobuf.Empty
+	78, // 83: OrderService.CartItemDelete:output_type -> google.protobuf.Empty
+	19, // 84: OrderService.CartItemRefresh:output_type -> CartRefreshResponse
+	60, // 85: OrderService.CartItemBatchCheck:output_type -> CartSummaryResponse
+	60, // 86: OrderService.CartItemBatchDelete:output_type -> CartSummaryResponse
+	60, // 87: OrderService.CartItemBatchUpdate:output_type -> CartSummaryResponse
+	53, // 88: OrderService.GuestCartList:output_type -> GuestCartResponse
+	53, // 89: OrderService.GuestCartAdd:output_type -> GuestCartResponse
+	53, // 90: OrderService.GuestCartUpdate:output_type -> GuestCartResponse
+	53, // 91: OrderService.GuestCartDelete:output_type -> GuestCartResponse
+	55, // 92: OrderService.MergeCart:output_type -> MergeCartResponse
+	2,  // 93: OrderService.OrderCreate:output_type -> OrderInfoResponse
+	4,  // 94: OrderService.OrderList:output_type -> OrderListResponse
+	6,  // 95: OrderService.OrderDetail:output_type -> OrderInfoDetailResponse
+	78, // 96: OrderService.OrderUpdate:output_type -> google.protobuf.Empty
+	78, // 97: OrderService.OrderDelete:output_type -> google.protobuf.Empty
+	14, // 98: OrderService.OrderTimeline:output_type -> OrderTimelineResponse
+	50, // 99: OrderService.OrderPreview:output_type -> OrderPreviewResponse
+	69, // 100: OrderService.OrderSearch:output_type -> OrderSearchResponse
+	6,  // 101: OrderService.OrderExport:output_type -> OrderInfoDetailResponse
+	9,  // 102: OrderService.JobLeader:output_type -> JobLeaderResponse
+	12, // 103: OrderService.OutboxDeadLetterList:output_type -> OutboxDeadLetterListResponse
+	78, // 104: OrderService.OutboxReplay:output_type -> google.protobuf.Empty
+	32, // 105: OrderService.CouponTemplateCreate:output_type -> CouponTemplateInfo
+	33, // 106: OrderService.CouponTemplateList:output_type -> CouponTemplateListResponse
+	36, // 107: OrderService.CouponIssue:output_type -> UserCouponInfo
+	37, // 108: OrderService.UserCouponList:output_type -> UserCouponListResponse
+	39, // 109: OrderService.PromotionCreate:output_type -> PromotionInfo
+	41, // 110: OrderService.PromotionList:output_type -> PromotionListResponse
+	46, // 111: OrderService.PriceCalculate:output_type -> PriceCalculateResponse
+	21, // 112: OrderService.PaymentCreate:output_type -> PaymentResponse
+	23, // 113: OrderService.PaymentNotify:output_type -> PaymentNotifyResponse
+	29, // 114: OrderService.RefundCreate:output_type -> RefundInfoResponse
+	29, // 115: OrderService.RefundAudit:output_type -> RefundInfoResponse
+	29, // 116: OrderService.RefundConfirmReturn:output_type -> RefundInfoResponse
+	78, // 117: OrderService.RefundCancel:output_type -> google.protobuf.Empty
+	30, // 118: OrderService.RefundList:output_type -> RefundListResponse
+	66, // 119: OrderService.OrderShip:output_type -> ShipmentInfo
+	78, // 120: OrderService.ShipmentTrackAdd:output_type -> google.protobuf.Empty
+	67, // 121: OrderService.OrderShipmentList:output_type -> ShipmentListResponse
+	78, // 122: OrderService.OrderConfirmReceipt:output_type -> google.protobuf.Empty
+	72, // 123: OrderService.SalesStats:output_type -> SalesStatsResponse
+	75, // 124: OrderService.TopGoods:output_type -> TopGoodsResponse
+	80, // [80:125] is the sub-list for method output_type
+	35, // [35:80] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_proto_order_proto_init() }
@@ -1058,7 +6777,7 @@ func file_proto_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_order_proto_rawDesc), len(file_proto_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,128 @@
+// Package report 销售报表的周期汇总，输入为按天汇总的数据，按日、周、月合并并补齐没有数据的周期
+package report
+
+import (
+	"fmt"
+	"time"
+)
+
+// 汇总周期
+const (
+	PeriodDay   = "day"
+	PeriodWeek  = "week" // 周一为一周的第一天
+	PeriodMonth = "month"
+)
+
+// DateLayout 报表日期格式
+const DateLayout = "2006-01-02"
+
+// DailyRow 一天的订单汇总，金额单位为分
+type DailyRow struct {
+	Date        time.Time
+	OrderCount  int64 // 下单数
+	PaidCount   int64 // 其中已支付的订单数
+	OrderAmount int64 // 下单金额
+	PaidAmount  int64 // 已支付订单金额，即GMV
+}
+
+// Bucket 一个周期的汇总，Start为周期第一天
+type Bucket struct {
+	Start       time.Time
+	OrderCount  int64
+	PaidCount   int64
+	OrderAmount int64
+	PaidAmount  int64
+}
+
+// ConversionRate 下单到支付的转化率，没有订单时为0
+func (b Bucket) ConversionRate() float64 {
+	if b.OrderCount == 0 {
+		return 0
+	}
+	return float64(b.PaidCount) / float64(b.OrderCount)
+}
+
+// AverageOrderValue 客单价（分），按已支付订单计算，四舍五入
+func (b Bucket) AverageOrderValue() int64 {
+	if b.PaidCount == 0 {
+		return 0
+	}
+	return (b.PaidAmount + b.PaidCount/2) / b.PaidCount
+}
+
+func (b *Bucket) add(r DailyRow) {
+	b.OrderCount += r.OrderCount
+	b.PaidCount += r.PaidCount
+	b.OrderAmount += r.OrderAmount
+	b.PaidAmount += r.PaidAmount
+}
+
+// ValidPeriod 是否为支持的汇总周期
+func ValidPeriod(period string) bool {
+	switch period {
+	case PeriodDay, PeriodWeek, PeriodMonth:
+		return true
+	}
+	return false
+}
+
+// PeriodStart 返回日期所在周期的第一天
+func PeriodStart(t time.Time, period string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch period {
+	case PeriodWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case PeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	}
+	return day
+}
+
+func nextPeriod(t time.Time, period string) time.Time {
+	switch period {
+	case PeriodWeek:
+		return t.AddDate(0, 0, 7)
+	case PeriodMonth:
+		return t.AddDate(0, 1, 0)
+	}
+	return t.AddDate(0, 0, 1)
+}
+
+// Aggregate 将[start, end]内的按天汇总合并为周期汇总，没有数据的周期补零，结果按时间先后排列
+func Aggregate(rows []DailyRow, start, end time.Time, period string) ([]Bucket, error) {
+	if !ValidPeriod(period) {
+		return nil, fmt.Errorf("不支持的汇总周期: %s", period)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("结束日期早于开始日期")
+	}
+
+	var buckets []Bucket
+	index := make(map[time.Time]int)
+	for t := PeriodStart(start, period); !t.After(end); t = nextPeriod(t, period) {
+		index[t] = len(buckets)
+		buckets = append(buckets, Bucket{Start: t})
+	}
+	for _, r := range rows {
+		if r.Date.Before(start) || r.Date.After(end) {
+			continue
+		}
+		if i, ok := index[PeriodStart(r.Date, period)]; ok {
+			buckets[i].add(r)
+		}
+	}
+	return buckets, nil
+}
+
+// Total 合计所有周期
+func Total(buckets []Bucket) Bucket {
+	var total Bucket
+	for _, b := range buckets {
+		total.add(DailyRow{OrderCount: b.OrderCount, PaidCount: b.PaidCount, OrderAmount: b.OrderAmount, PaidAmount: b.PaidAmount})
+	}
+	if len(buckets) > 0 {
+		total.Start = buckets[0].Start
+	}
+	return total
+}
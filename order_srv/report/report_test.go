@@ -0,0 +1,71 @@
+package report
+
+import (
+	"testing"
+	"time"
+)
+
+func date(s string) time.Time {
+	t, _ := time.ParseInLocation(DateLayout, s, time.Local)
+	return t
+}
+
+// TestPeriodStart 测试周期第一天的计算
+func TestPeriodStart(t *testing.T) {
+	cases := []struct {
+		day, period, want string
+	}{
+		{"2026-10-21", PeriodDay, "2026-10-21"},
+		{"2026-10-21", PeriodWeek, "2026-10-19"}, // 周三 -> 周一
+		{"2026-10-25", PeriodWeek, "2026-10-19"}, // 周日属于上一个周一开始的周
+		{"2026-10-19", PeriodWeek, "2026-10-19"},
+		{"2026-10-21", PeriodMonth, "2026-10-01"},
+	}
+	for _, c := range cases {
+		if got := PeriodStart(date(c.day), c.period).Format(DateLayout); got != c.want {
+			t.Errorf("%s 按%s汇总期望从 %s 开始，实际 %s", c.day, c.period, c.want, got)
+		}
+	}
+}
+
+// TestAggregate 测试按周合并、补齐空周期和转化率、客单价
+func TestAggregate(t *testing.T) {
+	rows := []DailyRow{
+		{Date: date("2026-10-05"), OrderCount: 2, PaidCount: 1, OrderAmount: 3000, PaidAmount: 1000}, // 前一周，不在范围内
+		{Date: date("2026-10-13"), OrderCount: 4, PaidCount: 3, OrderAmount: 4000, PaidAmount: 3001},
+		{Date: date("2026-10-18"), OrderCount: 1, PaidCount: 0, OrderAmount: 500},
+		{Date: date("2026-10-27"), OrderCount: 5, PaidCount: 5, OrderAmount: 5000, PaidAmount: 5000},
+	}
+	buckets, err := Aggregate(rows, date("2026-10-12"), date("2026-10-31"), PeriodWeek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buckets) != 3 {
+		t.Fatalf("期望3周，实际 %d", len(buckets))
+	}
+	first := buckets[0]
+	if first.OrderCount != 5 || first.PaidCount != 3 || first.PaidAmount != 3001 {
+		t.Errorf("第一周汇总错误: %+v", first)
+	}
+	if first.AverageOrderValue() != 1000 {
+		t.Errorf("客单价期望1000，实际 %d", first.AverageOrderValue())
+	}
+	if rate := first.ConversionRate(); rate != 0.6 {
+		t.Errorf("转化率期望0.6，实际 %v", rate)
+	}
+	if buckets[1].OrderCount != 0 || buckets[1].Start.Format(DateLayout) != "2026-10-19" {
+		t.Errorf("空周期应补零: %+v", buckets[1])
+	}
+	if buckets[1].ConversionRate() != 0 || buckets[1].AverageOrderValue() != 0 {
+		t.Error("没有订单的周期转化率和客单价应为0")
+	}
+
+	total := Total(buckets)
+	if total.OrderCount != 10 || total.PaidAmount != 8001 {
+		t.Errorf("合计错误: %+v", total)
+	}
+
+	if _, err := Aggregate(rows, date("2026-10-12"), date("2026-10-31"), "year"); err == nil {
+		t.Error("不支持的周期应返回错误")
+	}
+}
@@ -0,0 +1,291 @@
+// Package saga Saga编排：跨服务流程拆分为带补偿的步骤，执行状态持久化，进程崩溃后由恢复任务继续执行或补偿
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"order_srv/global"
+	"order_srv/model"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// staleAfter 超过该时长未更新的执行中Saga视为进程已崩溃
+	staleAfter = 2 * time.Minute
+	// MaxAttempts 补偿失败超过该次数后标记为FAILED，需要人工处理
+	MaxAttempts  = 10
+	recoverEvery = 30 * time.Second
+	maxBackoff   = 10 * time.Minute
+)
+
+// ErrDefinitionNotFound Saga定义未注册
+var ErrDefinitionNotFound = errors.New("Saga定义未注册")
+
+// Step Saga步骤，Action和LocalAction二选一
+type Step struct {
+	Name string
+	// Action 远程调用等不在本地事务中的动作
+	Action func(ctx context.Context, data interface{}) error
+	// LocalAction 本地数据库动作，与Saga进度在同一事务中提交，不存在执行结果不确定的情况
+	LocalAction func(ctx context.Context, tx *gorm.DB, data interface{}) error
+	// Compensate 撤销动作的效果，需要保证幂等，为空表示无需补偿
+	Compensate func(ctx context.Context, data interface{}) error
+	// CompensateIfInterrupted 动作执行中进程崩溃、结果不确定时是否也执行补偿，补偿需要能容忍动作未执行
+	CompensateIfInterrupted bool
+}
+
+// Definition Saga定义
+type Definition struct {
+	Name  string
+	Steps []Step
+	// NewData 创建业务数据的零值指针，恢复时用于反序列化
+	NewData func() interface{}
+	// RecoverForward 崩溃恢复时继续执行剩余步骤，否则补偿已完成的步骤
+	RecoverForward bool
+}
+
+var (
+	mu          sync.RWMutex
+	definitions = make(map[string]*Definition)
+)
+
+// Register 注册Saga定义，恢复任务按名称查找
+func Register(def *Definition) {
+	mu.Lock()
+	defer mu.Unlock()
+	definitions[def.Name] = def
+}
+
+func definition(name string) (*Definition, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	def, ok := definitions[name]
+	return def, ok
+}
+
+// Run 执行Saga，某一步失败时逆序补偿已完成的步骤并返回该步骤的错误
+// 补偿失败时Saga保持补偿中状态，由恢复任务重试
+func Run(ctx context.Context, def *Definition, sagaId string, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("序列化Saga数据失败: %w", err)
+	}
+	inst := &model.SagaInstance{
+		SagaId:      sagaId,
+		Name:        def.Name,
+		Status:      model.SagaStatusRunning,
+		Data:        string(raw),
+		NextRetryAt: time.Now(),
+	}
+	if err := global.DB.Create(inst).Error; err != nil {
+		return fmt.Errorf("创建Saga实例失败: %w", err)
+	}
+	return execute(ctx, def, inst, data)
+}
+
+// execute 从inst.Step开始执行剩余步骤
+func execute(ctx context.Context, def *Definition, inst *model.SagaInstance, data interface{}) error {
+	for i := int(inst.Step); i < len(def.Steps); i++ {
+		step := def.Steps[i]
+		if err := runStep(ctx, step, inst, data); err != nil {
+			global.Logger.Warnf("Saga步骤失败，开始补偿，Saga: %s，步骤: %s，错误: %v", inst.SagaId, step.Name, err)
+			inst.LastError = truncate(err.Error(), 500)
+			if compErr := compensate(ctx, def, inst, data); compErr != nil {
+				global.Logger.Errorf("Saga补偿失败，稍后重试，Saga: %s，错误: %v", inst.SagaId, compErr)
+			}
+			return err
+		}
+	}
+	inst.Status = model.SagaStatusSucceeded
+	return save(global.DB, inst, data)
+}
+
+// runStep 执行单个步骤并保存进度，本地步骤的动作和进度在同一事务中提交
+func runStep(ctx context.Context, step Step, inst *model.SagaInstance, data interface{}) error {
+	if step.LocalAction != nil {
+		return global.DB.Transaction(func(tx *gorm.DB) error {
+			if err := step.LocalAction(ctx, tx, data); err != nil {
+				return err
+			}
+			inst.Step++
+			if err := save(tx, inst, data); err != nil {
+				inst.Step--
+				return err
+			}
+			return nil
+		})
+	}
+	if err := step.Action(ctx, data); err != nil {
+		return err
+	}
+	inst.Step++
+	if err := save(global.DB, inst, data); err != nil {
+		// 动作已执行但进度未保存，按动作已完成处理，恢复时会补偿
+		global.Logger.Errorf("保存Saga进度失败，Saga: %s，步骤: %s，错误: %v", inst.SagaId, step.Name, err)
+	}
+	return nil
+}
+
+// compensate 逆序补偿已完成的步骤，每完成一步保存一次进度
+func compensate(ctx context.Context, def *Definition, inst *model.SagaInstance, data interface{}) error {
+	inst.Status = model.SagaStatusCompensating
+	if err := save(global.DB, inst, data); err != nil {
+		return err
+	}
+	for inst.Step > 0 {
+		step := def.Steps[inst.Step-1]
+		if step.Compensate != nil {
+			if err := step.Compensate(ctx, data); err != nil {
+				return scheduleRetry(inst, data, fmt.Errorf("补偿步骤%s失败: %w", step.Name, err))
+			}
+		}
+		inst.Step--
+		if err := save(global.DB, inst, data); err != nil {
+			return err
+		}
+	}
+	inst.Status = model.SagaStatusCompensated
+	return save(global.DB, inst, data)
+}
+
+// scheduleRetry 补偿失败后按退避时间等待恢复任务重试，超过最大次数标记为FAILED
+func scheduleRetry(inst *model.SagaInstance, data interface{}, cause error) error {
+	inst.Attempts++
+	inst.LastError = truncate(cause.Error(), 500)
+	inst.NextRetryAt = time.Now().Add(backoff(inst.Attempts))
+	if inst.Attempts >= MaxAttempts {
+		inst.Status = model.SagaStatusFailed
+		global.Logger.Errorf("Saga补偿多次失败，需要人工处理，Saga: %s，错误: %v", inst.SagaId, cause)
+	}
+	if err := save(global.DB, inst, data); err != nil {
+		return err
+	}
+	return cause
+}
+
+func save(db *gorm.DB, inst *model.SagaInstance, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	inst.Data = string(raw)
+	return db.Model(&model.SagaInstance{}).Where("id = ?", inst.ID).Updates(map[string]interface{}{
+		"status":        inst.Status,
+		"step":          inst.Step,
+		"data":          inst.Data,
+		"last_error":    inst.LastError,
+		"attempts":      inst.Attempts,
+		"next_retry_at": inst.NextRetryAt,
+	}).Error
+}
+
+// RunRecovery 恢复任务，定期处理崩溃遗留和补偿失败的Saga，阻塞直到ctx取消
+func RunRecovery(ctx context.Context) {
+	ticker := time.NewTicker(recoverEvery)
+	defer ticker.Stop()
+	for {
+		if _, err := RecoverOnce(ctx); err != nil {
+			global.Logger.Errorf("恢复Saga失败: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecoverOnce 处理一批需要恢复的Saga，返回处理数量
+func RecoverOnce(ctx context.Context) (int, error) {
+	now := time.Now()
+	var instances []model.SagaInstance
+	if err := global.DB.Where("status IN ? AND updated_at < ? AND next_retry_at <= ?",
+		[]string{model.SagaStatusRunning, model.SagaStatusCompensating}, now.Add(-staleAfter), now).
+		Order("id").Limit(50).Find(&instances).Error; err != nil {
+		return 0, err
+	}
+	for i := range instances {
+		if ctx.Err() != nil {
+			return i, ctx.Err()
+		}
+		recoverInstance(ctx, &instances[i])
+	}
+	return len(instances), nil
+}
+
+func recoverInstance(ctx context.Context, inst *model.SagaInstance) {
+	def, ok := definition(inst.Name)
+	if !ok {
+		global.Logger.Errorf("%v，Saga: %s，定义: %s", ErrDefinitionNotFound, inst.SagaId, inst.Name)
+		return
+	}
+	// 领取：刷新更新时间，仍在执行的请求刚更新过进度时领取失败，避免同时处理
+	now := time.Now()
+	result := global.DB.Model(&model.SagaInstance{}).
+		Where("id = ? AND status = ? AND updated_at < ?", inst.ID, inst.Status, now.Add(-staleAfter)).
+		Update("updated_at", now)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return
+	}
+
+	data := def.NewData()
+	if err := json.Unmarshal([]byte(inst.Data), data); err != nil {
+		global.Logger.Errorf("解析Saga数据失败，Saga: %s，错误: %v", inst.SagaId, err)
+		return
+	}
+
+	if inst.Status == model.SagaStatusRunning {
+		if def.RecoverForward {
+			global.Logger.Warnf("继续执行中断的Saga，Saga: %s，已完成步骤: %d", inst.SagaId, inst.Step)
+			if err := execute(ctx, def, inst, data); err != nil {
+				global.Logger.Errorf("继续执行Saga失败，Saga: %s，错误: %v", inst.SagaId, err)
+			}
+			return
+		}
+		// 中断时正在执行的步骤结果不确定，声明可以容忍的步骤一并补偿
+		if int(inst.Step) < len(def.Steps) {
+			interrupted := def.Steps[inst.Step]
+			if interrupted.CompensateIfInterrupted {
+				inst.Step++
+			} else if interrupted.LocalAction == nil {
+				global.Logger.Warnf("Saga中断时远程步骤结果不确定，未补偿该步骤，请人工核对，Saga: %s，步骤: %s", inst.SagaId, interrupted.Name)
+			}
+		}
+		inst.LastError = "进程中断"
+		global.Logger.Warnf("补偿中断的Saga，Saga: %s，已完成步骤: %d", inst.SagaId, inst.Step)
+	}
+
+	if err := compensate(ctx, def, inst, data); err != nil {
+		global.Logger.Errorf("Saga补偿失败，Saga: %s，错误: %v", inst.SagaId, err)
+		return
+	}
+	global.Logger.Infof("Saga已补偿，Saga: %s", inst.SagaId)
+}
+
+// backoff 第attempts次补偿失败后的等待时间，从30秒开始指数增长，最长10分钟
+func backoff(attempts int32) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts > 10 {
+		return maxBackoff
+	}
+	d := 30 * time.Second << uint(attempts-1)
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,393 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"order_srv/config"
+	"order_srv/global"
+	"order_srv/model"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// setupTestDB 连接开发环境数据库并迁移Saga表，与model包的测试使用同一份配置
+func setupTestDB(t *testing.T) {
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("获取工作目录失败: %v", err)
+	}
+	configFile := filepath.Join(dir, "..", "config", "config-develop.yaml")
+	if _, err := os.Stat(configFile); os.IsNotExist(err) {
+		t.Fatalf("配置文件不存在: %s", configFile)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("读取配置文件失败: %v", err)
+	}
+	var cfg config.ServerConfig
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("解析配置文件失败: %v", err)
+	}
+
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		cfg.MySQL.User,
+		cfg.MySQL.Password,
+		cfg.MySQL.Host,
+		cfg.MySQL.Port,
+		cfg.MySQL.DBName,
+	)
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		NamingStrategy: schema.NamingStrategy{
+			SingularTable: true, // 使用单数表名
+		},
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		t.Fatalf("连接数据库失败: %v", err)
+	}
+
+	global.DB = db
+	global.Logger = zap.S()
+	if err := db.AutoMigrate(&model.SagaInstance{}); err != nil {
+		t.Fatalf("自动迁移表结构失败: %v", err)
+	}
+}
+
+type testData struct {
+	Value int
+}
+
+// recorder 记录动作和补偿的执行顺序
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) action(name string, err error) func(ctx context.Context, data interface{}) error {
+	return func(ctx context.Context, data interface{}) error {
+		r.calls = append(r.calls, name)
+		data.(*testData).Value++
+		return err
+	}
+}
+
+func (r *recorder) compensate(name string, err *error) func(ctx context.Context, data interface{}) error {
+	return func(ctx context.Context, data interface{}) error {
+		r.calls = append(r.calls, "undo "+name)
+		if err != nil && *err != nil {
+			return *err
+		}
+		return nil
+	}
+}
+
+// newDefinition 每个测试使用不同的定义名称，避免互相覆盖
+func newDefinition(t *testing.T, steps ...Step) *Definition {
+	def := &Definition{
+		Name:    fmt.Sprintf("test_%s_%d", t.Name(), time.Now().UnixNano()),
+		Steps:   steps,
+		NewData: func() interface{} { return &testData{} },
+	}
+	Register(def)
+	return def
+}
+
+func newSagaId(t *testing.T) string {
+	return fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano())
+}
+
+func loadInstance(t *testing.T, sagaId string) model.SagaInstance {
+	var inst model.SagaInstance
+	if err := global.DB.Where("saga_id = ?", sagaId).First(&inst).Error; err != nil {
+		t.Fatalf("查询Saga实例失败: %v", err)
+	}
+	return inst
+}
+
+// interrupted 模拟进程在第step步执行中崩溃：实例停留在执行中，且长时间未更新
+func interrupted(t *testing.T, def *Definition, step int32, data *testData) model.SagaInstance {
+	raw, _ := json.Marshal(data)
+	inst := model.SagaInstance{
+		SagaId:      newSagaId(t),
+		Name:        def.Name,
+		Status:      model.SagaStatusRunning,
+		Step:        step,
+		Data:        string(raw),
+		NextRetryAt: time.Now().Add(-time.Minute),
+	}
+	if err := global.DB.Create(&inst).Error; err != nil {
+		t.Fatalf("创建Saga实例失败: %v", err)
+	}
+	inst.UpdatedAt = time.Now().Add(-2 * staleAfter)
+	if err := global.DB.Model(&inst).UpdateColumn("updated_at", inst.UpdatedAt).Error; err != nil {
+		t.Fatalf("修改Saga更新时间失败: %v", err)
+	}
+	return inst
+}
+
+func expectCalls(t *testing.T, r *recorder, want ...string) {
+	t.Helper()
+	if !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("执行顺序期望 %v，实际 %v", want, r.calls)
+	}
+}
+
+// TestRunSucceeded 测试全部步骤成功
+func TestRunSucceeded(t *testing.T) {
+	setupTestDB(t)
+	r := &recorder{}
+	def := newDefinition(t,
+		Step{Name: "a", Action: r.action("a", nil), Compensate: r.compensate("a", nil)},
+		Step{Name: "b", Action: r.action("b", nil), Compensate: r.compensate("b", nil)},
+	)
+
+	sagaId := newSagaId(t)
+	if err := Run(context.Background(), def, sagaId, &testData{}); err != nil {
+		t.Fatalf("执行Saga失败: %v", err)
+	}
+	expectCalls(t, r, "a", "b")
+
+	inst := loadInstance(t, sagaId)
+	if inst.Status != model.SagaStatusSucceeded || inst.Step != 2 {
+		t.Errorf("期望SUCCEEDED且完成2步，实际 %s/%d", inst.Status, inst.Step)
+	}
+	if inst.Data != `{"Value":2}` {
+		t.Errorf("业务数据应随进度保存，实际 %s", inst.Data)
+	}
+}
+
+// TestRunCompensate 测试步骤失败后逆序补偿已完成的步骤，失败的步骤本身不补偿
+func TestRunCompensate(t *testing.T) {
+	setupTestDB(t)
+	r := &recorder{}
+	failure := errors.New("库存不足")
+	def := newDefinition(t,
+		Step{Name: "a", Action: r.action("a", nil), Compensate: r.compensate("a", nil)},
+		Step{Name: "b", Action: r.action("b", nil), Compensate: r.compensate("b", nil)},
+		Step{Name: "c", Action: r.action("c", failure), Compensate: r.compensate("c", nil)},
+	)
+
+	sagaId := newSagaId(t)
+	if err := Run(context.Background(), def, sagaId, &testData{}); !errors.Is(err, failure) {
+		t.Fatalf("期望返回失败步骤的错误，实际: %v", err)
+	}
+	expectCalls(t, r, "a", "b", "c", "undo b", "undo a")
+
+	inst := loadInstance(t, sagaId)
+	if inst.Status != model.SagaStatusCompensated || inst.Step != 0 {
+		t.Errorf("期望COMPENSATED且步骤回到0，实际 %s/%d", inst.Status, inst.Step)
+	}
+	if inst.LastError != failure.Error() {
+		t.Errorf("应记录失败原因，实际: %s", inst.LastError)
+	}
+}
+
+// TestLocalActionRollback 测试本地步骤失败时不推进进度，也不补偿该步骤
+func TestLocalActionRollback(t *testing.T) {
+	setupTestDB(t)
+	r := &recorder{}
+	def := newDefinition(t,
+		Step{Name: "a", Action: r.action("a", nil), Compensate: r.compensate("a", nil)},
+		Step{
+			Name: "local",
+			LocalAction: func(ctx context.Context, tx *gorm.DB, data interface{}) error {
+				r.calls = append(r.calls, "local")
+				return errors.New("本地写入失败")
+			},
+			Compensate: r.compensate("local", nil),
+		},
+	)
+
+	sagaId := newSagaId(t)
+	if err := Run(context.Background(), def, sagaId, &testData{}); err == nil {
+		t.Fatal("本地步骤失败时应返回错误")
+	}
+	expectCalls(t, r, "a", "local", "undo a")
+	if inst := loadInstance(t, sagaId); inst.Status != model.SagaStatusCompensated {
+		t.Errorf("期望COMPENSATED，实际 %s", inst.Status)
+	}
+}
+
+// TestCompensateRetry 测试补偿失败后保持补偿中状态，由恢复任务按退避时间重试
+func TestCompensateRetry(t *testing.T) {
+	setupTestDB(t)
+	r := &recorder{}
+	undoErr := errors.New("库存服务不可用")
+	def := newDefinition(t,
+		Step{Name: "a", Action: r.action("a", nil), Compensate: r.compensate("a", &undoErr)},
+		Step{Name: "b", Action: r.action("b", errors.New("失败"))},
+	)
+
+	sagaId := newSagaId(t)
+	if err := Run(context.Background(), def, sagaId, &testData{}); err == nil {
+		t.Fatal("步骤失败时应返回错误")
+	}
+	inst := loadInstance(t, sagaId)
+	if inst.Status != model.SagaStatusCompensating || inst.Step != 1 || inst.Attempts != 1 {
+		t.Fatalf("补偿失败后期望COMPENSATING/1步/1次，实际 %s/%d/%d", inst.Status, inst.Step, inst.Attempts)
+	}
+	if !inst.NextRetryAt.After(time.Now()) {
+		t.Errorf("下次重试时间应在退避之后，实际 %v", inst.NextRetryAt)
+	}
+
+	// 到达重试时间后由恢复任务重新补偿
+	undoErr = nil
+	global.DB.Model(&inst).UpdateColumns(map[string]interface{}{
+		"updated_at":    time.Now().Add(-2 * staleAfter),
+		"next_retry_at": time.Now().Add(-time.Second),
+	})
+	inst = loadInstance(t, sagaId)
+	recoverInstance(context.Background(), &inst)
+
+	inst = loadInstance(t, sagaId)
+	if inst.Status != model.SagaStatusCompensated || inst.Step != 0 {
+		t.Errorf("重试后期望COMPENSATED，实际 %s/%d", inst.Status, inst.Step)
+	}
+	expectCalls(t, r, "a", "b", "undo a", "undo a")
+}
+
+// TestCompensateMaxAttempts 测试补偿失败达到最大次数后标记为FAILED
+func TestCompensateMaxAttempts(t *testing.T) {
+	setupTestDB(t)
+	r := &recorder{}
+	undoErr := errors.New("库存服务不可用")
+	def := newDefinition(t,
+		Step{Name: "a", Action: r.action("a", nil), Compensate: r.compensate("a", &undoErr)},
+	)
+
+	inst := interrupted(t, def, 1, &testData{Value: 1})
+	global.DB.Model(&inst).UpdateColumns(map[string]interface{}{"status": model.SagaStatusCompensating, "attempts": MaxAttempts - 1})
+	inst = loadInstance(t, inst.SagaId)
+	recoverInstance(context.Background(), &inst)
+
+	if inst = loadInstance(t, inst.SagaId); inst.Status != model.SagaStatusFailed || inst.Attempts != MaxAttempts {
+		t.Errorf("期望FAILED且重试%d次，实际 %s/%d", MaxAttempts, inst.Status, inst.Attempts)
+	}
+}
+
+// TestRecoverInterrupted 测试进程崩溃后的恢复：中断的步骤按声明决定是否补偿
+func TestRecoverInterrupted(t *testing.T) {
+	setupTestDB(t)
+
+	cases := []struct {
+		name        string
+		interrupted func(r *recorder) Step
+		want        []string
+	}{
+		{
+			// 远程步骤结果不确定且补偿不能容忍未执行，只补偿已完成的步骤，留给人工核对
+			name: "远程步骤",
+			interrupted: func(r *recorder) Step {
+				return Step{Name: "b", Action: r.action("b", nil), Compensate: r.compensate("b", nil)}
+			},
+			want: []string{"undo a"},
+		},
+		{
+			name: "可容忍未执行的远程步骤",
+			interrupted: func(r *recorder) Step {
+				return Step{Name: "b", Action: r.action("b", nil), Compensate: r.compensate("b", nil), CompensateIfInterrupted: true}
+			},
+			want: []string{"undo b", "undo a"},
+		},
+		{
+			// 本地步骤与进度在同一事务中，中断说明未提交，无需补偿
+			name: "本地步骤",
+			interrupted: func(r *recorder) Step {
+				return Step{
+					Name:        "b",
+					LocalAction: func(ctx context.Context, tx *gorm.DB, data interface{}) error { return nil },
+					Compensate:  r.compensate("b", nil),
+				}
+			},
+			want: []string{"undo a"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := &recorder{}
+			def := newDefinition(t,
+				Step{Name: "a", Action: r.action("a", nil), Compensate: r.compensate("a", nil)},
+				c.interrupted(r),
+			)
+			inst := interrupted(t, def, 1, &testData{Value: 1})
+			recoverInstance(context.Background(), &inst)
+
+			expectCalls(t, r, c.want...)
+			got := loadInstance(t, inst.SagaId)
+			if got.Status != model.SagaStatusCompensated || got.Step != 0 || got.LastError != "进程中断" {
+				t.Errorf("期望COMPENSATED/0步/进程中断，实际 %s/%d/%s", got.Status, got.Step, got.LastError)
+			}
+		})
+	}
+}
+
+// TestRecoverForward 测试声明继续执行的Saga恢复时从中断的步骤开始执行，并使用保存的业务数据
+func TestRecoverForward(t *testing.T) {
+	setupTestDB(t)
+	r := &recorder{}
+	def := newDefinition(t,
+		Step{Name: "a", Action: r.action("a", nil)},
+		Step{Name: "b", Action: r.action("b", nil)},
+		Step{Name: "c", Action: r.action("c", nil)},
+	)
+	def.RecoverForward = true
+
+	inst := interrupted(t, def, 1, &testData{Value: 1})
+	recoverInstance(context.Background(), &inst)
+
+	expectCalls(t, r, "b", "c")
+	got := loadInstance(t, inst.SagaId)
+	if got.Status != model.SagaStatusSucceeded || got.Step != 3 || got.Data != `{"Value":3}` {
+		t.Errorf("期望SUCCEEDED/3步/Value=3，实际 %s/%d/%s", got.Status, got.Step, got.Data)
+	}
+}
+
+// TestRecoverClaim 测试领取时按更新时间判断：执行中的请求刚更新过进度，或已被其他实例领取时不再处理
+func TestRecoverClaim(t *testing.T) {
+	setupTestDB(t)
+	r := &recorder{}
+	def := newDefinition(t,
+		Step{Name: "a", Action: r.action("a", nil), Compensate: r.compensate("a", nil)},
+		Step{Name: "b", Action: r.action("b", nil), Compensate: r.compensate("b", nil)},
+	)
+
+	// 查询到待恢复的实例后，原请求又保存了进度
+	inst := interrupted(t, def, 1, &testData{Value: 1})
+	global.DB.Model(&inst).UpdateColumn("updated_at", time.Now())
+	recoverInstance(context.Background(), &inst)
+	expectCalls(t, r)
+	if got := loadInstance(t, inst.SagaId); got.Status != model.SagaStatusRunning {
+		t.Errorf("刚更新过的Saga不应被恢复，实际 %s", got.Status)
+	}
+
+	// 两个恢复任务拿到同一份快照，只有先领取的一个处理
+	inst = interrupted(t, def, 1, &testData{Value: 1})
+	snapshot := inst
+	recoverInstance(context.Background(), &inst)
+	recoverInstance(context.Background(), &snapshot)
+	expectCalls(t, r, "undo a")
+
+	// 最近更新的执行中实例不会被查询到
+	fresh := interrupted(t, def, 1, &testData{Value: 1})
+	global.DB.Model(&fresh).UpdateColumn("updated_at", time.Now())
+	if _, err := RecoverOnce(context.Background()); err != nil {
+		t.Fatalf("恢复Saga失败: %v", err)
+	}
+	if got := loadInstance(t, fresh.SagaId); got.Status != model.SagaStatusRunning {
+		t.Errorf("未超时的执行中Saga不应被恢复，实际 %s", got.Status)
+	}
+}
@@ -0,0 +1,24 @@
+package saga
+
+import (
+	"testing"
+	"time"
+)
+
+// TestBackoff 测试补偿失败的退避时间
+func TestBackoff(t *testing.T) {
+	cases := map[int32]time.Duration{
+		0:  30 * time.Second,
+		1:  30 * time.Second,
+		2:  time.Minute,
+		4:  4 * time.Minute,
+		5:  8 * time.Minute,
+		6:  maxBackoff,
+		50: maxBackoff,
+	}
+	for attempts, want := range cases {
+		if got := backoff(attempts); got != want {
+			t.Errorf("backoff(%d) 期望 %v，实际 %v", attempts, want, got)
+		}
+	}
+}
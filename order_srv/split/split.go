@@ -0,0 +1,111 @@
+// Package split 下单拆单规则，按拆单键将商品分组，每组生成一个子订单
+package split
+
+import (
+	"fmt"
+	"sort"
+)
+
+// 内置拆单键
+const (
+	KeyNone      = "none"      // 不拆单
+	KeyBrand     = "brand"     // 按品牌
+	KeyWarehouse = "warehouse" // 按发货仓库
+	KeyMerchant  = "merchant"  // 按商家
+)
+
+// Item 参与拆单的商品
+type Item struct {
+	GoodsId     int32
+	BrandId     int32
+	WarehouseId int32
+	MerchantId  int32
+}
+
+// KeyFunc 返回商品所属分组的键，键相同的商品拆到同一个子订单
+type KeyFunc func(Item) string
+
+var keys = map[string]KeyFunc{
+	KeyNone:      func(Item) string { return "" },
+	KeyBrand:     func(i Item) string { return fmt.Sprintf("brand:%d", i.BrandId) },
+	KeyWarehouse: func(i Item) string { return fmt.Sprintf("warehouse:%d", i.WarehouseId) },
+	KeyMerchant:  func(i Item) string { return fmt.Sprintf("merchant:%d", i.MerchantId) },
+}
+
+// Register 注册自定义拆单键，同名时覆盖
+func Register(name string, fn KeyFunc) {
+	keys[name] = fn
+}
+
+// Get 按名称获取拆单键，未配置时不拆单
+func Get(name string) (KeyFunc, error) {
+	if name == "" {
+		return keys[KeyNone], nil
+	}
+	fn, ok := keys[name]
+	if !ok {
+		return nil, fmt.Errorf("未知的拆单键: %s", name)
+	}
+	return fn, nil
+}
+
+// Group 一个子订单包含的商品在原列表中的下标
+type Group struct {
+	Key     string
+	Indexes []int
+}
+
+// GroupItems 按拆单键分组，分组顺序和组内商品顺序与原列表中首次出现的顺序一致
+func GroupItems(items []Item, key KeyFunc) []Group {
+	var groups []Group
+	index := make(map[string]int)
+	for i, item := range items {
+		k := key(item)
+		g, ok := index[k]
+		if !ok {
+			g = len(groups)
+			index[k] = g
+			groups = append(groups, Group{Key: k})
+		}
+		groups[g].Indexes = append(groups[g].Indexes, i)
+	}
+	return groups
+}
+
+// Allocate 按权重将金额分摊到各组，分到的金额之和等于total
+// 取整余下的金额按小数部分从大到小逐分补齐，权重全为0时平均分摊
+func Allocate(total int64, weights []int64) []int64 {
+	result := make([]int64, len(weights))
+	if len(weights) == 0 || total == 0 {
+		return result
+	}
+	var sum int64
+	for _, w := range weights {
+		sum += w
+	}
+	if sum <= 0 {
+		weights = make([]int64, len(weights))
+		for i := range weights {
+			weights[i] = 1
+		}
+		sum = int64(len(weights))
+	}
+
+	remainders := make([]int64, len(weights))
+	var allocated int64
+	for i, w := range weights {
+		result[i] = total * w / sum
+		remainders[i] = total * w % sum
+		allocated += result[i]
+	}
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]] > remainders[order[b]] })
+	for i := 0; allocated < total; i++ {
+		result[order[i%len(order)]]++
+		allocated++
+	}
+	return result
+}
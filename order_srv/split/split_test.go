@@ -0,0 +1,62 @@
+package split
+
+import "testing"
+
+// TestGroupItems 测试按拆单键分组并保持原有顺序
+func TestGroupItems(t *testing.T) {
+	items := []Item{
+		{GoodsId: 1, BrandId: 10},
+		{GoodsId: 2, BrandId: 20},
+		{GoodsId: 3, BrandId: 10},
+	}
+	brand, err := Get(KeyBrand)
+	if err != nil {
+		t.Fatalf("获取拆单键失败: %v", err)
+	}
+	groups := GroupItems(items, brand)
+	if len(groups) != 2 {
+		t.Fatalf("期望2个分组，实际 %d", len(groups))
+	}
+	if groups[0].Key != "brand:10" || len(groups[0].Indexes) != 2 || groups[0].Indexes[1] != 2 {
+		t.Errorf("第一个分组不正确: %+v", groups[0])
+	}
+	if groups[1].Key != "brand:20" || groups[1].Indexes[0] != 1 {
+		t.Errorf("第二个分组不正确: %+v", groups[1])
+	}
+
+	none, _ := Get("")
+	if groups := GroupItems(items, none); len(groups) != 1 || len(groups[0].Indexes) != 3 {
+		t.Errorf("不拆单时应只有一个分组: %+v", groups)
+	}
+	if _, err := Get("unknown"); err == nil {
+		t.Error("未知的拆单键应返回错误")
+	}
+}
+
+// TestAllocate 测试按权重分摊金额且合计不变
+func TestAllocate(t *testing.T) {
+	cases := []struct {
+		total   int64
+		weights []int64
+		want    []int64
+	}{
+		{1000, []int64{1, 1}, []int64{500, 500}},
+		{1000, []int64{1, 2}, []int64{333, 667}},
+		{1, []int64{3, 3, 3}, []int64{1, 0, 0}},
+		{900, []int64{0, 0, 0}, []int64{300, 300, 300}},
+		{0, []int64{5, 5}, []int64{0, 0}},
+	}
+	for _, c := range cases {
+		got := Allocate(c.total, c.weights)
+		var sum int64
+		for i := range got {
+			sum += got[i]
+			if got[i] != c.want[i] {
+				t.Errorf("Allocate(%d, %v) 期望 %v，实际 %v", c.total, c.weights, c.want, got)
+			}
+		}
+		if sum != c.total {
+			t.Errorf("Allocate(%d, %v) 合计 %d", c.total, c.weights, sum)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestCartBatchRPCs 测试批量选中、批量修改数量和批量删除及返回的购物车汇总
+func TestCartBatchRPCs(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+
+	// 使用单独的用户ID隔离测试数据，直接写入购物车避免依赖商品服务
+	userId := int32(980000 + time.Now().Unix()%10000)
+	otherUserId := userId + 1
+	defer global.DB.Unscoped().Where("user IN ?", []int32{userId, otherUserId}).Delete(&model.ShoppingCart{})
+
+	carts := []model.ShoppingCart{
+		{User: userId, Goods: 1, GoodsName: "批量测试商品1", GoodsPrice: model.Money(1000), Nums: 1, Checked: true},
+		{User: userId, Goods: 2, GoodsName: "批量测试商品2", GoodsPrice: model.Money(2000), Nums: 2, Checked: true},
+		{User: userId, Goods: 3, GoodsName: "批量测试商品3", GoodsPrice: model.Money(500), Nums: 3, Checked: true},
+		{User: otherUserId, Goods: 1, GoodsName: "其他用户商品", GoodsPrice: model.Money(1000), Nums: 1, Checked: true},
+	}
+	if err := global.DB.Create(&carts).Error; err != nil {
+		t.Fatalf("创建购物车记录失败: %v", err)
+	}
+	ids := []int32{carts[0].ID, carts[1].ID, carts[2].ID}
+	otherId := carts[3].ID
+
+	t.Run("批量选中", func(t *testing.T) {
+		summary, err := srv.CartItemBatchCheck(ctx, &proto.CartBatchCheckRequest{UserId: userId, Checked: false})
+		if err != nil {
+			t.Fatalf("全部取消选中失败: %v", err)
+		}
+		if summary.Total != 3 || summary.SelectedCount != 0 || summary.SelectedTotal != 0 {
+			t.Errorf("全部取消选中后汇总不正确: %+v", summary)
+		}
+
+		// 其他用户的记录不受影响
+		summary, err = srv.CartItemBatchCheck(ctx, &proto.CartBatchCheckRequest{UserId: userId, Ids: []int32{ids[1], ids[2], otherId}, Checked: true})
+		if err != nil {
+			t.Fatalf("批量选中失败: %v", err)
+		}
+		if summary.Affected != 2 || summary.SelectedCount != 2 || summary.SelectedNums != 5 || summary.SelectedTotal != 2*2000+3*500 {
+			t.Errorf("批量选中后汇总不正确: %+v", summary)
+		}
+	})
+
+	t.Run("批量修改数量", func(t *testing.T) {
+		summary, err := srv.CartItemBatchUpdate(ctx, &proto.CartBatchUpdateRequest{UserId: userId, Items: []*proto.CartItemNums{
+			{Id: ids[1], Nums: 4},
+			{Id: ids[2], Nums: 3},
+		}})
+		if err != nil {
+			t.Fatalf("批量修改数量失败: %v", err)
+		}
+		if summary.Affected != 1 || summary.SelectedNums != 7 || summary.SelectedTotal != 4*2000+3*500 {
+			t.Errorf("批量修改数量后汇总不正确: %+v", summary)
+		}
+
+		// 包含其他用户的记录时整体回滚
+		_, err = srv.CartItemBatchUpdate(ctx, &proto.CartBatchUpdateRequest{UserId: userId, Items: []*proto.CartItemNums{
+			{Id: ids[1], Nums: 9},
+			{Id: otherId, Nums: 9},
+		}})
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("包含其他用户的记录时期望NotFound，实际 %v", err)
+		}
+		var cart model.ShoppingCart
+		global.DB.First(&cart, ids[1])
+		if cart.Nums != 4 {
+			t.Errorf("修改失败后数量应保持为4，实际 %d", cart.Nums)
+		}
+	})
+
+	t.Run("批量删除", func(t *testing.T) {
+		summary, err := srv.CartItemBatchDelete(ctx, &proto.CartBatchRequest{UserId: userId, Ids: []int32{ids[0], ids[2], otherId}})
+		if err != nil {
+			t.Fatalf("批量删除失败: %v", err)
+		}
+		if summary.Affected != 2 || summary.Total != 1 || summary.SelectedCount != 1 || summary.SelectedNums != 4 {
+			t.Errorf("批量删除后汇总不正确: %+v", summary)
+		}
+		var count int64
+		global.DB.Model(&model.ShoppingCart{}).Where("id = ?", otherId).Count(&count)
+		if count != 1 {
+			t.Error("其他用户的购物车记录不应被删除")
+		}
+
+		if _, err := srv.CartItemBatchDelete(ctx, &proto.CartBatchRequest{UserId: userId}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("未指定记录时期望参数错误，实际 %v", err)
+		}
+	})
+}
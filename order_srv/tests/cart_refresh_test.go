@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+	goodsproto "order_srv/proto/goods"
+	inventoryproto "order_srv/proto/inventory"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// refreshGoodsServer 模拟商品服务，只返回goods中存在的商品
+type refreshGoodsServer struct {
+	goodsproto.UnimplementedGoodsServer
+	goods map[int32]*goodsproto.GoodsInfoResponse
+}
+
+func (f *refreshGoodsServer) BatchGetGoods(ctx context.Context, req *goodsproto.BatchGoodsIdInfo) (*goodsproto.GoodsListResponse, error) {
+	resp := &goodsproto.GoodsListResponse{}
+	for _, id := range req.Id {
+		if g, ok := f.goods[id]; ok {
+			resp.Data = append(resp.Data, g)
+		}
+	}
+	resp.Total = int32(len(resp.Data))
+	return resp, nil
+}
+
+func (f *refreshGoodsServer) GetGoodsDetail(ctx context.Context, req *goodsproto.GoodInfoRequest) (*goodsproto.GoodsInfoResponse, error) {
+	if g, ok := f.goods[req.Id]; ok {
+		return g, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "商品不存在")
+}
+
+// refreshInventoryServer 模拟库存服务，所有商品库存充足
+type refreshInventoryServer struct {
+	inventoryproto.UnimplementedInventoryServiceServer
+}
+
+func (f *refreshInventoryServer) BatchGetInventory(ctx context.Context, req *inventoryproto.BatchInvRequest) (*inventoryproto.BatchInvResponse, error) {
+	resp := &inventoryproto.BatchInvResponse{}
+	for _, id := range req.GoodsIds {
+		resp.Data = append(resp.Data, &inventoryproto.GoodsInvInfo{GoodsId: id, Num: 100})
+	}
+	return resp, nil
+}
+
+// serveFake 启动内存中的gRPC服务并返回连接，测试结束后关闭
+func serveFake(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(lis)
+	conn, err := grpc.NewClient("passthrough:///fake",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("连接模拟服务失败: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+	return conn
+}
+
+// useFakeGoodsServices 将商品服务和库存服务替换为模拟服务，goods为nil时模拟商品服务不可用
+func useFakeGoodsServices(t *testing.T, goods *refreshGoodsServer) {
+	oldGoods, oldInventory, oldConsul := global.GoodsClient, global.InventoryClient, global.ConsulClient
+	t.Cleanup(func() {
+		global.GoodsClient, global.InventoryClient, global.ConsulClient = oldGoods, oldInventory, oldConsul
+	})
+	global.GoodsClient, global.ConsulClient = nil, nil
+	if goods != nil {
+		global.GoodsClient = serveFake(t, func(s *grpc.Server) { goodsproto.RegisterGoodsServer(s, goods) })
+	}
+	global.InventoryClient = serveFake(t, func(s *grpc.Server) {
+		inventoryproto.RegisterInventoryServiceServer(s, &refreshInventoryServer{})
+	})
+}
+
+// createRefreshCarts 创建购物车记录：价格和名称已变化的商品1，未变化的商品2，已下架的商品3，已删除的商品4
+func createRefreshCarts(t *testing.T) (int32, []model.ShoppingCart) {
+	t.Helper()
+	userId := int32(990000 + time.Now().UnixNano()%10000)
+	carts := []model.ShoppingCart{
+		{User: userId, Goods: 1, GoodsName: "刷新测试商品1", GoodsPrice: model.Money(1300), Nums: 1, Checked: true},
+		{User: userId, Goods: 2, GoodsName: "刷新测试商品2", GoodsPrice: model.Money(2000), Nums: 2, Checked: true},
+		{User: userId, Goods: 3, GoodsName: "刷新测试商品3", GoodsPrice: model.Money(3000), Nums: 1, Checked: true},
+		{User: userId, Goods: 4, GoodsName: "刷新测试商品4", GoodsPrice: model.Money(4000), Nums: 1, Checked: true},
+	}
+	if err := global.DB.Create(&carts).Error; err != nil {
+		t.Fatalf("创建购物车记录失败: %v", err)
+	}
+	t.Cleanup(func() {
+		global.DB.Unscoped().Where("user = ?", userId).Delete(&model.ShoppingCart{})
+	})
+	return userId, carts
+}
+
+// TestCartItemRefresh 测试刷新购物车时更新价格和名称，移除已下架和已删除的商品
+func TestCartItemRefresh(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+	useFakeGoodsServices(t, &refreshGoodsServer{goods: map[int32]*goodsproto.GoodsInfoResponse{
+		1: {Id: 1, Name: "刷新测试商品1-新", ShopPrice: 12.5, GoodsFrontImage: "new.png", OnSale: true},
+		2: {Id: 2, Name: "刷新测试商品2", ShopPrice: 20, OnSale: true},
+		3: {Id: 3, Name: "刷新测试商品3", ShopPrice: 30, OnSale: false},
+	}})
+	userId, carts := createRefreshCarts(t)
+
+	resp, err := srv.CartItemRefresh(ctx, &proto.UserInfo{Id: userId})
+	if err != nil {
+		t.Fatalf("刷新购物车失败: %v", err)
+	}
+	if resp.Updated != 1 || resp.Removed != 2 || len(resp.RemovedItems) != 2 {
+		t.Errorf("应更新1条、移除2条，实际更新 %d，移除 %d", resp.Updated, resp.Removed)
+	}
+	removed := map[int32]bool{}
+	for _, item := range resp.RemovedItems {
+		removed[item.GoodsId] = true
+	}
+	if !removed[3] || !removed[4] {
+		t.Errorf("应移除已下架的商品3和已删除的商品4，实际 %v", removed)
+	}
+
+	var updated model.ShoppingCart
+	global.DB.First(&updated, carts[0].ID)
+	if updated.GoodsPrice != model.Money(1250) || updated.GoodsName != "刷新测试商品1-新" || updated.GoodsImage != "new.png" {
+		t.Errorf("商品1的购物车记录未更新: %+v", updated)
+	}
+	var count int64
+	global.DB.Model(&model.ShoppingCart{}).Where("user = ?", userId).Count(&count)
+	if count != 2 {
+		t.Errorf("刷新后应剩余2条购物车记录，实际 %d 条", count)
+	}
+
+	// 刷新后返回的购物车按当前售价展示，价格不再有变化
+	if resp.Cart == nil || len(resp.Cart.CartItems) != 2 {
+		t.Fatalf("刷新后应返回2条购物车记录: %+v", resp.Cart)
+	}
+	for _, item := range resp.Cart.CartItems {
+		if item.PriceChanged || !item.Available {
+			t.Errorf("刷新后的商品%d应无价格变化且可下单: %+v", item.GoodsId, item)
+		}
+	}
+}
+
+// TestCartItemRefreshGoodsUnavailable 测试商品服务不可用时刷新失败，购物车记录不变
+func TestCartItemRefreshGoodsUnavailable(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+	useFakeGoodsServices(t, nil)
+	userId, carts := createRefreshCarts(t)
+
+	_, err := srv.CartItemRefresh(ctx, &proto.UserInfo{Id: userId})
+	assertCode(t, err, codes.Unavailable, "商品服务不可用时刷新购物车")
+
+	var after []model.ShoppingCart
+	global.DB.Where("user = ?", userId).Order("id").Find(&after)
+	if len(after) != len(carts) {
+		t.Fatalf("刷新失败后不应删除购物车记录，实际剩余 %d 条", len(after))
+	}
+	for i := range after {
+		if after[i].GoodsPrice != carts[i].GoodsPrice || after[i].GoodsName != carts[i].GoodsName {
+			t.Errorf("刷新失败后购物车记录不应变化: %+v", after[i])
+		}
+	}
+}
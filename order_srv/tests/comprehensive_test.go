@@ -29,7 +29,6 @@ func TestComprehensiveOrderAndCartFlow(t *testing.T) {
 			GoodsId:    999, // 使用不存在的商品ID，避免与现有数据冲突
 			GoodsName:  "综合测试商品",
 			GoodsImage: "test-image.jpg", 
-			GoodsPrice: 199.99,
 			Nums:       2,
 			Checked:    true,
 		}
@@ -127,7 +126,7 @@ func TestComprehensiveOrderAndCartFlow(t *testing.T) {
 			OrderSn:      "COMPREHENSIVE_TEST_ORDER_001",
 			PayType:      "alipay",
 			Status:       "WAIT_BUYER_PAY",
-			OrderMount:   299.99,
+			OrderMount:   model.Money(29999),
 			Address:      "测试地址",
 			SignerName:   "测试用户",
 			SingerMobile: "13900139000",
@@ -7,6 +7,7 @@ replace order_srv => ../
 require (
 	github.com/spf13/viper v1.18.2
 	go.uber.org/zap v1.27.0
+	google.golang.org/grpc v1.72.1
 	gorm.io/driver/mysql v1.5.4
 	gorm.io/gorm v1.25.7
 	order_srv v0.0.0-00010101000000-000000000000
@@ -95,7 +96,6 @@ require (
 	golang.org/x/text v0.25.0 // indirect
 	golang.org/x/time v0.5.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250512202823-5a2f75b736a9 // indirect
-	google.golang.org/grpc v1.72.1 // indirect
 	google.golang.org/protobuf v1.36.6 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/natefinch/lumberjack.v2 v2.0.0 // indirect
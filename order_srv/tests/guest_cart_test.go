@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+	goodsproto "order_srv/proto/goods"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// newGuestCartToken 生成测试用的会话令牌，测试结束后清理游客购物车、合并记录和用户购物车
+func newGuestCartToken(t *testing.T, userId int32) string {
+	token := fmt.Sprintf("test-guest-cart-%d", time.Now().UnixNano())
+	t.Cleanup(func() {
+		ctx := context.Background()
+		key := fmt.Sprintf("guest_cart:{%s}", token)
+		global.RedisClient.Del(ctx, key, key+":merging")
+		global.DB.Unscoped().Where("session_token = ?", token).Delete(&model.CartMergeRecord{})
+		global.DB.Unscoped().Where("user = ?", userId).Delete(&model.ShoppingCart{})
+	})
+	return token
+}
+
+// putGuestCart 直接写入游客购物车，避免依赖加购时的商品查询
+func putGuestCart(t *testing.T, key string, goodsId, nums int32) {
+	t.Helper()
+	value, _ := json.Marshal(map[string]interface{}{
+		"goods_id": goodsId, "goods_name": "合并测试商品", "price": 1000, "nums": nums, "checked": true, "add_time": time.Now().Unix(),
+	})
+	if err := global.RedisClient.HSet(context.Background(), key, fmt.Sprint(goodsId), value).Err(); err != nil {
+		t.Fatalf("写入游客购物车失败: %v", err)
+	}
+}
+
+func userCartNums(t *testing.T, userId, goodsId int32) int32 {
+	t.Helper()
+	var cart model.ShoppingCart
+	if err := global.DB.Where("user = ? AND goods = ?", userId, goodsId).First(&cart).Error; err != nil {
+		t.Fatalf("查询用户购物车失败: %v", err)
+	}
+	return cart.Nums
+}
+
+// TestMergeCartIdempotent 测试同一会话令牌只合并一次，事务提交后中断留下的购物车在下次合并时跳过
+func TestMergeCartIdempotent(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+	useFakeGoodsServices(t, &refreshGoodsServer{goods: map[int32]*goodsproto.GoodsInfoResponse{
+		1: {Id: 1, Name: "合并测试商品", ShopPrice: 10, OnSale: true},
+	}})
+	userId := int32(960000 + time.Now().Unix()%10000)
+	token := newGuestCartToken(t, userId)
+	key := fmt.Sprintf("guest_cart:{%s}", token)
+
+	putGuestCart(t, key, 1, 2)
+	resp, err := srv.MergeCart(ctx, &proto.MergeCartRequest{UserId: userId, SessionToken: token})
+	if err != nil {
+		t.Fatalf("合并购物车失败: %v", err)
+	}
+	if resp.Added != 1 || userCartNums(t, userId, 1) != 2 {
+		t.Fatalf("首次合并应新增1个商品，实际新增 %d", resp.Added)
+	}
+
+	// 模拟上次合并在事务提交后、删除改名后的购物车前中断
+	putGuestCart(t, key+":merging", 1, 2)
+	resp, err = srv.MergeCart(ctx, &proto.MergeCartRequest{UserId: userId, SessionToken: token})
+	if err != nil {
+		t.Fatalf("重复合并失败: %v", err)
+	}
+	if resp.Added != 0 || resp.Merged != 0 {
+		t.Errorf("已合并的令牌不应再次合并，实际新增 %d，合并 %d", resp.Added, resp.Merged)
+	}
+	if got := userCartNums(t, userId, 1); got != 2 {
+		t.Errorf("重复合并后商品数量应保持 2，实际 %d", got)
+	}
+	if exists, _ := global.RedisClient.Exists(ctx, key+":merging").Result(); exists != 0 {
+		t.Error("跳过合并后应删除改名后的游客购物车")
+	}
+
+	var records int64
+	global.DB.Model(&model.CartMergeRecord{}).Where("session_token = ?", token).Count(&records)
+	if records != 1 {
+		t.Errorf("合并记录应只有1条，实际 %d 条", records)
+	}
+}
+
+// TestMergeCartRollbackKeepsToken 测试合并事务失败时不写入合并记录，恢复游客购物车后可以再次合并
+func TestMergeCartRollbackKeepsToken(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+	useFakeGoodsServices(t, &refreshGoodsServer{goods: map[int32]*goodsproto.GoodsInfoResponse{
+		1: {Id: 1, Name: "合并测试商品", ShopPrice: 10, OnSale: true},
+	}})
+	userId := int32(950000 + time.Now().Unix()%10000)
+	token := newGuestCartToken(t, userId)
+	key := fmt.Sprintf("guest_cart:{%s}", token)
+	putGuestCart(t, key, 1, 3)
+
+	const callbackName = "test:fail_cart_create"
+	if err := global.DB.Callback().Create().Before("gorm:create").Register(callbackName, func(db *gorm.DB) {
+		if _, ok := db.Statement.Dest.(*model.ShoppingCart); ok {
+			db.AddError(fmt.Errorf("模拟写入购物车失败"))
+		}
+	}); err != nil {
+		t.Fatalf("注册回调失败: %v", err)
+	}
+	_, err := srv.MergeCart(ctx, &proto.MergeCartRequest{UserId: userId, SessionToken: token})
+	global.DB.Callback().Create().Remove(callbackName)
+	assertCode(t, err, codes.Internal, "写入购物车失败时合并")
+
+	var records int64
+	global.DB.Model(&model.CartMergeRecord{}).Where("session_token = ?", token).Count(&records)
+	if records != 0 {
+		t.Fatalf("事务失败时不应写入合并记录，实际 %d 条", records)
+	}
+	if exists, _ := global.RedisClient.Exists(ctx, key).Result(); exists != 1 {
+		t.Fatal("事务失败时应恢复游客购物车")
+	}
+
+	resp, err := srv.MergeCart(ctx, &proto.MergeCartRequest{UserId: userId, SessionToken: token})
+	if err != nil {
+		t.Fatalf("再次合并失败: %v", err)
+	}
+	if resp.Added != 1 || userCartNums(t, userId, 1) != 3 {
+		t.Errorf("再次合并应新增商品，实际新增 %d", resp.Added)
+	}
+}
+
+// TestGuestCartAddConcurrent 测试并发加购同一商品时数量累加不丢失，超过上限时拒绝且数量不变
+func TestGuestCartAddConcurrent(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+	useFakeGoodsServices(t, &refreshGoodsServer{goods: map[int32]*goodsproto.GoodsInfoResponse{
+		1: {Id: 1, Name: "加购测试商品", ShopPrice: 10, OnSale: true},
+	}})
+
+	oldCfg := global.ServerConfig
+	cfg := *oldCfg
+	cfg.Cart.MaxNumsPerGoods = 25
+	global.ServerConfig = &cfg
+	defer func() { global.ServerConfig = oldCfg }()
+
+	userId := int32(940000 + time.Now().Unix()%10000)
+	token := newGuestCartToken(t, userId)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := srv.GuestCartAdd(ctx, &proto.GuestCartItemRequest{SessionToken: token, GoodsId: 1, Nums: 1, Checked: true}); err != nil {
+				t.Errorf("并发加购失败: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	resp, err := srv.GuestCartList(ctx, &proto.GuestCartRequest{SessionToken: token})
+	if err != nil {
+		t.Fatalf("查询游客购物车失败: %v", err)
+	}
+	if len(resp.Cart.CartItems) != 1 || resp.Cart.CartItems[0].Nums != 20 {
+		t.Fatalf("并发加购20次后数量应为20: %+v", resp.Cart.CartItems)
+	}
+
+	_, err = srv.GuestCartAdd(ctx, &proto.GuestCartItemRequest{SessionToken: token, GoodsId: 1, Nums: 6})
+	assertCode(t, err, codes.FailedPrecondition, "超过单个商品最大数量加购")
+	resp, _ = srv.GuestCartList(ctx, &proto.GuestCartRequest{SessionToken: token})
+	if resp.Cart.CartItems[0].Nums != 20 || !resp.Cart.CartItems[0].Checked {
+		t.Errorf("加购失败后数量和选中状态应不变: %+v", resp.Cart.CartItems[0])
+	}
+
+	// 合并后的令牌继续加购时生成新令牌
+	if _, err := srv.MergeCart(ctx, &proto.MergeCartRequest{UserId: userId, SessionToken: token}); err != nil {
+		t.Fatalf("合并购物车失败: %v", err)
+	}
+	added, err := srv.GuestCartAdd(ctx, &proto.GuestCartItemRequest{SessionToken: token, GoodsId: 1, Nums: 1})
+	if err != nil {
+		t.Fatalf("合并后加购失败: %v", err)
+	}
+	t.Cleanup(func() {
+		global.RedisClient.Del(context.Background(), fmt.Sprintf("guest_cart:{%s}", added.SessionToken))
+	})
+	if added.SessionToken == token {
+		t.Error("已合并的令牌加购时应生成新令牌")
+	}
+}
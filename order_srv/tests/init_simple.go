@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 
 	"order_srv/config"
@@ -18,6 +19,8 @@ import (
 	"gorm.io/gorm/schema"
 )
 
+var idGenOnce sync.Once
+
 // initTestEnvSimple 初始化测试环境
 func initTestEnvSimple(t *testing.T) {
 	// 初始化日志
@@ -96,4 +99,7 @@ func initTestEnvSimple(t *testing.T) {
 	
 	// 初始化服务客户端连接
 	initialize.InitServiceClients()
+
+	// 初始化订单号生成器，机器号在整个测试进程内只申请一次
+	idGenOnce.Do(initialize.InitIdGenerator)
 }
\ No newline at end of file
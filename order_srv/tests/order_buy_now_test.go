@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestOrderCreateBuyNow 测试立即购买只购买指定商品，不修改购物车
+func TestOrderCreateBuyNow(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+
+	userId := int32(2)
+	var cartsBefore []model.ShoppingCart
+	global.DB.Where("user = ?", userId).Order("id").Find(&cartsBefore)
+
+	order, err := srv.OrderCreate(ctx, &proto.OrderRequest{
+		UserId:  userId,
+		Address: "测试地址",
+		Name:    "测试用户",
+		Mobile:  "13800138000",
+		Post:    "立即购买测试",
+		Items:   []*proto.OrderGoodsItem{{GoodsId: 1, Nums: 1}},
+	})
+	if err != nil {
+		t.Fatalf("立即购买下单失败: %v", err)
+	}
+
+	detail, err := srv.OrderDetail(ctx, &proto.OrderRequest{Id: order.Id, UserId: userId})
+	if err != nil {
+		t.Fatalf("查询订单详情失败: %v", err)
+	}
+	if len(detail.Goods) != 1 || detail.Goods[0].GoodsId != 1 || detail.Goods[0].Nums != 1 {
+		t.Errorf("订单商品应只有商品1一件: %+v", detail.Goods)
+	}
+
+	var cartsAfter []model.ShoppingCart
+	global.DB.Where("user = ?", userId).Order("id").Find(&cartsAfter)
+	if len(cartsAfter) != len(cartsBefore) {
+		t.Fatalf("购物车记录数从 %d 变为 %d", len(cartsBefore), len(cartsAfter))
+	}
+	for i := range cartsBefore {
+		if cartsAfter[i].ID != cartsBefore[i].ID || cartsAfter[i].Nums != cartsBefore[i].Nums || cartsAfter[i].Checked != cartsBefore[i].Checked {
+			t.Errorf("购物车记录被修改: %+v -> %+v", cartsBefore[i], cartsAfter[i])
+		}
+	}
+
+	if _, err := srv.OrderCreate(ctx, &proto.OrderRequest{
+		UserId:  userId,
+		Address: "测试地址",
+		Name:    "测试用户",
+		Mobile:  "13800138000",
+		Items:   []*proto.OrderGoodsItem{{GoodsId: 1, Nums: 0}},
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("数量为0时期望参数错误，实际 %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestOrderPreview 测试下单预览的金额计算、不可下单商品和只读
+func TestOrderPreview(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+
+	t.Run("指定商品", func(t *testing.T) {
+		resp, err := srv.OrderPreview(ctx, &proto.OrderPreviewRequest{
+			UserId: 2,
+			Items:  []*proto.OrderGoodsItem{{GoodsId: 1, Nums: 1}, {GoodsId: 1, Nums: 1}},
+		})
+		if err != nil {
+			t.Fatalf("订单预览失败: %v", err)
+		}
+		if len(resp.Items) != 1 || resp.Items[0].Nums != 2 {
+			t.Fatalf("同一商品应合并为一条，数量为2: %+v", resp.Items)
+		}
+		item := resp.Items[0]
+		if !item.Available {
+			t.Skipf("商品1当前不可下单: %s", item.Reason)
+		}
+		if resp.GoodsTotal != item.Price*2 {
+			t.Errorf("商品总额 = %d，期望 %d", resp.GoodsTotal, item.Price*2)
+		}
+		if resp.Payable != resp.GoodsTotal+resp.ShippingFee-resp.PromotionDiscount-resp.CouponDiscount {
+			t.Errorf("应付金额不正确: %+v", resp)
+		}
+	})
+
+	t.Run("商品不存在", func(t *testing.T) {
+		resp, err := srv.OrderPreview(ctx, &proto.OrderPreviewRequest{
+			UserId: 2,
+			Items:  []*proto.OrderGoodsItem{{GoodsId: 999999, Nums: 1}},
+		})
+		if err != nil {
+			t.Fatalf("订单预览失败: %v", err)
+		}
+		if resp.Available || len(resp.Items) != 1 || resp.Items[0].Available || resp.Items[0].Reason == "" {
+			t.Errorf("不存在的商品应不可下单: %+v", resp)
+		}
+		if resp.Payable != 0 {
+			t.Errorf("没有可下单商品时应付金额应为0，实际 %d", resp.Payable)
+		}
+	})
+
+	t.Run("购物车没有选中商品", func(t *testing.T) {
+		userId := int32(970000 + time.Now().Unix()%10000)
+		_, err := srv.OrderPreview(ctx, &proto.OrderPreviewRequest{UserId: userId})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("期望FailedPrecondition，实际 %v", err)
+		}
+	})
+
+	t.Run("预览不修改购物车", func(t *testing.T) {
+		var before, after int64
+		global.DB.Model(&model.ShoppingCart{}).Where("user = ?", 2).Count(&before)
+		_, _ = srv.OrderPreview(ctx, &proto.OrderPreviewRequest{UserId: 2})
+		global.DB.Model(&model.ShoppingCart{}).Where("user = ?", 2).Count(&after)
+		if before != after {
+			t.Errorf("预览后购物车记录数从 %d 变为 %d", before, after)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+	"order_srv/utils"
+)
+
+// TestOrderSearchCursorOrdering 测试按支付时间和金额游标分页时，NULL和相同排序值的订单不重复也不遗漏
+func TestOrderSearchCursorOrdering(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+
+	// 使用单独的用户ID隔离测试数据
+	userId := int32(990000 + time.Now().Unix()%10000)
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	t1, t2 := base.Add(time.Minute), base.Add(2*time.Minute)
+	fixtures := []struct {
+		payTime *time.Time
+		amount  int64
+		created time.Time
+	}{
+		{nil, 100, base},
+		{&t1, 200, base},
+		{nil, 100, base.Add(time.Second)},
+		{&t2, 300, base.Add(time.Second)},
+		{&t1, 200, base},
+		{nil, 300, base.Add(2 * time.Second)},
+		{&t1, 100, base.Add(2 * time.Second)},
+	}
+	var orders []model.OrderInfo
+	for _, f := range fixtures {
+		orderSn, err := utils.GenerateOrderSn()
+		if err != nil {
+			t.Fatalf("生成订单号失败: %v", err)
+		}
+		order := model.OrderInfo{
+			BaseModel:  model.BaseModel{CreatedAt: f.created},
+			User:       userId,
+			OrderSn:    orderSn,
+			Status:     "WAIT_BUYER_PAY",
+			OrderMount: model.Money(f.amount),
+			PayTime:    f.payTime,
+		}
+		if err := global.DB.Create(&order).Error; err != nil {
+			t.Fatalf("创建测试订单失败: %v", err)
+		}
+		orders = append(orders, order)
+	}
+	defer global.DB.Unscoped().Where("user = ?", userId).Delete(&model.OrderInfo{})
+
+	// sortKey 与数据库排序一致：NULL最小，排序值相同时按ID
+	sortKey := map[string]func(o *model.OrderInfo) (bool, int64){
+		"add_time": func(o *model.OrderInfo) (bool, int64) { return true, o.CreatedAt.Unix() },
+		"pay_time": func(o *model.OrderInfo) (bool, int64) {
+			if o.PayTime == nil {
+				return false, 0
+			}
+			return true, o.PayTime.Unix()
+		},
+		"pay_amount": func(o *model.OrderInfo) (bool, int64) { return true, int64(o.OrderMount) },
+		"id":         func(o *model.OrderInfo) (bool, int64) { return true, int64(o.ID) },
+	}
+
+	for sortBy, key := range sortKey {
+		for _, asc := range []bool{true, false} {
+			expected := make([]model.OrderInfo, len(orders))
+			copy(expected, orders)
+			sort.Slice(expected, func(i, j int) bool {
+				iok, iv := key(&expected[i])
+				jok, jv := key(&expected[j])
+				less := false
+				switch {
+				case iok != jok:
+					less = !iok
+				case iv != jv:
+					less = iv < jv
+				default:
+					less = expected[i].ID < expected[j].ID
+				}
+				if asc {
+					return less
+				}
+				return !less
+			})
+
+			var got []int32
+			cursor := ""
+			for page := 0; page < len(orders); page++ {
+				resp, err := srv.OrderSearch(ctx, &proto.OrderSearchRequest{
+					UserId:    userId,
+					SortBy:    sortBy,
+					Ascending: asc,
+					Cursor:    cursor,
+					Limit:     2,
+				})
+				if err != nil {
+					t.Fatalf("%s 升序=%v 第%d页搜索失败: %v", sortBy, asc, page+1, err)
+				}
+				for _, o := range resp.Data {
+					got = append(got, o.Id)
+				}
+				if resp.NextCursor == "" {
+					break
+				}
+				cursor = resp.NextCursor
+			}
+
+			if len(got) != len(expected) {
+				t.Errorf("%s 升序=%v 返回 %d 个订单，期望 %d 个: %v", sortBy, asc, len(got), len(expected), got)
+				continue
+			}
+			for i := range expected {
+				if got[i] != expected[i].ID {
+					t.Errorf("%s 升序=%v 第%d个订单ID = %d，期望 %d，完整结果: %v", sortBy, asc, i+1, got[i], expected[i].ID, got)
+					break
+				}
+			}
+		}
+	}
+}
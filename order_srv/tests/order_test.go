@@ -34,7 +34,6 @@ func TestCartItemAddAndList(t *testing.T) {
 		GoodsId:    testItem.GoodsID,
 		GoodsName:  testItem.GoodsName,
 		GoodsImage: testItem.GoodsImage,
-		GoodsPrice: testItem.GoodsPrice,
 		Nums:       testItem.Nums,
 		Checked:    testItem.Checked,
 	}
@@ -122,7 +121,7 @@ func TestOrderCreateAndList(t *testing.T) {
 	t.Logf("用户ID %d 的订单数量: %d", orderReq.UserId, listResp.Total)
 	for _, order := range listResp.Data {
 		t.Logf("订单信息 - ID: %d, 订单号: %s, 状态: %s, 金额: %.2f",
-			order.Id, order.OrderSn, order.Status, order.Total)
+			order.Id, order.OrderSn, order.Status, float64(order.PayAmount)/100)
 	}
 }
 
@@ -194,7 +193,6 @@ func TestPredefinedCartItems(t *testing.T) {
 					GoodsId:    item.GoodsID,
 					GoodsName:  item.GoodsName,
 					GoodsImage: item.GoodsImage,
-					GoodsPrice: item.GoodsPrice,
 					Nums:       item.Nums,
 					Checked:    item.Checked,
 				}
@@ -302,12 +300,12 @@ func TestVIPUserOrders(t *testing.T) {
 			}
 
 			// 验证VIP订单特征（高金额）
-			if detailResp.OrderInfo.Total < 10000 { // VIP订单通常金额较高
-				t.Logf("注意：VIP订单金额较低: ¥%.2f", detailResp.OrderInfo.Total)
+			if detailResp.OrderInfo.PayAmount < 1000000 { // VIP订单通常金额较高
+				t.Logf("注意：VIP订单金额较低: ¥%.2f", float64(detailResp.OrderInfo.PayAmount)/100)
 			}
 
 			t.Logf("VIP订单详情 - 订单号: %s, 用户ID: %d, 金额: ¥%.2f, 状态: %s",
-				detailResp.OrderInfo.OrderSn, detailResp.OrderInfo.UserId, detailResp.OrderInfo.Total, detailResp.OrderInfo.Status)
+				detailResp.OrderInfo.OrderSn, detailResp.OrderInfo.UserId, float64(detailResp.OrderInfo.PayAmount)/100, detailResp.OrderInfo.Status)
 
 			// VIP订单应该有特殊备注
 			if len(detailResp.OrderInfo.Post) > 0 && (len(detailResp.OrderInfo.Post) < 3 || detailResp.OrderInfo.Post[:3] != "VIP") {
@@ -335,7 +333,6 @@ func TestRunOrderCreationScenarios(t *testing.T) {
 					GoodsId:    item.GoodsID,
 					GoodsName:  item.GoodsName,
 					GoodsImage: item.GoodsImage,
-					GoodsPrice: item.GoodsPrice,
 					Nums:       item.Nums,
 					Checked:    true, // 设为选中状态
 				}
@@ -396,12 +393,11 @@ func TestBatchCartOperations(t *testing.T) {
 	t.Run("批量添加商品", func(t *testing.T) {
 		for _, item := range batchItems {
 			addReq := &proto.CartItemRequest{
-				UserId:     item.UserID,
-				GoodsId:    item.GoodsID,
-				GoodsName:  item.GoodsName,
-				GoodsPrice: item.GoodsPrice,
-				Nums:       item.Nums,
-				Checked:    item.Checked,
+				UserId:    item.UserID,
+				GoodsId:   item.GoodsID,
+				GoodsName: item.GoodsName,
+				Nums:      item.Nums,
+				Checked:   item.Checked,
 			}
 
 			_, err := srv.CartItemAdd(ctx, addReq)
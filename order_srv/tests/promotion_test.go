@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+	"order_srv/utils"
+)
+
+// TestCategoryPromotionPreview 分类促销活动需要商品服务返回商品分类才能匹配
+func TestCategoryPromotionPreview(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+
+	goodsMap, err := utils.GetGoodsByIds(ctx, []int32{1})
+	if err != nil {
+		t.Fatalf("查询商品失败: %v", err)
+	}
+	goods, ok := goodsMap[1]
+	if !ok {
+		t.Fatal("商品1不存在")
+	}
+	if len(goods.CategoryIds) == 0 {
+		t.Fatal("批量查询商品未返回分类")
+	}
+
+	now := time.Now().Unix()
+	promotion, err := srv.PromotionCreate(ctx, &proto.PromotionRequest{
+		Name:      "分类立减测试",
+		Type:      "FIXED",
+		Amount:    100,
+		Scope:     "CATEGORY",
+		ScopeId:   goods.CategoryIds[0],
+		StartTime: now - 60,
+		EndTime:   now + 3600,
+	})
+	if err != nil {
+		t.Fatalf("创建促销活动失败: %v", err)
+	}
+	defer global.DB.Unscoped().Delete(&model.Promotion{}, promotion.Id)
+
+	preview, err := srv.OrderPreview(ctx, &proto.OrderPreviewRequest{
+		UserId: 2,
+		Items:  []*proto.OrderGoodsItem{{GoodsId: 1, Nums: 1}},
+	})
+	if err != nil {
+		t.Fatalf("订单预览失败: %v", err)
+	}
+	if preview.PromotionDiscount < 100 {
+		t.Errorf("活动优惠 = %d，期望至少 100", preview.PromotionDiscount)
+	}
+	found := false
+	for _, d := range preview.Discounts {
+		if d.Source == "PROMOTION" && d.SourceId == promotion.Id {
+			found = true
+			if d.Amount != 100 {
+				t.Errorf("分类活动优惠 = %d，期望 100", d.Amount)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("优惠明细中没有分类促销活动 %d: %+v", promotion.Id, preview.Discounts)
+	}
+}
@@ -0,0 +1,371 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/payment"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// refundTestOrder 已支付的测试订单，订单商品为 1000分×2 和 500分×1，应付2500分
+type refundTestOrder struct {
+	order model.OrderInfo
+	goods []model.OrderGoods
+}
+
+// createRefundTestOrder 创建已通过模拟渠道支付的订单，测试结束后清理订单、售后单和发件箱消息
+func createRefundTestOrder(t *testing.T, provider *payment.MockProvider) *refundTestOrder {
+	t.Helper()
+	ctx := context.Background()
+	orderSn, err := utils.GenerateOrderSn()
+	if err != nil {
+		t.Fatalf("生成订单号失败: %v", err)
+	}
+	now := time.Now()
+	o := &refundTestOrder{order: model.OrderInfo{
+		User:        int32(980000 + now.Unix()%10000),
+		OrderSn:     orderSn,
+		PayType:     payment.PayTypeMock,
+		Status:      "TRADE_SUCCESS",
+		GoodsAmount: 2500,
+		OrderMount:  2500,
+		PayTime:     &now,
+	}}
+	if err := global.DB.Create(&o.order).Error; err != nil {
+		t.Fatalf("创建测试订单失败: %v", err)
+	}
+	o.goods = []model.OrderGoods{
+		{Order: o.order.ID, Goods: 1, GoodsName: "售后测试商品1", GoodsPrice: 1000, Nums: 2},
+		{Order: o.order.ID, Goods: 2, GoodsName: "售后测试商品2", GoodsPrice: 500, Nums: 1},
+	}
+	if err := global.DB.Create(&o.goods).Error; err != nil {
+		t.Fatalf("创建测试订单商品失败: %v", err)
+	}
+
+	if _, err := provider.CreatePayment(ctx, &payment.CreateRequest{OutTradeNo: orderSn, AmountCents: 2500}); err != nil {
+		t.Fatalf("创建模拟支付失败: %v", err)
+	}
+	headers, body := provider.BuildNotify(orderSn, "T"+orderSn, 2500)
+	if _, err := provider.VerifyNotify(ctx, headers, body); err != nil {
+		t.Fatalf("模拟支付回调失败: %v", err)
+	}
+	if err := global.DB.Create(&model.PaymentRecord{
+		Order:   o.order.ID,
+		OrderSn: orderSn,
+		PayType: payment.PayTypeMock,
+		TradeNo: "T" + orderSn,
+		Amount:  2500,
+		Status:  model.PaymentStatusSuccess,
+		PaidAt:  &now,
+	}).Error; err != nil {
+		t.Fatalf("创建支付记录失败: %v", err)
+	}
+
+	t.Cleanup(func() {
+		var refunds []model.RefundOrder
+		global.DB.Unscoped().Where("`order` = ?", o.order.ID).Find(&refunds)
+		for _, r := range refunds {
+			global.DB.Unscoped().Where("refund = ?", r.ID).Delete(&model.RefundGoods{})
+			global.DB.Unscoped().Where("msg_key = ?", "stock_reback:refund:"+r.RefundSn).Delete(&model.OutboxMessage{})
+		}
+		global.DB.Unscoped().Where("`order` = ?", o.order.ID).Delete(&model.RefundOrder{})
+		global.DB.Unscoped().Where("order_sn = ?", orderSn).Delete(&model.PaymentRecord{})
+		global.DB.Unscoped().Where("`order` = ?", o.order.ID).Delete(&model.OrderStatusLog{})
+		global.DB.Unscoped().Where("`order` = ?", o.order.ID).Delete(&model.OrderGoods{})
+		global.DB.Unscoped().Delete(&model.OrderInfo{}, o.order.ID)
+	})
+	return o
+}
+
+// setupRefundTest 初始化测试环境并注册模拟支付渠道
+func setupRefundTest(t *testing.T) (*handler.OrderServiceServer, *payment.MockProvider) {
+	initTestEnvSimple(t)
+	provider := payment.NewMockProvider("refund-test")
+	payment.Register(provider)
+	return &handler.OrderServiceServer{}, provider
+}
+
+func assertCode(t *testing.T, err error, want codes.Code, action string) {
+	t.Helper()
+	if status.Code(err) != want {
+		t.Errorf("%s 期望错误码 %v，实际: %v", action, want, err)
+	}
+}
+
+func loadRefund(t *testing.T, id int32) *model.RefundOrder {
+	t.Helper()
+	var refund model.RefundOrder
+	if err := global.DB.First(&refund, id).Error; err != nil {
+		t.Fatalf("查询售后单失败: %v", err)
+	}
+	return &refund
+}
+
+func orderStatus(t *testing.T, id int32) string {
+	t.Helper()
+	var order model.OrderInfo
+	if err := global.DB.Select("status").First(&order, id).Error; err != nil {
+		t.Fatalf("查询订单失败: %v", err)
+	}
+	return order.Status
+}
+
+// TestRefundCreateLimits 测试退款金额和退货数量不能超过剩余可退部分，撤销的售后单不计入已退
+func TestRefundCreateLimits(t *testing.T) {
+	srv, provider := setupRefundTest(t)
+	ctx := context.Background()
+	o := createRefundTestOrder(t, provider)
+	line := o.goods[0]
+
+	_, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, Type: model.RefundTypeRefundOnly, Reason: "测试", RefundAmount: 2501,
+	})
+	assertCode(t, err, codes.FailedPrecondition, "退款金额超出订单金额")
+
+	_, err = srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, OrderGoodsId: line.ID, Type: model.RefundTypeReturnGoods, Reason: "测试", Nums: 3,
+	})
+	assertCode(t, err, codes.FailedPrecondition, "退货数量超出购买数量")
+
+	// 退1件时可退金额按数量折算为1000分
+	_, err = srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, OrderGoodsId: line.ID, Type: model.RefundTypeReturnGoods, Reason: "测试", Nums: 1, RefundAmount: 1001,
+	})
+	assertCode(t, err, codes.FailedPrecondition, "退款金额超出退货商品金额")
+
+	first, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, OrderGoodsId: line.ID, Type: model.RefundTypeReturnGoods, Reason: "测试", Nums: 1,
+	})
+	if err != nil {
+		t.Fatalf("申请退货失败: %v", err)
+	}
+	if first.RefundAmount != 1000 || len(first.Goods) != 1 || first.Goods[0].Nums != 1 {
+		t.Errorf("退货金额或数量错误: 金额 %d，商品 %+v", first.RefundAmount, first.Goods)
+	}
+
+	_, err = srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, Type: model.RefundTypeRefundOnly, Reason: "测试",
+	})
+	assertCode(t, err, codes.FailedPrecondition, "已有处理中的售后时再次申请")
+
+	// 撤销后数量和金额退回可退部分
+	if _, err := srv.RefundCancel(ctx, &proto.RefundOperateRequest{Id: first.Id, UserId: o.order.User}); err != nil {
+		t.Fatalf("撤销售后失败: %v", err)
+	}
+	second, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, OrderGoodsId: line.ID, Type: model.RefundTypeReturnGoods, Reason: "测试",
+	})
+	if err != nil {
+		t.Fatalf("撤销后再次申请退货失败: %v", err)
+	}
+	if second.RefundAmount != 2000 || second.Goods[0].Nums != 2 {
+		t.Errorf("撤销后可退金额或数量错误: 金额 %d，数量 %d", second.RefundAmount, second.Goods[0].Nums)
+	}
+}
+
+// TestRefundTransitions 测试售后单只能按状态流转规则变更
+func TestRefundTransitions(t *testing.T) {
+	srv, provider := setupRefundTest(t)
+	ctx := context.Background()
+	o := createRefundTestOrder(t, provider)
+
+	refund, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, Type: model.RefundTypeRefundOnly, Reason: "测试", RefundAmount: 100,
+	})
+	if err != nil {
+		t.Fatalf("申请售后失败: %v", err)
+	}
+	_, err = srv.RefundAudit(ctx, &proto.RefundAuditRequest{Id: refund.Id, Approve: false})
+	assertCode(t, err, codes.InvalidArgument, "拒绝时不填写原因")
+	_, err = srv.RefundConfirmReturn(ctx, &proto.RefundOperateRequest{Id: refund.Id})
+	assertCode(t, err, codes.FailedPrecondition, "仅退款的售后单确认退货")
+
+	if _, err := srv.RefundCancel(ctx, &proto.RefundOperateRequest{Id: refund.Id, UserId: o.order.User}); err != nil {
+		t.Fatalf("撤销售后失败: %v", err)
+	}
+	_, err = srv.RefundAudit(ctx, &proto.RefundAuditRequest{Id: refund.Id, Approve: true})
+	assertCode(t, err, codes.FailedPrecondition, "审核已撤销的售后单")
+	_, err = srv.RefundCancel(ctx, &proto.RefundOperateRequest{Id: refund.Id, UserId: o.order.User})
+	assertCode(t, err, codes.FailedPrecondition, "重复撤销售后单")
+
+	// 退货退款同意后等待退货，等待退货时仍可撤销
+	refund, err = srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, OrderGoodsId: o.goods[1].ID, Type: model.RefundTypeReturnGoods, Reason: "测试",
+	})
+	if err != nil {
+		t.Fatalf("申请退货失败: %v", err)
+	}
+	audited, err := srv.RefundAudit(ctx, &proto.RefundAuditRequest{Id: refund.Id, Approve: true})
+	if err != nil {
+		t.Fatalf("审核退货失败: %v", err)
+	}
+	if audited.Status != model.RefundStatusWaitReturn {
+		t.Errorf("同意退货后状态应为 %s，实际 %s", model.RefundStatusWaitReturn, audited.Status)
+	}
+	if _, err := srv.RefundCancel(ctx, &proto.RefundOperateRequest{Id: refund.Id, UserId: o.order.User}); err != nil {
+		t.Errorf("等待退货时撤销失败: %v", err)
+	}
+	if got := orderStatus(t, o.order.ID); got != "TRADE_SUCCESS" {
+		t.Errorf("没有退款时订单状态不应变化，实际 %s", got)
+	}
+}
+
+// TestRefundFullOrderRefunded 测试订单金额全部退回后订单才置为已退款
+func TestRefundFullOrderRefunded(t *testing.T) {
+	srv, provider := setupRefundTest(t)
+	ctx := context.Background()
+	o := createRefundTestOrder(t, provider)
+
+	partial, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, Type: model.RefundTypeRefundOnly, Reason: "测试", RefundAmount: 1000,
+	})
+	if err != nil {
+		t.Fatalf("申请部分退款失败: %v", err)
+	}
+	resp, err := srv.RefundAudit(ctx, &proto.RefundAuditRequest{Id: partial.Id, Approve: true})
+	if err != nil {
+		t.Fatalf("审核退款失败: %v", err)
+	}
+	if resp.Status != model.RefundStatusRefunded {
+		t.Fatalf("部分退款后售后单状态应为 %s，实际 %s（%s）", model.RefundStatusRefunded, resp.Status, resp.FailReason)
+	}
+	if got := orderStatus(t, o.order.ID); got != "TRADE_SUCCESS" {
+		t.Errorf("部分退款后订单状态应保持 TRADE_SUCCESS，实际 %s", got)
+	}
+
+	rest, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, Type: model.RefundTypeRefundOnly, Reason: "测试",
+	})
+	if err != nil {
+		t.Fatalf("申请剩余金额退款失败: %v", err)
+	}
+	if rest.RefundAmount != 1500 {
+		t.Errorf("剩余可退金额应为1500，实际 %d", rest.RefundAmount)
+	}
+	if _, err := srv.RefundAudit(ctx, &proto.RefundAuditRequest{Id: rest.Id, Approve: true}); err != nil {
+		t.Fatalf("审核退款失败: %v", err)
+	}
+	if got := orderStatus(t, o.order.ID); got != "TRADE_REFUNDED" {
+		t.Errorf("全额退款后订单状态应为 TRADE_REFUNDED，实际 %s", got)
+	}
+}
+
+// TestRefundChannelProcessing 测试渠道退款处理中时售后单保持退款中，由退款查询任务确认到账
+func TestRefundChannelProcessing(t *testing.T) {
+	srv, provider := setupRefundTest(t)
+	ctx := context.Background()
+	provider.SetAsyncRefund(true)
+	o := createRefundTestOrder(t, provider)
+
+	refund, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, Type: model.RefundTypeRefundOnly, Reason: "测试",
+	})
+	if err != nil {
+		t.Fatalf("申请退款失败: %v", err)
+	}
+	resp, err := srv.RefundAudit(ctx, &proto.RefundAuditRequest{Id: refund.Id, Approve: true})
+	if err != nil {
+		t.Fatalf("审核退款失败: %v", err)
+	}
+	if resp.Status != model.RefundStatusRefunding {
+		t.Fatalf("渠道处理中时售后单应为 %s，实际 %s", model.RefundStatusRefunding, resp.Status)
+	}
+	record := loadRefund(t, refund.Id)
+	if record.PayType != payment.PayTypeMock || record.ChannelRefund == "" || record.RefundedAt != nil {
+		t.Errorf("处理中的售后单应记录渠道退款单号且没有退款时间: %+v", record)
+	}
+
+	// expire 将更新时间提前，使退款查询任务立即处理
+	expire := func() {
+		global.DB.Model(&model.RefundOrder{}).Where("id = ?", refund.Id).UpdateColumn("updated_at", time.Now().Add(-2*time.Minute))
+	}
+	expire()
+	handler.SyncRefundingRefunds(ctx)
+	if got := loadRefund(t, refund.Id).Status; got != model.RefundStatusRefunding {
+		t.Errorf("渠道仍在处理时售后单应保持 %s，实际 %s", model.RefundStatusRefunding, got)
+	}
+	if got := orderStatus(t, o.order.ID); got != "TRADE_SUCCESS" {
+		t.Errorf("退款未到账时订单状态不应变化，实际 %s", got)
+	}
+
+	if err := provider.SetRefundStatus(o.order.OrderSn, refund.RefundSn, payment.RefundStatusSuccess); err != nil {
+		t.Fatalf("设置渠道退款结果失败: %v", err)
+	}
+	expire()
+	handler.SyncRefundingRefunds(ctx)
+	record = loadRefund(t, refund.Id)
+	if record.Status != model.RefundStatusRefunded || record.RefundedAt == nil {
+		t.Errorf("渠道退款到账后售后单应为 %s，实际 %s", model.RefundStatusRefunded, record.Status)
+	}
+	if got := orderStatus(t, o.order.ID); got != "TRADE_REFUNDED" {
+		t.Errorf("全额退款到账后订单状态应为 TRADE_REFUNDED，实际 %s", got)
+	}
+}
+
+// TestRefundConfirmReturnRollback 测试归还库存消息写入失败时确认退货整体回滚，可以再次确认
+func TestRefundConfirmReturnRollback(t *testing.T) {
+	srv, provider := setupRefundTest(t)
+	ctx := context.Background()
+	o := createRefundTestOrder(t, provider)
+
+	refund, err := srv.RefundCreate(ctx, &proto.RefundRequest{
+		OrderId: o.order.ID, UserId: o.order.User, OrderGoodsId: o.goods[0].ID, Type: model.RefundTypeReturnGoods, Reason: "测试",
+	})
+	if err != nil {
+		t.Fatalf("申请退货失败: %v", err)
+	}
+	if _, err := srv.RefundAudit(ctx, &proto.RefundAuditRequest{Id: refund.Id, Approve: true}); err != nil {
+		t.Fatalf("审核退货失败: %v", err)
+	}
+	msgKey := "stock_reback:refund:" + refund.RefundSn
+	countMessages := func() int64 {
+		var n int64
+		global.DB.Model(&model.OutboxMessage{}).Where("msg_key = ?", msgKey).Count(&n)
+		return n
+	}
+
+	const callbackName = "test:fail_outbox_enqueue"
+	if err := global.DB.Callback().Create().Before("gorm:create").Register(callbackName, func(db *gorm.DB) {
+		if db.Statement.Schema != nil && db.Statement.Schema.Table == "outbox_message" {
+			db.AddError(errors.New("模拟写入发件箱失败"))
+		}
+	}); err != nil {
+		t.Fatalf("注册回调失败: %v", err)
+	}
+	_, err = srv.RefundConfirmReturn(ctx, &proto.RefundOperateRequest{Id: refund.Id})
+	global.DB.Callback().Create().Remove(callbackName)
+	assertCode(t, err, codes.Internal, "写入归还库存消息失败时确认退货")
+
+	record := loadRefund(t, refund.Id)
+	if record.Status != model.RefundStatusWaitReturn || record.StockReturned {
+		t.Errorf("确认退货失败后售后单应回滚为 %s 且库存未归还，实际 %s，已归还 %v", model.RefundStatusWaitReturn, record.Status, record.StockReturned)
+	}
+	if n := countMessages(); n != 0 {
+		t.Errorf("确认退货失败后不应有归还库存消息，实际 %d 条", n)
+	}
+
+	resp, err := srv.RefundConfirmReturn(ctx, &proto.RefundOperateRequest{Id: refund.Id})
+	if err != nil {
+		t.Fatalf("再次确认退货失败: %v", err)
+	}
+	if resp.Status != model.RefundStatusRefunded {
+		t.Errorf("确认退货后售后单应为 %s，实际 %s（%s）", model.RefundStatusRefunded, resp.Status, resp.FailReason)
+	}
+	if n := countMessages(); n != 1 {
+		t.Errorf("确认退货后应写入1条归还库存消息，实际 %d 条", n)
+	}
+	if !loadRefund(t, refund.Id).StockReturned {
+		t.Error("确认退货后售后单应标记库存已归还")
+	}
+}
@@ -0,0 +1,215 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"order_srv/config"
+	"order_srv/global"
+	"order_srv/handler"
+	"order_srv/model"
+	"order_srv/proto"
+	"order_srv/utils"
+
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// createShipTestOrder 创建指定状态的测试订单，订单商品为 商品1×2 和 商品2×1，测试结束后清理订单和发货单
+func createShipTestOrder(t *testing.T, orderStatus string, shipTime *time.Time) (*model.OrderInfo, []model.OrderGoods) {
+	t.Helper()
+	orderSn, err := utils.GenerateOrderSn()
+	if err != nil {
+		t.Fatalf("生成订单号失败: %v", err)
+	}
+	order := &model.OrderInfo{
+		User:       int32(970000 + time.Now().Unix()%10000),
+		OrderSn:    orderSn,
+		Status:     orderStatus,
+		OrderMount: 2500,
+		ShipTime:   shipTime,
+	}
+	if err := global.DB.Create(order).Error; err != nil {
+		t.Fatalf("创建测试订单失败: %v", err)
+	}
+	goods := []model.OrderGoods{
+		{Order: order.ID, Goods: 1, GoodsName: "发货测试商品1", GoodsPrice: 1000, Nums: 2},
+		{Order: order.ID, Goods: 2, GoodsName: "发货测试商品2", GoodsPrice: 500, Nums: 1},
+	}
+	if err := global.DB.Create(&goods).Error; err != nil {
+		t.Fatalf("创建测试订单商品失败: %v", err)
+	}
+
+	t.Cleanup(func() {
+		var shipments []model.Shipment
+		global.DB.Unscoped().Where("`order` = ?", order.ID).Find(&shipments)
+		for _, s := range shipments {
+			global.DB.Unscoped().Where("shipment = ?", s.ID).Delete(&model.ShipmentTrack{})
+		}
+		global.DB.Unscoped().Where("`order` = ?", order.ID).Delete(&model.ShipmentGoods{})
+		global.DB.Unscoped().Where("`order` = ?", order.ID).Delete(&model.Shipment{})
+		global.DB.Unscoped().Where("`order` = ?", order.ID).Delete(&model.OrderStatusLog{})
+		global.DB.Unscoped().Where("`order` = ?", order.ID).Delete(&model.OrderGoods{})
+		global.DB.Unscoped().Delete(&model.OrderInfo{}, order.ID)
+	})
+	return order, goods
+}
+
+// TestOrderShipPartial 测试部分发货进入部分发货状态，剩余商品发出后进入已发货状态
+func TestOrderShipPartial(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+	order, goods := createShipTestOrder(t, "TRADE_SUCCESS", nil)
+
+	first, err := srv.OrderShip(ctx, &proto.OrderShipRequest{
+		OrderId:    order.ID,
+		Carrier:    "SF",
+		TrackingNo: "SF" + order.OrderSn + "1",
+		Items:      []*proto.ShipGoodsItem{{OrderGoodsId: goods[0].ID, Nums: 1}},
+	})
+	if err != nil {
+		t.Fatalf("部分发货失败: %v", err)
+	}
+	if len(first.Goods) != 1 || first.Goods[0].Nums != 1 {
+		t.Errorf("第一个发货单的商品错误: %+v", first.Goods)
+	}
+	if got := orderStatus(t, order.ID); got != "PART_SHIPPED" {
+		t.Errorf("部分发货后订单状态应为 PART_SHIPPED，实际 %s", got)
+	}
+
+	// 部分发货状态下继续发货，未全部发出时保持部分发货
+	if _, err := srv.OrderShip(ctx, &proto.OrderShipRequest{
+		OrderId:    order.ID,
+		Carrier:    "SF",
+		TrackingNo: "SF" + order.OrderSn + "2",
+		Items:      []*proto.ShipGoodsItem{{OrderGoodsId: goods[1].ID, Nums: 1}},
+	}); err != nil {
+		t.Fatalf("继续部分发货失败: %v", err)
+	}
+	if got := orderStatus(t, order.ID); got != "PART_SHIPPED" {
+		t.Errorf("仍有未发货商品时订单状态应为 PART_SHIPPED，实际 %s", got)
+	}
+
+	_, err = srv.OrderShip(ctx, &proto.OrderShipRequest{
+		OrderId:    order.ID,
+		Carrier:    "SF",
+		TrackingNo: "SF" + order.OrderSn + "3",
+		Items:      []*proto.ShipGoodsItem{{OrderGoodsId: goods[0].ID, Nums: 2}},
+	})
+	assertCode(t, err, codes.InvalidArgument, "超过未发货数量发货")
+
+	// 未指定商品时发出剩余的全部商品
+	last, err := srv.OrderShip(ctx, &proto.OrderShipRequest{
+		OrderId:    order.ID,
+		Carrier:    "SF",
+		TrackingNo: "SF" + order.OrderSn + "4",
+	})
+	if err != nil {
+		t.Fatalf("发出剩余商品失败: %v", err)
+	}
+	if len(last.Goods) != 1 || last.Goods[0].OrderGoodsId != goods[0].ID || last.Goods[0].Nums != 1 {
+		t.Errorf("最后一个发货单应只包含剩余的1件商品: %+v", last.Goods)
+	}
+	var shipped model.OrderInfo
+	global.DB.First(&shipped, order.ID)
+	if shipped.Status != "SHIPPED" || shipped.ShipTime == nil {
+		t.Errorf("全部发货后订单状态应为 SHIPPED 并记录发货时间，实际 %s，发货时间 %v", shipped.Status, shipped.ShipTime)
+	}
+
+	_, err = srv.OrderShip(ctx, &proto.OrderShipRequest{OrderId: order.ID, Carrier: "SF", TrackingNo: "SF" + order.OrderSn + "5"})
+	assertCode(t, err, codes.FailedPrecondition, "已全部发货的订单再次发货")
+
+	list, err := srv.OrderShipmentList(ctx, &proto.OrderRequest{Id: order.ID})
+	if err != nil {
+		t.Fatalf("查询发货单失败: %v", err)
+	}
+	if len(list.Shipments) != 3 {
+		t.Errorf("订单应有3个发货单，实际 %d 个", len(list.Shipments))
+	}
+}
+
+// TestOrderShipDuplicateTrackingNo 测试物流单号重复时发货失败，订单状态和发货商品不变
+func TestOrderShipDuplicateTrackingNo(t *testing.T) {
+	initTestEnvSimple(t)
+	srv := &handler.OrderServiceServer{}
+	ctx := context.Background()
+	order, goods := createShipTestOrder(t, "TRADE_SUCCESS", nil)
+	trackingNo := "YT" + order.OrderSn
+
+	if _, err := srv.OrderShip(ctx, &proto.OrderShipRequest{
+		OrderId:    order.ID,
+		Carrier:    "YTO",
+		TrackingNo: trackingNo,
+		Items:      []*proto.ShipGoodsItem{{OrderGoodsId: goods[0].ID, Nums: 1}},
+	}); err != nil {
+		t.Fatalf("发货失败: %v", err)
+	}
+
+	_, err := srv.OrderShip(ctx, &proto.OrderShipRequest{OrderId: order.ID, Carrier: "YTO", TrackingNo: trackingNo})
+	assertCode(t, err, codes.AlreadyExists, "使用重复的物流单号发货")
+
+	if got := orderStatus(t, order.ID); got != "PART_SHIPPED" {
+		t.Errorf("发货失败后订单状态应保持 PART_SHIPPED，实际 %s", got)
+	}
+	var count int64
+	global.DB.Model(&model.ShipmentGoods{}).Where("`order` = ?", order.ID).Count(&count)
+	if count != 1 {
+		t.Errorf("发货失败后不应写入发货商品，实际 %d 行", count)
+	}
+
+	// 不同物流公司可以使用相同的物流单号
+	if _, err := srv.OrderShip(ctx, &proto.OrderShipRequest{OrderId: order.ID, Carrier: "ZTO", TrackingNo: trackingNo}); err != nil {
+		t.Errorf("不同物流公司使用相同物流单号发货失败: %v", err)
+	}
+}
+
+// TestAutoConfirmReceiptSkipsChanged 测试自动确认收货时跳过扫描后状态已变化的订单，其余订单正常确认
+func TestAutoConfirmReceiptSkipsChanged(t *testing.T) {
+	initTestEnvSimple(t)
+	ctx := context.Background()
+
+	// 使用很长的自动确认天数，只处理本测试创建的订单
+	oldCfg := global.ServerConfig
+	cfg := *oldCfg
+	cfg.Delivery = config.DeliveryConfig{AutoConfirmDays: 3650}
+	global.ServerConfig = &cfg
+	defer func() { global.ServerConfig = oldCfg }()
+
+	shipTime := time.Now().AddDate(0, 0, -3651)
+	normal, _ := createShipTestOrder(t, "SHIPPED", &shipTime)
+	changed, _ := createShipTestOrder(t, "SHIPPED", &shipTime)
+
+	// 扫描查出订单后，模拟用户同时对其中一个订单完成了全额退款
+	const callbackName = "test:change_order_after_scan"
+	var once sync.Once
+	if err := global.DB.Callback().Query().After("gorm:query").Register(callbackName, func(db *gorm.DB) {
+		if _, ok := db.Statement.Dest.(*[]model.OrderInfo); !ok {
+			return
+		}
+		once.Do(func() {
+			global.DB.Model(&model.OrderInfo{}).Where("id = ?", changed.ID).UpdateColumn("status", "TRADE_REFUNDED")
+		})
+	}); err != nil {
+		t.Fatalf("注册回调失败: %v", err)
+	}
+	defer global.DB.Callback().Query().Remove(callbackName)
+
+	handler.AutoConfirmReceipt(ctx)
+
+	var confirmed model.OrderInfo
+	global.DB.First(&confirmed, normal.ID)
+	if confirmed.Status != "TRADE_FINISHED" || confirmed.ReceiveTime == nil {
+		t.Errorf("超时未确认的订单应自动确认收货，实际 %s", confirmed.Status)
+	}
+	if got := orderStatus(t, changed.ID); got != "TRADE_REFUNDED" {
+		t.Errorf("状态已变化的订单不应被自动确认收货，实际 %s", got)
+	}
+	var logs int64
+	global.DB.Model(&model.OrderStatusLog{}).Where("`order` = ?", changed.ID).Count(&logs)
+	if logs != 0 {
+		t.Errorf("跳过的订单不应写入状态流转日志，实际 %d 条", logs)
+	}
+}
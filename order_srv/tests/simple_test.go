@@ -21,7 +21,6 @@ func TestSimpleCartAdd(t *testing.T) {
 		GoodsId:    1,
 		GoodsName:  "测试商品",
 		GoodsImage: "test.jpg",
-		GoodsPrice: 99.99,
 		Nums:       1,
 		Checked:    true,
 	}
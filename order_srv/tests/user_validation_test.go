@@ -2,9 +2,18 @@ package tests
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"order_srv/global"
 	"order_srv/handler"
 	"order_srv/proto"
+	userpb "order_srv/proto/user"
+	"order_srv/util"
 	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestOrderCreateWithInvalidUser(t *testing.T) {
@@ -14,23 +23,28 @@ func TestOrderCreateWithInvalidUser(t *testing.T) {
 	srv := &handler.OrderServiceServer{}
 	ctx := context.Background()
 
-	// 测试无效用户ID（超出合理范围）
-	invalidUserID := int32(9999)
-	orderReq := &proto.OrderRequest{
-		UserId:  invalidUserID,
-		Address: "测试地址",
-		Name:    "测试用户",
-		Mobile:  "13800138000",
-		Post:    "测试订单",
+	// 已删除的用户和用户服务中不存在的用户都应被拒绝
+	deletedUserID := createTestUser(t)
+	if _, err := userpb.NewUserClient(global.UserClient).DeleteUser(ctx, &userpb.IdRequest{Id: deletedUserID}); err != nil {
+		t.Fatalf("删除测试用户失败: %v", err)
 	}
 
-	t.Logf("尝试为无效用户创建订单，用户ID: %d", invalidUserID)
-	_, err := srv.OrderCreate(ctx, orderReq)
-	
-	if err == nil {
-		t.Error("无效用户创建订单应该失败但成功了")
-	} else {
-		t.Logf("无效用户创建订单正确失败: %v", err)
+	for _, invalidUserID := range []int32{deletedUserID, math.MaxInt32} {
+		orderReq := &proto.OrderRequest{
+			UserId:  invalidUserID,
+			Address: "测试地址",
+			Name:    "测试用户",
+			Mobile:  "13800138000",
+			Post:    "测试订单",
+		}
+
+		t.Logf("尝试为无效用户创建订单，用户ID: %d", invalidUserID)
+		_, err := srv.OrderCreate(ctx, orderReq)
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("无效用户 %d 创建订单应返回用户不存在，实际: %v", invalidUserID, err)
+		} else {
+			t.Logf("无效用户创建订单正确失败: %v", err)
+		}
 	}
 }
 
@@ -70,31 +84,45 @@ func TestOrderCreateWithValidUser(t *testing.T) {
 func TestOrderCreateWithNewUser(t *testing.T) {
 	t.Log("测试新用户创建订单")
 
-	initTestEnvSimple(t) 
+	initTestEnvSimple(t)
 	srv := &handler.OrderServiceServer{}
 	ctx := context.Background()
 
-	// 测试新用户ID（在合理范围内但没有历史数据）
-	newUserID := int32(500)
+	// 刚注册、没有历史数据的用户
+	newUserID := createTestUser(t)
+	defer userpb.NewUserClient(global.UserClient).DeleteUser(ctx, &userpb.IdRequest{Id: newUserID})
 	orderReq := &proto.OrderRequest{
 		UserId:  newUserID,
 		Address: "测试地址",
 		Name:    "新用户",
-		Mobile:  "13800138000", 
+		Mobile:  "13800138000",
 		Post:    "新用户订单",
 	}
 
 	t.Logf("为新用户创建订单，用户ID: %d", newUserID)
 	_, err := srv.OrderCreate(ctx, orderReq)
-	
-	// 新用户应该被允许创建订单
-	if err != nil {
-		if err.Error() == "rpc error: code = NotFound desc = 用户不存在" {
-			t.Error("新用户被错误拒绝")
-		} else {
-			t.Logf("新用户通过验证，但因其他原因失败（预期）: %v", err)
-		}
+
+	// 新用户购物车为空，应通过用户校验后因没有选中商品失败
+	if msg := status.Convert(err).Message(); msg == "用户不存在" || msg == "用户服务不可用，请稍后重试" {
+		t.Errorf("新用户被错误拒绝: %v", err)
 	} else {
-		t.Log("新用户订单创建成功")
+		t.Logf("新用户通过验证: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+// createTestUser 通过用户服务注册一个新用户，用户服务未连接时跳过测试
+func createTestUser(t *testing.T) int32 {
+	if util.ServiceConn(&global.UserClient, "user_srv") == nil {
+		t.Skip("用户服务未连接")
+	}
+	mobile := fmt.Sprintf("139%08d", time.Now().UnixNano()%100000000)
+	user, err := userpb.NewUserClient(global.UserClient).CreateUser(context.Background(), &userpb.CreateUserInfo{
+		Mobile:   mobile,
+		Password: "test123456",
+		Nickname: "订单测试用户",
+	})
+	if err != nil {
+		t.Skipf("注册测试用户失败: %v", err)
+	}
+	return user.Id
+}
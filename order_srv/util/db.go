@@ -22,7 +22,25 @@ func SetupTestTables() error {
 	return global.DB.AutoMigrate(
 		&model.OrderGoods{},
 		&model.ShoppingCart{},
+		&model.CartMergeRecord{},
 		&model.OrderInfo{},
+		&model.PaymentRecord{},
+		&model.RefundOrder{},
+		&model.RefundGoods{},
+		&model.OrderStatusLog{},
+		&model.OutboxMessage{},
+		&model.OutboxDeadLetter{},
+		&model.SagaInstance{},
+		&model.CouponTemplate{},
+		&model.UserCoupon{},
+		&model.Promotion{},
+		&model.OrderDiscount{},
+		&model.ParentOrder{},
+		&model.Shipment{},
+		&model.ShipmentGoods{},
+		&model.ShipmentTrack{},
+		&model.OrderDailyStat{},
+		&model.GoodsDailyStat{},
 	)
 }
 
@@ -32,7 +50,25 @@ func CleanTestTables() error {
 	tables := []interface{}{
 		&model.OrderGoods{},
 		&model.ShoppingCart{},
+		&model.CartMergeRecord{},
 		&model.OrderInfo{},
+		&model.PaymentRecord{},
+		&model.RefundOrder{},
+		&model.RefundGoods{},
+		&model.OrderStatusLog{},
+		&model.OutboxMessage{},
+		&model.OutboxDeadLetter{},
+		&model.SagaInstance{},
+		&model.CouponTemplate{},
+		&model.UserCoupon{},
+		&model.Promotion{},
+		&model.OrderDiscount{},
+		&model.ParentOrder{},
+		&model.Shipment{},
+		&model.ShipmentGoods{},
+		&model.ShipmentTrack{},
+		&model.OrderDailyStat{},
+		&model.GoodsDailyStat{},
 	}
 	for _, table := range tables {
 		if err := global.DB.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(table).Error; err != nil {
@@ -48,7 +84,25 @@ func CleanGoodsRelatedTables() error {
 	tables := []interface{}{
 		&model.OrderGoods{},
 		&model.ShoppingCart{},
+		&model.CartMergeRecord{},
 		&model.OrderInfo{},
+		&model.PaymentRecord{},
+		&model.RefundOrder{},
+		&model.RefundGoods{},
+		&model.OrderStatusLog{},
+		&model.OutboxMessage{},
+		&model.OutboxDeadLetter{},
+		&model.SagaInstance{},
+		&model.CouponTemplate{},
+		&model.UserCoupon{},
+		&model.Promotion{},
+		&model.OrderDiscount{},
+		&model.ParentOrder{},
+		&model.Shipment{},
+		&model.ShipmentGoods{},
+		&model.ShipmentTrack{},
+		&model.OrderDailyStat{},
+		&model.GoodsDailyStat{},
 	}
 	for _, table := range tables {
 		if err := global.DB.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(table).Error; err != nil {
@@ -64,6 +118,24 @@ func DropAllTables() error {
 	return global.DB.Migrator().DropTable(
 		&model.OrderGoods{},
 		&model.ShoppingCart{},
+		&model.CartMergeRecord{},
 		&model.OrderInfo{},
+		&model.PaymentRecord{},
+		&model.RefundOrder{},
+		&model.RefundGoods{},
+		&model.OrderStatusLog{},
+		&model.OutboxMessage{},
+		&model.OutboxDeadLetter{},
+		&model.SagaInstance{},
+		&model.CouponTemplate{},
+		&model.UserCoupon{},
+		&model.Promotion{},
+		&model.OrderDiscount{},
+		&model.ParentOrder{},
+		&model.Shipment{},
+		&model.ShipmentGoods{},
+		&model.ShipmentTrack{},
+		&model.OrderDailyStat{},
+		&model.GoodsDailyStat{},
 	)
 }
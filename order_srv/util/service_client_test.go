@@ -0,0 +1,75 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"order_srv/global"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// TestServiceConnRetry 测试启动时依赖的服务未注册，之后注册时可以重新连接，重试有最小间隔
+func TestServiceConnRetry(t *testing.T) {
+	var registered atomic.Bool
+	var lookups atomic.Int32
+	consul := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/v1/health/service/user_srv") {
+			http.NotFound(w, r)
+			return
+		}
+		lookups.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		if !registered.Load() {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"Service":{"Service":"user_srv","Address":"127.0.0.1","Port":50051}}]`))
+	}))
+	defer consul.Close()
+
+	client, err := api.NewClient(&api.Config{Address: strings.TrimPrefix(consul.URL, "http://")})
+	if err != nil {
+		t.Fatalf("创建Consul客户端失败: %v", err)
+	}
+	oldConsul := global.ConsulClient
+	global.ConsulClient = client
+	zap.ReplaceGlobals(zap.NewNop())
+	defer func() { global.ConsulClient = oldConsul }()
+
+	var conn *grpc.ClientConn
+	if ServiceConn(&conn, "user_srv") != nil {
+		t.Fatal("服务未注册时应返回nil")
+	}
+	// 重试间隔内不再查询Consul
+	if ServiceConn(&conn, "user_srv") != nil || lookups.Load() != 1 {
+		t.Fatalf("重试间隔内不应重新查询，查询次数: %d", lookups.Load())
+	}
+
+	registered.Store(true)
+	serviceConns.Lock()
+	serviceConns.lastTry["user_srv"] = time.Now().Add(-serviceConnRetryInterval)
+	serviceConns.Unlock()
+	got := ServiceConn(&conn, "user_srv")
+	if got == nil || conn != got {
+		t.Fatal("服务注册后应重新连接成功")
+	}
+	defer conn.Close()
+
+	// 已连接后直接返回，不再查询Consul
+	if ServiceConn(&conn, "user_srv") != got || lookups.Load() != 2 {
+		t.Errorf("已连接时不应重新查询，查询次数: %d", lookups.Load())
+	}
+
+	var noConsul *grpc.ClientConn
+	global.ConsulClient = nil
+	if ServiceConn(&noConsul, "goods_srv") != nil {
+		t.Error("未连接Consul时应返回nil")
+	}
+}
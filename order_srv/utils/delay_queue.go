@@ -0,0 +1,226 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"order_srv/global"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// claimScript 原子地取出到期任务并移入处理中集合，多个消费者并发领取时同一任务只会被一个消费者拿到
+// KEYS[1] 待处理集合 KEYS[2] 处理中集合 ARGV[1] 当前时间 ARGV[2] 领取数量 ARGV[3] 处理超时时间点
+var claimScript = redis.NewScript(`
+local items = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "LIMIT", 0, ARGV[2])
+for _, item in ipairs(items) do
+	redis.call("ZREM", KEYS[1], item)
+	redis.call("ZADD", KEYS[2], ARGV[3], item)
+end
+return items
+`)
+
+// reclaimScript 将处理超时（消费者崩溃或卡住）的任务放回待处理集合
+// KEYS[1] 待处理集合 KEYS[2] 处理中集合 ARGV[1] 当前时间 ARGV[2] 单次最多处理数量
+var reclaimScript = redis.NewScript(`
+local items = redis.call("ZRANGEBYSCORE", KEYS[2], "-inf", ARGV[1], "LIMIT", 0, ARGV[2])
+for _, item in ipairs(items) do
+	redis.call("ZREM", KEYS[2], item)
+	redis.call("ZADD", KEYS[1], "NX", ARGV[1], item)
+end
+return #items
+`)
+
+// DelayHandler 延时任务处理函数，返回错误时任务按退避时间重试
+type DelayHandler func(ctx context.Context, member string) error
+
+// DelayQueue 基于Redis有序集合的延时队列，分数为任务到期时间（毫秒）
+// 任务领取后移入处理中集合，处理成功后确认删除；超过处理超时未确认的任务会被放回重新领取
+// 同一任务重复添加只保留一份，处理函数需要保证幂等
+type DelayQueue struct {
+	key          string // 待处理集合
+	processing   string // 处理中集合，分数为处理超时时间点
+	attempts     string // 任务失败次数
+	visibility   time.Duration
+	maxRetries   int
+	retryBackoff time.Duration
+	batchSize    int
+	pollInterval time.Duration
+}
+
+// NewDelayQueue 创建延时队列，visibility为单个任务的处理超时时间，超过maxRetries次失败的任务会被丢弃
+func NewDelayQueue(name string, visibility time.Duration, maxRetries int, retryBackoff time.Duration) *DelayQueue {
+	key := "delay_queue:" + name
+	return &DelayQueue{
+		key:          key,
+		processing:   key + ":processing",
+		attempts:     key + ":attempts",
+		visibility:   visibility,
+		maxRetries:   maxRetries,
+		retryBackoff: retryBackoff,
+		batchSize:    20,
+		pollInterval: time.Second,
+	}
+}
+
+// Add 添加任务，任务已存在时更新到期时间
+func (q *DelayQueue) Add(ctx context.Context, member string, at time.Time) error {
+	if err := global.RedisClient.ZAdd(ctx, q.key, redis.Z{Score: float64(at.UnixMilli()), Member: member}).Err(); err != nil {
+		return fmt.Errorf("添加延时任务失败: %w", err)
+	}
+	return nil
+}
+
+// Remove 删除任务，任务已不需要执行时调用
+func (q *DelayQueue) Remove(ctx context.Context, member string) error {
+	_, err := global.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, q.key, member)
+		pipe.ZRem(ctx, q.processing, member)
+		pipe.HDel(ctx, q.attempts, member)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("删除延时任务失败: %w", err)
+	}
+	return nil
+}
+
+// Claim 领取最多n个已到期的任务
+func (q *DelayQueue) Claim(ctx context.Context, n int) ([]string, error) {
+	now := time.Now()
+	deadline := now.Add(q.visibility).UnixMilli()
+	items, err := claimScript.Run(ctx, global.RedisClient, []string{q.key, q.processing}, now.UnixMilli(), n, deadline).StringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("领取延时任务失败: %w", err)
+	}
+	return items, nil
+}
+
+// Ack 确认任务处理成功
+func (q *DelayQueue) Ack(ctx context.Context, member string) error {
+	_, err := global.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, q.processing, member)
+		pipe.HDel(ctx, q.attempts, member)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("确认延时任务失败: %w", err)
+	}
+	return nil
+}
+
+// Retry 任务处理失败，按失败次数线性退避后重新放回队列，返回false表示超过重试次数已丢弃
+func (q *DelayQueue) Retry(ctx context.Context, member string) (bool, error) {
+	attempts, err := global.RedisClient.HIncrBy(ctx, q.attempts, member, 1).Result()
+	if err != nil {
+		return false, fmt.Errorf("记录延时任务失败次数失败: %w", err)
+	}
+	if int(attempts) > q.maxRetries {
+		return false, q.Ack(ctx, member)
+	}
+	at := time.Now().Add(time.Duration(attempts) * q.retryBackoff)
+	_, err = global.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRem(ctx, q.processing, member)
+		pipe.ZAddNX(ctx, q.key, redis.Z{Score: float64(at.UnixMilli()), Member: member})
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("重新放回延时任务失败: %w", err)
+	}
+	return true, nil
+}
+
+// Reclaim 将处理超时的任务放回待处理集合，返回放回的数量
+func (q *DelayQueue) Reclaim(ctx context.Context) (int, error) {
+	n, err := reclaimScript.Run(ctx, global.RedisClient, []string{q.key, q.processing}, time.Now().UnixMilli(), 100).Int()
+	if err != nil {
+		return 0, fmt.Errorf("回收超时任务失败: %w", err)
+	}
+	return n, nil
+}
+
+// Size 返回待处理和处理中的任务数量
+func (q *DelayQueue) Size(ctx context.Context) (pending int64, processing int64, err error) {
+	if pending, err = global.RedisClient.ZCard(ctx, q.key).Result(); err != nil {
+		return 0, 0, err
+	}
+	if processing, err = global.RedisClient.ZCard(ctx, q.processing).Result(); err != nil {
+		return 0, 0, err
+	}
+	return pending, processing, nil
+}
+
+// Consume 启动consumers个消费者和一个超时回收协程，阻塞直到ctx取消且所有消费者退出
+func (q *DelayQueue) Consume(ctx context.Context, consumers int, handler DelayHandler) {
+	var wg sync.WaitGroup
+	for i := 0; i < consumers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.consumeLoop(ctx, handler)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(q.visibility)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if n, err := q.Reclaim(ctx); err != nil {
+					global.Logger.Errorf("%v，队列: %s", err, q.key)
+				} else if n > 0 {
+					global.Logger.Warnf("回收处理超时的延时任务 %d 个，队列: %s", n, q.key)
+				}
+			}
+		}
+	}()
+	wg.Wait()
+}
+
+func (q *DelayQueue) consumeLoop(ctx context.Context, handler DelayHandler) {
+	for {
+		items, err := q.Claim(ctx, q.batchSize)
+		if err != nil {
+			global.Logger.Errorf("%v，队列: %s", err, q.key)
+		}
+		for _, member := range items {
+			q.handle(ctx, member, handler)
+		}
+		// 本次领满时说明还有积压，不等待直接继续领取
+		if err == nil && len(items) == q.batchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(q.pollInterval):
+		}
+	}
+}
+
+func (q *DelayQueue) handle(ctx context.Context, member string, handler DelayHandler) {
+	handleCtx, cancel := context.WithTimeout(ctx, q.visibility)
+	defer cancel()
+	if err := handler(handleCtx, member); err != nil {
+		retried, retryErr := q.Retry(ctx, member)
+		switch {
+		case retryErr != nil:
+			// 任务仍在处理中集合，超时后会被回收重试
+			global.Logger.Errorf("%v，队列: %s，任务: %s", retryErr, q.key, member)
+		case retried:
+			global.Logger.Warnf("延时任务处理失败，稍后重试，队列: %s，任务: %s，错误: %v", q.key, member, err)
+		default:
+			global.Logger.Errorf("延时任务超过最大重试次数已丢弃，队列: %s，任务: %s，错误: %v", q.key, member, err)
+		}
+		return
+	}
+	if err := q.Ack(ctx, member); err != nil {
+		global.Logger.Errorf("%v，队列: %s，任务: %s", err, q.key, member)
+	}
+}
@@ -0,0 +1,303 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"order_srv/config"
+	"order_srv/global"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// setupTestRedis 连接开发环境Redis，替换全局客户端和日志，测试结束后恢复
+func setupTestRedis(t *testing.T) {
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("获取工作目录失败: %v", err)
+	}
+	configFile := filepath.Join(dir, "..", "config", "config-develop.yaml")
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("读取配置文件失败: %v", err)
+	}
+	var cfg config.ServerConfig
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("解析配置文件失败: %v", err)
+	}
+
+	rdb := redis.NewClient(&redis.Options{Addr: fmt.Sprintf("%s:%d", cfg.Redis.Host, cfg.Redis.Port)})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		t.Fatalf("连接Redis失败: %v", err)
+	}
+
+	oldRedis, oldLogger := global.RedisClient, global.Logger
+	global.RedisClient, global.Logger = rdb, zap.NewNop().Sugar()
+	t.Cleanup(func() {
+		global.RedisClient, global.Logger = oldRedis, oldLogger
+		rdb.Close()
+	})
+}
+
+// newTestQueue 每个测试使用独立的队列，测试结束后删除队列的全部键
+func newTestQueue(t *testing.T, visibility time.Duration, maxRetries int, retryBackoff time.Duration) *DelayQueue {
+	q := NewDelayQueue(fmt.Sprintf("test:%s:%d", t.Name(), time.Now().UnixNano()), visibility, maxRetries, retryBackoff)
+	t.Cleanup(func() {
+		global.RedisClient.Del(context.Background(), q.key, q.processing, q.attempts)
+	})
+	return q
+}
+
+func assertQueueSize(t *testing.T, q *DelayQueue, wantPending, wantProcessing int64) {
+	t.Helper()
+	pending, processing, err := q.Size(context.Background())
+	if err != nil {
+		t.Fatalf("查询队列长度失败: %v", err)
+	}
+	if pending != wantPending || processing != wantProcessing {
+		t.Errorf("队列长度错误，待处理期望 %d 实际 %d，处理中期望 %d 实际 %d", wantPending, pending, wantProcessing, processing)
+	}
+}
+
+// TestDelayQueueClaim 测试只领取已到期的任务，领取后移入处理中集合，确认后删除
+func TestDelayQueueClaim(t *testing.T) {
+	setupTestRedis(t)
+	ctx := context.Background()
+	q := newTestQueue(t, time.Minute, 3, time.Second)
+
+	now := time.Now()
+	q.Add(ctx, "due1", now.Add(-time.Second))
+	q.Add(ctx, "due2", now)
+	q.Add(ctx, "future", now.Add(time.Hour))
+	// 重复添加只保留一份
+	q.Add(ctx, "due1", now.Add(-time.Second))
+
+	items, err := q.Claim(ctx, 10)
+	if err != nil {
+		t.Fatalf("领取任务失败: %v", err)
+	}
+	sort.Strings(items)
+	if len(items) != 2 || items[0] != "due1" || items[1] != "due2" {
+		t.Fatalf("应领取到期的2个任务，实际 %v", items)
+	}
+	assertQueueSize(t, q, 1, 2)
+
+	// 已领取的任务不会被再次领取
+	if items, _ := q.Claim(ctx, 10); len(items) != 0 {
+		t.Errorf("已领取的任务不应被再次领取，实际 %v", items)
+	}
+
+	if err := q.Ack(ctx, "due1"); err != nil {
+		t.Fatalf("确认任务失败: %v", err)
+	}
+	assertQueueSize(t, q, 1, 1)
+
+	if err := q.Remove(ctx, "due2"); err != nil {
+		t.Fatalf("删除任务失败: %v", err)
+	}
+	if err := q.Remove(ctx, "future"); err != nil {
+		t.Fatalf("删除任务失败: %v", err)
+	}
+	assertQueueSize(t, q, 0, 0)
+}
+
+// TestDelayQueueConcurrentClaim 测试多个消费者并发领取时每个任务只被领取一次
+func TestDelayQueueConcurrentClaim(t *testing.T) {
+	setupTestRedis(t)
+	ctx := context.Background()
+	q := newTestQueue(t, time.Minute, 3, time.Second)
+
+	const total = 100
+	for i := 0; i < total; i++ {
+		q.Add(ctx, fmt.Sprintf("task%d", i), time.Now().Add(-time.Second))
+	}
+
+	var mu sync.Mutex
+	claimed := map[string]int{}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				items, err := q.Claim(ctx, 3)
+				if err != nil {
+					t.Errorf("领取任务失败: %v", err)
+					return
+				}
+				if len(items) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, item := range items {
+					claimed[item]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(claimed) != total {
+		t.Errorf("应领取 %d 个任务，实际 %d 个", total, len(claimed))
+	}
+	for item, n := range claimed {
+		if n != 1 {
+			t.Errorf("任务 %s 被领取 %d 次", item, n)
+		}
+	}
+	assertQueueSize(t, q, 0, total)
+}
+
+// TestDelayQueueRetry 测试失败任务按失败次数退避后重新领取，超过最大重试次数后丢弃
+func TestDelayQueueRetry(t *testing.T) {
+	setupTestRedis(t)
+	ctx := context.Background()
+	backoff := 200 * time.Millisecond
+	q := newTestQueue(t, time.Minute, 2, backoff)
+
+	q.Add(ctx, "task", time.Now())
+	for attempt := 1; attempt <= 2; attempt++ {
+		if items, _ := q.Claim(ctx, 1); len(items) != 1 {
+			t.Fatalf("第%d次领取任务失败，实际 %v", attempt, items)
+		}
+		retried, err := q.Retry(ctx, "task")
+		if err != nil || !retried {
+			t.Fatalf("第%d次失败应重新放回队列，实际 %v，错误: %v", attempt, retried, err)
+		}
+		assertQueueSize(t, q, 1, 0)
+
+		// 退避时间未到时不能领取
+		if items, _ := q.Claim(ctx, 1); len(items) != 0 {
+			t.Fatalf("第%d次失败后退避时间未到不应被领取", attempt)
+		}
+		score, err := global.RedisClient.ZScore(ctx, q.key, "task").Result()
+		if err != nil {
+			t.Fatalf("查询任务到期时间失败: %v", err)
+		}
+		delay := time.Until(time.UnixMilli(int64(score)))
+		if want := time.Duration(attempt) * backoff; delay > want || delay < want-100*time.Millisecond {
+			t.Errorf("第%d次失败的退避时间应约为 %v，实际 %v", attempt, want, delay)
+		}
+		time.Sleep(delay + 10*time.Millisecond)
+	}
+
+	if items, _ := q.Claim(ctx, 1); len(items) != 1 {
+		t.Fatalf("退避时间到后应能领取任务，实际 %v", items)
+	}
+	retried, err := q.Retry(ctx, "task")
+	if err != nil || retried {
+		t.Fatalf("超过最大重试次数应丢弃任务，实际 %v，错误: %v", retried, err)
+	}
+	assertQueueSize(t, q, 0, 0)
+	if exists, _ := global.RedisClient.HExists(ctx, q.attempts, "task").Result(); exists {
+		t.Error("丢弃的任务应清除失败次数")
+	}
+}
+
+// TestDelayQueueReclaim 测试处理超时的任务被放回队列并由其他消费者领取，未超时的任务不受影响
+func TestDelayQueueReclaim(t *testing.T) {
+	setupTestRedis(t)
+	ctx := context.Background()
+	visibility := 200 * time.Millisecond
+	q := newTestQueue(t, visibility, 3, time.Second)
+
+	q.Add(ctx, "stuck", time.Now())
+	if items, _ := q.Claim(ctx, 1); len(items) != 1 {
+		t.Fatalf("领取任务失败，实际 %v", items)
+	}
+	if n, err := q.Reclaim(ctx); err != nil || n != 0 {
+		t.Fatalf("未超时的任务不应被回收，实际 %d，错误: %v", n, err)
+	}
+
+	time.Sleep(visibility + 50*time.Millisecond)
+	q.Add(ctx, "fresh", time.Now())
+	if items, _ := q.Claim(ctx, 1); len(items) != 1 || items[0] != "fresh" {
+		t.Fatalf("应领取新任务，实际 %v", items)
+	}
+	n, err := q.Reclaim(ctx)
+	if err != nil || n != 1 {
+		t.Fatalf("应回收1个超时任务，实际 %d，错误: %v", n, err)
+	}
+	assertQueueSize(t, q, 1, 1)
+
+	// 模拟另一个消费者领取回收的任务
+	items, err := q.Claim(ctx, 10)
+	if err != nil || len(items) != 1 || items[0] != "stuck" {
+		t.Fatalf("其他消费者应领取到回收的任务，实际 %v，错误: %v", items, err)
+	}
+	assertQueueSize(t, q, 0, 2)
+}
+
+// TestDelayQueueConsume 测试多个消费者处理任务，失败的任务重试后成功，所有任务最终确认
+func TestDelayQueueConsume(t *testing.T) {
+	setupTestRedis(t)
+	q := newTestQueue(t, time.Second, 3, 50*time.Millisecond)
+	q.pollInterval = 20 * time.Millisecond
+	q.batchSize = 2
+
+	const total = 20
+	for i := 0; i < total; i++ {
+		q.Add(context.Background(), fmt.Sprintf("task%d", i), time.Now())
+	}
+
+	var mu sync.Mutex
+	handled := map[string]int{}
+	handler := func(ctx context.Context, member string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		handled[member]++
+		// task0 第一次处理失败
+		if member == "task0" && handled[member] == 1 {
+			return fmt.Errorf("模拟处理失败")
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		q.Consume(ctx, 3, handler)
+		close(stopped)
+	}()
+	// 等待所有任务确认，失败的任务退避后重新处理
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		pending, processing, err := q.Size(context.Background())
+		if err == nil && pending == 0 && processing == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Errorf("等待任务处理完成超时，待处理 %d，处理中 %d", pending, processing)
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	cancel()
+	<-stopped
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i := 0; i < total; i++ {
+		member := fmt.Sprintf("task%d", i)
+		want := 1
+		if member == "task0" {
+			want = 2
+		}
+		if handled[member] != want {
+			t.Errorf("任务 %s 应处理 %d 次，实际 %d 次", member, want, handled[member])
+		}
+	}
+}
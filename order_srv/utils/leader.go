@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"order_srv/global"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// renewScript 只有租约持有者才能续期
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+else
+	return 0
+end
+`)
+
+// resignScript 只有租约持有者才能释放
+var resignScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+else
+	return 0
+end
+`)
+
+// LeaderJob 只在主实例上运行的后台任务，Run应阻塞直到ctx取消
+type LeaderJob struct {
+	Name string
+	Run  func(ctx context.Context)
+}
+
+// LeaderElector 基于Redis租约的选主，多副本部署时只有持有租约的实例运行注册的后台任务
+// 主实例定期续期，续期失败或进程退出后租约过期，其他实例在下一次竞选时接管
+type LeaderElector struct {
+	key      string
+	id       string
+	ttl      time.Duration
+	interval time.Duration
+
+	mu        sync.Mutex
+	jobs      []LeaderJob
+	leading   bool
+	renewedAt time.Time
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewLeaderElector 创建选主器，id为本实例标识，ttl为租约时长，续期和竞选间隔为ttl的三分之一
+func NewLeaderElector(name, id string, ttl time.Duration) *LeaderElector {
+	return &LeaderElector{
+		key:      "leader:" + name,
+		id:       id,
+		ttl:      ttl,
+		interval: ttl / 3,
+	}
+}
+
+// Register 注册后台任务，需在Start之前调用
+func (e *LeaderElector) Register(name string, run func(ctx context.Context)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.jobs = append(e.jobs, LeaderJob{Name: name, Run: run})
+}
+
+// Jobs 返回已注册的任务名称
+func (e *LeaderElector) Jobs() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	names := make([]string, 0, len(e.jobs))
+	for _, job := range e.jobs {
+		names = append(names, job.Name)
+	}
+	return names
+}
+
+// ID 返回本实例标识
+func (e *LeaderElector) ID() string {
+	return e.id
+}
+
+// IsLeader 本实例当前是否为主实例
+func (e *LeaderElector) IsLeader() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.leading
+}
+
+// Leader 查询当前租约持有者和剩余时长，没有持有者时返回空字符串
+func (e *LeaderElector) Leader(ctx context.Context) (string, time.Duration, error) {
+	holder, err := global.RedisClient.Get(ctx, e.key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("查询租约持有者失败: %w", err)
+	}
+	ttl, err := global.RedisClient.PTTL(ctx, e.key).Result()
+	if err != nil {
+		return "", 0, fmt.Errorf("查询租约剩余时长失败: %w", err)
+	}
+	return holder, ttl, nil
+}
+
+// Start 启动竞选循环，阻塞直到ctx取消，退出前停止任务并主动释放租约以便其他实例尽快接管
+func (e *LeaderElector) Start(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		e.tick(ctx)
+		select {
+		case <-ctx.Done():
+			e.resign()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *LeaderElector) tick(ctx context.Context) {
+	if e.IsLeader() {
+		held, err := e.renew(ctx)
+		switch {
+		case err != nil:
+			// 续期出错时在租约到期前主动下线，避免与新的主实例同时运行任务
+			e.mu.Lock()
+			expired := time.Since(e.renewedAt) >= e.ttl-e.interval
+			e.mu.Unlock()
+			global.Logger.Errorf("%v，实例: %s", err, e.id)
+			if expired {
+				global.Logger.Warnf("租约续期持续失败，停止后台任务，租约: %s，实例: %s", e.key, e.id)
+				e.stepDown()
+			}
+		case !held:
+			global.Logger.Warnf("租约已被其他实例持有，停止后台任务，租约: %s，实例: %s", e.key, e.id)
+			e.stepDown()
+		}
+		return
+	}
+
+	acquired, err := global.RedisClient.SetNX(ctx, e.key, e.id, e.ttl).Result()
+	if err != nil {
+		global.Logger.Errorf("竞选租约失败: %v，租约: %s，实例: %s", err, e.key, e.id)
+		return
+	}
+	if acquired {
+		global.Logger.Infof("成为主实例，开始运行后台任务，租约: %s，实例: %s", e.key, e.id)
+		e.stepUp(ctx)
+	}
+}
+
+func (e *LeaderElector) renew(ctx context.Context) (bool, error) {
+	n, err := renewScript.Run(ctx, global.RedisClient, []string{e.key}, e.id, e.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("租约续期失败: %w", err)
+	}
+	if n == 1 {
+		e.mu.Lock()
+		e.renewedAt = time.Now()
+		e.mu.Unlock()
+	}
+	return n == 1, nil
+}
+
+func (e *LeaderElector) stepUp(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	jobCtx, cancel := context.WithCancel(ctx)
+	e.leading = true
+	e.renewedAt = time.Now()
+	e.cancel = cancel
+	for _, job := range e.jobs {
+		job := job
+		e.wg.Add(1)
+		go func() {
+			defer e.wg.Done()
+			job.Run(jobCtx)
+		}()
+	}
+}
+
+// stepDown 停止所有任务并等待退出
+func (e *LeaderElector) stepDown() {
+	e.mu.Lock()
+	if !e.leading {
+		e.mu.Unlock()
+		return
+	}
+	e.leading = false
+	e.cancel()
+	e.mu.Unlock()
+	e.wg.Wait()
+}
+
+func (e *LeaderElector) resign() {
+	if !e.IsLeader() {
+		return
+	}
+	e.stepDown()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := resignScript.Run(ctx, global.RedisClient, []string{e.key}, e.id).Err(); err != nil {
+		global.Logger.Errorf("释放租约失败: %v，租约: %s，实例: %s", err, e.key, e.id)
+		return
+	}
+	global.Logger.Infof("已释放租约，租约: %s，实例: %s", e.key, e.id)
+}
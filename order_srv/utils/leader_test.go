@@ -0,0 +1,251 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"order_srv/global"
+)
+
+// jobProbe 记录后台任务的启动和停止次数
+type jobProbe struct {
+	mu      sync.Mutex
+	started int
+	stopped int
+}
+
+func (p *jobProbe) run(ctx context.Context) {
+	p.mu.Lock()
+	p.started++
+	p.mu.Unlock()
+	<-ctx.Done()
+	p.mu.Lock()
+	p.stopped++
+	p.mu.Unlock()
+}
+
+func (p *jobProbe) counts() (int, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.started, p.stopped
+}
+
+// waitFor 轮询等待条件成立，超时返回false
+func waitFor(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return true
+}
+
+// newTestElectors 创建竞选同一租约的多个实例，每个实例注册一个后台任务，测试结束后删除租约
+func newTestElectors(t *testing.T, n int, ttl time.Duration) ([]*LeaderElector, []*jobProbe) {
+	name := fmt.Sprintf("test:%s:%d", t.Name(), time.Now().UnixNano())
+	electors := make([]*LeaderElector, n)
+	probes := make([]*jobProbe, n)
+	for i := range electors {
+		electors[i] = NewLeaderElector(name, fmt.Sprintf("instance-%d", i), ttl)
+		probes[i] = &jobProbe{}
+		electors[i].Register("probe", probes[i].run)
+	}
+	t.Cleanup(func() {
+		for _, e := range electors {
+			e.stepDown()
+		}
+		global.RedisClient.Del(context.Background(), electors[0].key)
+	})
+	return electors, probes
+}
+
+func assertLeader(t *testing.T, e *LeaderElector, want string) {
+	t.Helper()
+	holder, _, err := e.Leader(context.Background())
+	if err != nil {
+		t.Fatalf("查询租约持有者失败: %v", err)
+	}
+	if holder != want {
+		t.Errorf("租约持有者期望 %q，实际 %q", want, holder)
+	}
+}
+
+// TestLeaderAcquireAndRenew 测试只有一个实例获得租约并运行任务，续期后租约时长刷新
+func TestLeaderAcquireAndRenew(t *testing.T) {
+	setupTestRedis(t)
+	ctx := context.Background()
+	ttl := 600 * time.Millisecond
+	electors, probes := newTestElectors(t, 2, ttl)
+	leader, follower := electors[0], electors[1]
+
+	leader.tick(ctx)
+	follower.tick(ctx)
+	if !leader.IsLeader() || follower.IsLeader() {
+		t.Fatalf("应只有先竞选的实例成为主实例，实例0: %v，实例1: %v", leader.IsLeader(), follower.IsLeader())
+	}
+	assertLeader(t, follower, leader.ID())
+	if !waitFor(time.Second, func() bool { started, _ := probes[0].counts(); return started == 1 }) {
+		t.Error("主实例应启动后台任务")
+	}
+	if started, _ := probes[1].counts(); started != 0 {
+		t.Error("从实例不应启动后台任务")
+	}
+
+	time.Sleep(ttl / 2)
+	leader.tick(ctx)
+	_, remaining, err := leader.Leader(ctx)
+	if err != nil {
+		t.Fatalf("查询租约失败: %v", err)
+	}
+	if remaining <= ttl/2 {
+		t.Errorf("续期后租约剩余时长应刷新，实际 %v", remaining)
+	}
+
+	// 超过原租约时长后仍由主实例持有，从实例竞选失败
+	time.Sleep(ttl / 2)
+	leader.tick(ctx)
+	follower.tick(ctx)
+	if !leader.IsLeader() || follower.IsLeader() {
+		t.Error("持续续期的主实例不应失去租约")
+	}
+	if started, stopped := probes[0].counts(); started != 1 || stopped != 0 {
+		t.Errorf("续期不应重启后台任务，启动 %d 次，停止 %d 次", started, stopped)
+	}
+}
+
+// TestLeaderResign 测试主实例主动释放租约后停止任务，其他实例立即接管
+func TestLeaderResign(t *testing.T) {
+	setupTestRedis(t)
+	ctx := context.Background()
+	electors, probes := newTestElectors(t, 2, 10*time.Second)
+	leader, follower := electors[0], electors[1]
+
+	leader.tick(ctx)
+	if !leader.IsLeader() {
+		t.Fatal("实例0应成为主实例")
+	}
+	leader.resign()
+	if leader.IsLeader() {
+		t.Error("释放租约后不应再是主实例")
+	}
+	if started, stopped := probes[0].counts(); started != 1 || stopped != 1 {
+		t.Errorf("释放租约时应停止后台任务，启动 %d 次，停止 %d 次", started, stopped)
+	}
+	assertLeader(t, leader, "")
+
+	follower.tick(ctx)
+	if !follower.IsLeader() {
+		t.Fatal("租约释放后其他实例应立即接管")
+	}
+
+	// 非持有者不能释放他人的租约
+	if err := resignScript.Run(ctx, global.RedisClient, []string{leader.key}, leader.ID()).Err(); err != nil {
+		t.Fatalf("执行释放脚本失败: %v", err)
+	}
+	assertLeader(t, leader, follower.ID())
+}
+
+// TestLeaderFailover 测试主实例停止续期后租约过期由其他实例接管，原主实例发现租约被占后停止任务
+func TestLeaderFailover(t *testing.T) {
+	setupTestRedis(t)
+	ctx := context.Background()
+	ttl := 300 * time.Millisecond
+	electors, probes := newTestElectors(t, 2, ttl)
+	stale, next := electors[0], electors[1]
+
+	stale.tick(ctx)
+	if !stale.IsLeader() {
+		t.Fatal("实例0应成为主实例")
+	}
+
+	// 模拟主实例卡住未续期，租约过期前其他实例无法接管
+	next.tick(ctx)
+	if next.IsLeader() {
+		t.Fatal("租约未过期时其他实例不应接管")
+	}
+	time.Sleep(ttl + 100*time.Millisecond)
+	next.tick(ctx)
+	if !next.IsLeader() {
+		t.Fatal("租约过期后其他实例应接管")
+	}
+	assertLeader(t, next, next.ID())
+
+	// 原主实例恢复后续期失败，停止任务，不能抢回租约
+	stale.tick(ctx)
+	if stale.IsLeader() {
+		t.Error("租约被占后原主实例应下线")
+	}
+	if started, stopped := probes[0].counts(); started != 1 || stopped != 1 {
+		t.Errorf("原主实例应停止后台任务，启动 %d 次，停止 %d 次", started, stopped)
+	}
+	stale.tick(ctx)
+	if stale.IsLeader() {
+		t.Error("原主实例不应抢回他人持有的租约")
+	}
+	assertLeader(t, stale, next.ID())
+	if started, stopped := probes[1].counts(); started != 1 || stopped != 0 {
+		t.Errorf("新主实例应运行后台任务，启动 %d 次，停止 %d 次", started, stopped)
+	}
+}
+
+// TestLeaderStartFailover 测试多个实例同时运行竞选循环时只有一个主实例，主实例退出后其他实例接管
+func TestLeaderStartFailover(t *testing.T) {
+	setupTestRedis(t)
+	electors, _ := newTestElectors(t, 3, 300*time.Millisecond)
+
+	cancels := make([]context.CancelFunc, len(electors))
+	stopped := make([]chan struct{}, len(electors))
+	for i, e := range electors {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancels[i], stopped[i] = cancel, make(chan struct{})
+		go func(e *LeaderElector, done chan struct{}) {
+			e.Start(ctx)
+			close(done)
+		}(e, stopped[i])
+	}
+	defer func() {
+		for i := range electors {
+			cancels[i]()
+			<-stopped[i]
+		}
+	}()
+
+	leaderIndex := func() (int, int) {
+		index, count := -1, 0
+		for i, e := range electors {
+			if e.IsLeader() {
+				index, count = i, count+1
+			}
+		}
+		return index, count
+	}
+
+	var first int
+	if !waitFor(time.Second, func() bool { i, n := leaderIndex(); first = i; return n == 1 }) {
+		t.Fatal("应选出一个主实例")
+	}
+	// 持续运行多个续期周期，主实例保持不变
+	time.Sleep(time.Second)
+	if i, n := leaderIndex(); n != 1 || i != first {
+		t.Fatalf("主实例不应变化，期望实例%d，实际实例%d，主实例数 %d", first, i, n)
+	}
+
+	cancels[first]()
+	<-stopped[first]
+	if electors[first].IsLeader() {
+		t.Error("退出的实例不应再是主实例")
+	}
+	var second int
+	if !waitFor(time.Second, func() bool { i, n := leaderIndex(); second = i; return n == 1 }) {
+		t.Fatal("主实例退出后其他实例应接管")
+	}
+	if second == first {
+		t.Error("接管的应是其他实例")
+	}
+	assertLeader(t, electors[second], electors[second].ID())
+}
@@ -1,40 +1,41 @@
 package utils
 
 import (
-	"fmt"
-	"math/rand"
-	"time"
+	"order_srv/idgen"
 )
 
 // GenerateOrderSn 生成唯一订单号
-// 
-// 订单号生成规则：
-// - 总长度：26位
-// - 组成部分：时间戳(14位) + 用户ID(8位) + 随机数(4位)
-// - 示例：20240101123045000001230456
-//   - 20240101123045: 2024年01月01日12时30分45秒
-//   - 00000123: 用户ID 123，补零到8位
-//   - 0456: 随机数 456，补零到4位
 //
-// 参数：
-//   userID: 用户ID，用于标识订单所属用户
+// 订单号由idgen默认生成器生成，默认使用Snowflake算法：
+// - 总长度：20位
+// - 组成部分：ID(19位，补零) + 校验码(1位)
+// - ID：41位毫秒时间戳 + 10位机器号 + 12位序列号，机器号由Redis或Consul分配，保证多副本间不重复
+// - 校验码：Luhn算法，可用idgen.Valid校验
 //
-// 返回值：
-//   string: 26位唯一订单号
-func GenerateOrderSn(userID int32) string {
-	// 获取当前时间戳，格式：YYYYMMDDHHMMSS (14位)
-	// 使用Go标准时间格式：2006-01-02 15:04:05
-	timestamp := time.Now().Format("20060102150405")
-	
-	// 将用户ID格式化为8位字符串，不足8位前补零
-	// 例如：用户ID 123 -> "00000123"
-	userIDStr := fmt.Sprintf("%08d", userID)
-	
-	// 生成0-9999的随机数，确保4位长度
-	randomNum := rand.Intn(10000)
-	randomStr := fmt.Sprintf("%04d", randomNum)
-	
-	// 拼接生成最终的26位订单号
-	// 格式：时间戳(14) + 用户ID(8) + 随机数(4) = 26位
-	return fmt.Sprintf("%s%s%s", timestamp, userIDStr, randomStr)
-}
\ No newline at end of file
+// 机器号丢失（租约过期、被其他实例占用）时返回错误，调用方应拒绝下单而不是生成可能重复的订单号
+func GenerateOrderSn() (string, error) {
+	return idgen.Next()
+}
+
+// ValidOrderSn 校验请求中的订单号，用于在查询数据库前拦截输错或伪造的订单号
+// 除带校验码的20位订单号外，兼容改用Snowflake之前的26位订单号，以及拆单子订单单独生成订单号之前的父订单号加两位序号
+func ValidOrderSn(sn string) bool {
+	switch len(sn) {
+	case 20:
+		return idgen.Valid(sn)
+	case 26:
+		return isDigits(sn)
+	case 22, 28:
+		return isDigits(sn[len(sn)-2:]) && ValidOrderSn(sn[:len(sn)-2])
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+
+	"order_srv/idgen"
+)
+
+// TestValidOrderSn 测试订单号校验，兼容旧格式的订单号
+func TestValidOrderSn(t *testing.T) {
+	g, err := idgen.NewSnowflake(1)
+	if err != nil {
+		t.Fatalf("创建订单号生成器失败: %v", err)
+	}
+	sn, err := g.Next()
+	if err != nil {
+		t.Fatalf("生成订单号失败: %v", err)
+	}
+	// 改动最后一位校验码
+	bad := sn[:19] + string('0'+(sn[19]-'0'+1)%10)
+	legacy := "20240101123045000001230456"
+
+	cases := []struct {
+		sn   string
+		want bool
+	}{
+		{sn, true},
+		{bad, false},
+		{legacy, true},
+		{sn + "01", true},
+		{legacy + "02", true},
+		{bad + "01", false},
+		{sn + "0a", false},
+		{"2024010112304500000123045a", false},
+		{"", false},
+		{"123", false},
+		{sn + "1", false},
+	}
+	for _, c := range cases {
+		if got := ValidOrderSn(c.sn); got != c.want {
+			t.Errorf("ValidOrderSn(%q) = %v，期望 %v", c.sn, got, c.want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"order_srv/global"
 	goodspb "order_srv/proto/goods"
 	inventorypb "order_srv/proto/inventory"
+	"order_srv/util"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,11 +14,12 @@ import (
 
 // GetGoodsByIds 批量获取商品信息
 func GetGoodsByIds(ctx context.Context, goodsIds []int32) (map[int32]*goodspb.GoodsInfoResponse, error) {
-	if global.GoodsClient == nil {
+	conn := util.ServiceConn(&global.GoodsClient, "goods_srv")
+	if conn == nil {
 		return nil, fmt.Errorf("商品服务未连接")
 	}
 
-	goodsClient := goodspb.NewGoodsClient(global.GoodsClient)
+	goodsClient := goodspb.NewGoodsClient(conn)
 	
 	// 调用商品服务批量获取商品信息
 	resp, err := goodsClient.BatchGetGoods(ctx, &goodspb.BatchGoodsIdInfo{
@@ -40,11 +42,12 @@ func GetGoodsByIds(ctx context.Context, goodsIds []int32) (map[int32]*goodspb.Go
 
 // SellInventory 扣减库存
 func SellInventory(ctx context.Context, sellItems []*inventorypb.GoodsInvInfo) error {
-	if global.InventoryClient == nil {
+	conn := util.ServiceConn(&global.InventoryClient, "inventory_srv")
+	if conn == nil {
 		return fmt.Errorf("库存服务未连接")
 	}
 
-	inventoryClient := inventorypb.NewInventoryServiceClient(global.InventoryClient)
+	inventoryClient := inventorypb.NewInventoryServiceClient(conn)
 	
 	// 调用库存服务扣减库存
 	_, err := inventoryClient.Sell(ctx, &inventorypb.SellInfo{
@@ -66,17 +69,19 @@ func SellInventory(ctx context.Context, sellItems []*inventorypb.GoodsInvInfo) e
 	return nil
 }
 
-// RebackInventory 归还库存（用于订单取消等场景）
-func RebackInventory(ctx context.Context, rebackItems []*inventorypb.GoodsInvInfo) error {
-	if global.InventoryClient == nil {
+// RebackInventory 归还库存（用于订单取消等场景），rebackKey相同的归还库存服务只执行一次
+func RebackInventory(ctx context.Context, rebackKey string, rebackItems []*inventorypb.GoodsInvInfo) error {
+	conn := util.ServiceConn(&global.InventoryClient, "inventory_srv")
+	if conn == nil {
 		return fmt.Errorf("库存服务未连接")
 	}
 
-	inventoryClient := inventorypb.NewInventoryServiceClient(global.InventoryClient)
+	inventoryClient := inventorypb.NewInventoryServiceClient(conn)
 	
 	// 调用库存服务归还库存
 	_, err := inventoryClient.Reback(ctx, &inventorypb.SellInfo{
 		GoodsInvInfo: rebackItems,
+		RebackKey:    rebackKey,
 	})
 	if err != nil {
 		global.Logger.Errorf("库存归还失败: %v", err)
@@ -101,4 +106,43 @@ func ValidateGoodsAvailability(goodsInfo *goodspb.GoodsInfoResponse, requiredNum
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+// GetInventories 批量查询商品当前库存，只读不扣减
+func GetInventories(ctx context.Context, goodsIds []int32) (map[int32]int32, error) {
+	conn := util.ServiceConn(&global.InventoryClient, "inventory_srv")
+	if conn == nil {
+		return nil, fmt.Errorf("库存服务未连接")
+	}
+
+	inventoryClient := inventorypb.NewInventoryServiceClient(conn)
+	rsp, err := inventoryClient.BatchGetInventory(ctx, &inventorypb.BatchInvRequest{GoodsIds: goodsIds})
+	if status.Code(err) == codes.Unimplemented {
+		// 库存服务尚未升级时逐个查询
+		return getInventoriesOneByOne(ctx, inventoryClient, goodsIds)
+	}
+	if err != nil {
+		global.Logger.Errorf("批量查询库存失败，商品ID: %v，错误: %v", goodsIds, err)
+		return nil, fmt.Errorf("查询库存失败: %w", err)
+	}
+	stocks := make(map[int32]int32, len(rsp.Data))
+	for _, inv := range rsp.Data {
+		stocks[inv.GoodsId] = inv.Num
+	}
+	return stocks, nil
+}
+
+func getInventoriesOneByOne(ctx context.Context, inventoryClient inventorypb.InventoryServiceClient, goodsIds []int32) (map[int32]int32, error) {
+	stocks := make(map[int32]int32, len(goodsIds))
+	for _, goodsId := range goodsIds {
+		if _, ok := stocks[goodsId]; ok {
+			continue
+		}
+		inv, err := inventoryClient.GetInventory(ctx, &inventorypb.GoodsInvInfo{GoodsId: goodsId})
+		if err != nil {
+			global.Logger.Errorf("查询库存失败，商品ID: %d，错误: %v", goodsId, err)
+			return nil, fmt.Errorf("查询库存失败: %w", err)
+		}
+		stocks[goodsId] = inv.Num
+	}
+	return stocks, nil
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"order_srv/global"
+	userpb "order_srv/proto/user"
+	"order_srv/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultUserCacheTTL = time.Minute
+	// 不存在的用户ID之后可能被注册，缓存时间更短
+	userNegativeCacheTTL = 10 * time.Second
+	defaultUserTimeout   = 800 * time.Millisecond
+	userCacheMaxSize     = 10000
+)
+
+// ErrUserSrvUnavailable 用户服务未连接或调用失败，无法确认用户是否存在
+var ErrUserSrvUnavailable = errors.New("用户服务不可用")
+
+type userCacheEntry struct {
+	exists   bool
+	expireAt time.Time
+}
+
+var userCache = struct {
+	sync.Mutex
+	entries map[int32]userCacheEntry
+}{entries: make(map[int32]userCacheEntry)}
+
+// CheckUserExists 通过用户服务确认用户存在且未被删除，结果在本地短暂缓存
+// 用户服务不可用时返回ErrUserSrvUnavailable，失败结果不缓存
+func CheckUserExists(ctx context.Context, userId int32) (bool, error) {
+	if exists, ok := cachedUser(userId); ok {
+		return exists, nil
+	}
+	conn := util.ServiceConn(&global.UserClient, "user_srv")
+	if conn == nil {
+		return false, fmt.Errorf("%w: 未连接", ErrUserSrvUnavailable)
+	}
+
+	timeout := defaultUserTimeout
+	if ms := global.ServerConfig.UserSrv.Timeout; ms > 0 {
+		timeout = time.Duration(ms) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := userpb.NewUserClient(conn).GetUserById(ctx, &userpb.IdRequest{Id: userId})
+	if err != nil {
+		if !isUserNotFound(err) {
+			global.Logger.Errorf("调用用户服务失败，用户ID: %d，错误: %v", userId, err)
+			return false, fmt.Errorf("%w: %v", ErrUserSrvUnavailable, err)
+		}
+		cacheUser(userId, false, userNegativeCacheTTL)
+		return false, nil
+	}
+
+	ttl := defaultUserCacheTTL
+	if seconds := global.ServerConfig.UserSrv.CacheTTL; seconds > 0 {
+		ttl = time.Duration(seconds) * time.Second
+	}
+	cacheUser(userId, true, ttl)
+	return true, nil
+}
+
+// isUserNotFound 旧版本用户服务查不到用户时直接返回gorm错误，按错误信息兼容
+func isUserNotFound(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	return st.Code() == codes.NotFound || (st.Code() == codes.Unknown && strings.Contains(st.Message(), "record not found"))
+}
+
+func cachedUser(userId int32) (bool, bool) {
+	userCache.Lock()
+	defer userCache.Unlock()
+	entry, ok := userCache.entries[userId]
+	if !ok || time.Now().After(entry.expireAt) {
+		return false, false
+	}
+	return entry.exists, true
+}
+
+func cacheUser(userId int32, exists bool, ttl time.Duration) {
+	userCache.Lock()
+	defer userCache.Unlock()
+	now := time.Now()
+	if len(userCache.entries) >= userCacheMaxSize {
+		for id, entry := range userCache.entries {
+			if now.After(entry.expireAt) {
+				delete(userCache.entries, id)
+			}
+		}
+		// 仍然没有空间时整体清空，缓存只是减少调用，不影响正确性
+		if len(userCache.entries) >= userCacheMaxSize {
+			userCache.entries = make(map[int32]userCacheEntry)
+		}
+	}
+	userCache.entries[userId] = userCacheEntry{exists: exists, expireAt: now.Add(ttl)}
+}
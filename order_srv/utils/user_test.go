@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"order_srv/config"
+	"order_srv/global"
+	userpb "order_srv/proto/user"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeUserServer 模拟用户服务，记录调用次数，errs中的用户ID返回指定错误
+type fakeUserServer struct {
+	userpb.UnimplementedUserServer
+	mu    sync.Mutex
+	calls int
+	users map[int32]bool
+	errs  map[int32]error
+}
+
+func (f *fakeUserServer) GetUserById(ctx context.Context, req *userpb.IdRequest) (*userpb.UserInfoResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if err, ok := f.errs[req.Id]; ok {
+		return nil, err
+	}
+	if !f.users[req.Id] {
+		return nil, status.Errorf(codes.NotFound, "用户不存在")
+	}
+	return &userpb.UserInfoResponse{Id: req.Id}, nil
+}
+
+func (f *fakeUserServer) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+// setupUserSrv 启动内存中的用户服务并替换全局连接和配置，测试结束后恢复
+func setupUserSrv(t *testing.T, cfg config.UserSrvConfig) *fakeUserServer {
+	fake := &fakeUserServer{users: map[int32]bool{}, errs: map[int32]error{}}
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	userpb.RegisterUserServer(server, fake)
+	go server.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///user_srv",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("连接用户服务失败: %v", err)
+	}
+
+	oldClient, oldConfig, oldLogger := global.UserClient, global.ServerConfig, global.Logger
+	global.UserClient = conn
+	global.ServerConfig = &config.ServerConfig{UserSrv: cfg}
+	global.Logger = zap.NewNop().Sugar()
+	resetUserCache()
+	t.Cleanup(func() {
+		global.UserClient, global.ServerConfig, global.Logger = oldClient, oldConfig, oldLogger
+		resetUserCache()
+		conn.Close()
+		server.Stop()
+	})
+	return fake
+}
+
+func resetUserCache() {
+	userCache.Lock()
+	defer userCache.Unlock()
+	userCache.entries = make(map[int32]userCacheEntry)
+}
+
+// cacheExpireIn 缓存记录距过期的剩余时间
+func cacheExpireIn(t *testing.T, userId int32) time.Duration {
+	userCache.Lock()
+	defer userCache.Unlock()
+	entry, ok := userCache.entries[userId]
+	if !ok {
+		t.Fatalf("用户 %d 没有缓存记录", userId)
+	}
+	return time.Until(entry.expireAt)
+}
+
+// TestCheckUserExistsCache 测试存在和不存在的结果分别按各自的时长缓存，过期后重新查询
+func TestCheckUserExistsCache(t *testing.T) {
+	fake := setupUserSrv(t, config.UserSrvConfig{})
+	fake.users[1] = true
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		exists, err := CheckUserExists(ctx, 1)
+		if err != nil || !exists {
+			t.Fatalf("用户1应存在，实际 %v, %v", exists, err)
+		}
+		exists, err = CheckUserExists(ctx, 2)
+		if err != nil || exists {
+			t.Fatalf("用户2应不存在，实际 %v, %v", exists, err)
+		}
+	}
+	if calls := fake.callCount(); calls != 2 {
+		t.Errorf("缓存命中后不应再调用用户服务，调用次数: %d", calls)
+	}
+	if d := cacheExpireIn(t, 1); d <= defaultUserCacheTTL-time.Second || d > defaultUserCacheTTL {
+		t.Errorf("存在的用户缓存时长 = %v，期望 %v", d, defaultUserCacheTTL)
+	}
+	if d := cacheExpireIn(t, 2); d <= userNegativeCacheTTL-time.Second || d > userNegativeCacheTTL {
+		t.Errorf("不存在的用户缓存时长 = %v，期望 %v", d, userNegativeCacheTTL)
+	}
+
+	// 缓存过期后重新查询，期间注册的用户可以下单
+	fake.users[2] = true
+	cacheUser(2, false, -time.Second)
+	if exists, err := CheckUserExists(ctx, 2); err != nil || !exists {
+		t.Errorf("缓存过期后应重新查询到用户2，实际 %v, %v", exists, err)
+	}
+	if calls := fake.callCount(); calls != 3 {
+		t.Errorf("缓存过期后应调用用户服务，调用次数: %d", calls)
+	}
+}
+
+// TestCheckUserExistsCacheTTLConfig 测试配置的缓存时长只作用于存在的用户
+func TestCheckUserExistsCacheTTLConfig(t *testing.T) {
+	fake := setupUserSrv(t, config.UserSrvConfig{CacheTTL: 5})
+	fake.users[1] = true
+	ctx := context.Background()
+
+	CheckUserExists(ctx, 1)
+	CheckUserExists(ctx, 2)
+	if d := cacheExpireIn(t, 1); d <= 4*time.Second || d > 5*time.Second {
+		t.Errorf("存在的用户缓存时长 = %v，期望 5s", d)
+	}
+	if d := cacheExpireIn(t, 2); d <= userNegativeCacheTTL-time.Second || d > userNegativeCacheTTL {
+		t.Errorf("不存在的用户缓存时长 = %v，期望 %v", d, userNegativeCacheTTL)
+	}
+}
+
+// TestCheckUserExistsErrors 测试NotFound和旧版本的record not found视为不存在，其他错误视为服务不可用且不缓存
+func TestCheckUserExistsErrors(t *testing.T) {
+	fake := setupUserSrv(t, config.UserSrvConfig{})
+	fake.errs[3] = status.Errorf(codes.Unknown, "record not found")
+	fake.errs[4] = status.Errorf(codes.Internal, "数据库错误")
+	fake.errs[5] = status.Errorf(codes.Unknown, "连接被拒绝")
+	ctx := context.Background()
+
+	if exists, err := CheckUserExists(ctx, 3); err != nil || exists {
+		t.Errorf("record not found应视为不存在，实际 %v, %v", exists, err)
+	}
+	for _, id := range []int32{4, 5} {
+		for i := 0; i < 2; i++ {
+			exists, err := CheckUserExists(ctx, id)
+			if exists || !errors.Is(err, ErrUserSrvUnavailable) {
+				t.Errorf("用户 %d 期望ErrUserSrvUnavailable，实际 %v, %v", id, exists, err)
+			}
+		}
+	}
+	// 失败结果不缓存，每次都调用用户服务
+	if calls := fake.callCount(); calls != 5 {
+		t.Errorf("调用次数 = %d，期望 5", calls)
+	}
+}
+
+// TestCheckUserExistsNoClient 测试用户服务未连接时拒绝而不是放行
+func TestCheckUserExistsNoClient(t *testing.T) {
+	setupUserSrv(t, config.UserSrvConfig{})
+	global.UserClient = nil
+
+	exists, err := CheckUserExists(context.Background(), 1)
+	if exists || !errors.Is(err, ErrUserSrvUnavailable) {
+		t.Errorf("未连接用户服务时期望ErrUserSrvUnavailable，实际 %v, %v", exists, err)
+	}
+}
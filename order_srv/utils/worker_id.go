@@ -0,0 +1,267 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"order_srv/global"
+
+	"github.com/hashicorp/consul/api"
+)
+
+var errNoFreeWorkerId = errors.New("没有可用的机器号")
+
+// WorkerIdAllocator 机器号分配器，同一时刻一个机器号只能被一个实例持有
+type WorkerIdAllocator interface {
+	// Acquire 申请一个未被占用的机器号
+	Acquire(ctx context.Context) (int64, error)
+	// Renew 续期已持有的机器号，返回false表示机器号已过期或被其他实例占用
+	Renew(ctx context.Context, id int64) (bool, error)
+	// Release 释放机器号
+	Release(ctx context.Context, id int64) error
+}
+
+// NewWorkerIdAllocator 按名称创建机器号分配器，支持redis和consul
+func NewWorkerIdAllocator(source, name, instance string, maxId int64, ttl time.Duration) (WorkerIdAllocator, error) {
+	switch source {
+	case "", "redis":
+		return &redisWorkerIdAllocator{prefix: "idgen:" + name + ":worker:", instance: instance, maxId: maxId, ttl: ttl}, nil
+	case "consul":
+		return &consulWorkerIdAllocator{prefix: "idgen/" + name + "/worker/", instance: instance, maxId: maxId, ttl: ttl}, nil
+	}
+	return nil, fmt.Errorf("未知的机器号分配方式: %s", source)
+}
+
+// randomStart 从随机位置开始查找空闲机器号，减少多个实例同时启动时的冲突
+func randomStart(maxId int64) int64 {
+	return rand.Int63n(maxId + 1)
+}
+
+// redisWorkerIdAllocator 基于Redis键的机器号分配，键带过期时间，实例退出后自动回收
+type redisWorkerIdAllocator struct {
+	prefix   string
+	instance string
+	maxId    int64
+	ttl      time.Duration
+}
+
+func (a *redisWorkerIdAllocator) Acquire(ctx context.Context) (int64, error) {
+	start := randomStart(a.maxId)
+	for i := int64(0); i <= a.maxId; i++ {
+		id := (start + i) % (a.maxId + 1)
+		ok, err := global.RedisClient.SetNX(ctx, a.prefix+strconv.FormatInt(id, 10), a.instance, a.ttl).Result()
+		if err != nil {
+			return 0, fmt.Errorf("申请机器号失败: %w", err)
+		}
+		if ok {
+			return id, nil
+		}
+	}
+	return 0, errNoFreeWorkerId
+}
+
+func (a *redisWorkerIdAllocator) Renew(ctx context.Context, id int64) (bool, error) {
+	n, err := renewScript.Run(ctx, global.RedisClient, []string{a.prefix + strconv.FormatInt(id, 10)}, a.instance, a.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("续期机器号失败: %w", err)
+	}
+	return n == 1, nil
+}
+
+func (a *redisWorkerIdAllocator) Release(ctx context.Context, id int64) error {
+	return resignScript.Run(ctx, global.RedisClient, []string{a.prefix + strconv.FormatInt(id, 10)}, a.instance).Err()
+}
+
+// consulWorkerIdAllocator 基于Consul会话锁的机器号分配，会话过期后键被删除，机器号自动回收
+type consulWorkerIdAllocator struct {
+	prefix   string
+	instance string
+	maxId    int64
+	ttl      time.Duration
+	session  string
+}
+
+func (a *consulWorkerIdAllocator) Acquire(ctx context.Context) (int64, error) {
+	opts := (&api.WriteOptions{}).WithContext(ctx)
+	if a.session == "" {
+		session, _, err := global.ConsulClient.Session().Create(&api.SessionEntry{
+			Name:     a.prefix + a.instance,
+			TTL:      a.ttl.String(),
+			Behavior: api.SessionBehaviorDelete,
+		}, opts)
+		if err != nil {
+			return 0, fmt.Errorf("创建Consul会话失败: %w", err)
+		}
+		a.session = session
+	}
+
+	start := randomStart(a.maxId)
+	for i := int64(0); i <= a.maxId; i++ {
+		id := (start + i) % (a.maxId + 1)
+		ok, _, err := global.ConsulClient.KV().Acquire(&api.KVPair{
+			Key:     a.prefix + strconv.FormatInt(id, 10),
+			Value:   []byte(a.instance),
+			Session: a.session,
+		}, opts)
+		if err != nil {
+			return 0, fmt.Errorf("申请机器号失败: %w", err)
+		}
+		if ok {
+			return id, nil
+		}
+	}
+	return 0, errNoFreeWorkerId
+}
+
+func (a *consulWorkerIdAllocator) Renew(ctx context.Context, id int64) (bool, error) {
+	if a.session == "" {
+		return false, nil
+	}
+	entry, _, err := global.ConsulClient.Session().Renew(a.session, (&api.WriteOptions{}).WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("续期Consul会话失败: %w", err)
+	}
+	if entry == nil {
+		// 会话已过期，持有的键已被删除，下次申请时创建新会话
+		a.session = ""
+		return false, nil
+	}
+	pair, _, err := global.ConsulClient.KV().Get(a.prefix+strconv.FormatInt(id, 10), (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("查询机器号失败: %w", err)
+	}
+	return pair != nil && pair.Session == a.session, nil
+}
+
+func (a *consulWorkerIdAllocator) Release(ctx context.Context, id int64) error {
+	if a.session == "" {
+		return nil
+	}
+	opts := (&api.WriteOptions{}).WithContext(ctx)
+	if _, _, err := global.ConsulClient.KV().Release(&api.KVPair{
+		Key:     a.prefix + strconv.FormatInt(id, 10),
+		Session: a.session,
+	}, opts); err != nil {
+		return err
+	}
+	_, err := global.ConsulClient.Session().Destroy(a.session, opts)
+	a.session = ""
+	return err
+}
+
+// WorkerLease 持有机器号并定期续期，机器号丢失或续期持续失败时通知使用方停止使用并重新申请
+type WorkerLease struct {
+	allocator WorkerIdAllocator
+	ttl       time.Duration
+	interval  time.Duration
+	onChange  func(id int64) // 机器号变化时回调，-1表示机器号已失效
+
+	mu        sync.Mutex
+	id        int64
+	renewedAt time.Time
+}
+
+// NewWorkerLease 创建机器号租约，续期间隔为ttl的三分之一
+func NewWorkerLease(allocator WorkerIdAllocator, ttl time.Duration, onChange func(id int64)) *WorkerLease {
+	return &WorkerLease{allocator: allocator, ttl: ttl, interval: ttl / 3, onChange: onChange, id: -1}
+}
+
+// ID 返回当前持有的机器号，未持有时返回-1
+func (l *WorkerLease) ID() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.id
+}
+
+// Acquire 申请机器号
+func (l *WorkerLease) Acquire(ctx context.Context) error {
+	id, err := l.allocator.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	l.set(id)
+	return nil
+}
+
+// Run 定期续期，阻塞直到ctx取消，退出时释放机器号
+func (l *WorkerLease) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			l.release()
+			return
+		case <-ticker.C:
+			l.tick(ctx)
+		}
+	}
+}
+
+func (l *WorkerLease) tick(ctx context.Context) {
+	id := l.ID()
+	if id < 0 {
+		if err := l.Acquire(ctx); err != nil {
+			global.Logger.Errorf("重新申请机器号失败: %v", err)
+		}
+		return
+	}
+
+	held, err := l.allocator.Renew(ctx, id)
+	switch {
+	case err != nil:
+		// 续期出错时在租约到期前停止使用，避免与重新分配到该机器号的实例生成相同的ID
+		l.mu.Lock()
+		expired := time.Since(l.renewedAt) >= l.ttl-l.interval
+		l.mu.Unlock()
+		global.Logger.Errorf("%v，机器号: %d", err, id)
+		if expired {
+			global.Logger.Warnf("机器号续期持续失败，停止发号，机器号: %d", id)
+			l.set(-1)
+		}
+	case !held:
+		global.Logger.Warnf("机器号已失效，停止发号并重新申请，机器号: %d", id)
+		l.set(-1)
+		if err := l.Acquire(ctx); err != nil {
+			global.Logger.Errorf("重新申请机器号失败: %v", err)
+		}
+	default:
+		l.mu.Lock()
+		l.renewedAt = time.Now()
+		l.mu.Unlock()
+	}
+}
+
+func (l *WorkerLease) set(id int64) {
+	l.mu.Lock()
+	l.id = id
+	if id >= 0 {
+		l.renewedAt = time.Now()
+	}
+	l.mu.Unlock()
+	if l.onChange != nil {
+		l.onChange(id)
+	}
+	if id >= 0 {
+		global.Logger.Infof("已持有机器号: %d", id)
+	}
+}
+
+func (l *WorkerLease) release() {
+	id := l.ID()
+	if id < 0 {
+		return
+	}
+	l.set(-1)
+	// ctx已取消，使用新的超时上下文释放
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := l.allocator.Release(ctx, id); err != nil {
+		global.Logger.Warnf("释放机器号失败，机器号: %d，错误: %v", id, err)
+	}
+}
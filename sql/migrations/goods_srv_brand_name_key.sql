@@ -0,0 +1,88 @@
+-- 商品服务品牌名称唯一约束：brand 增加由名称生成的小写唯一键 name_key（uk_brand_name_key）
+-- 说明：必须在新版本服务启动前执行。已有数据中忽略大小写和首尾空格后同名的未删除品牌（如 Apple 和 apple）会导致加唯一索引失败，AutoMigrate 启动报错
+-- 本脚本先将重名品牌合并到ID最小的品牌，合并方式与 MergeBrand 接口一致：迁移商品、分类品牌关联和定向轮播图后软删除源品牌，再加唯一键
+
+SET NAMES utf8mb4;
+
+-- 合并前查看重名品牌，ids 中第一个为保留的品牌
+SELECT LOWER(TRIM(name)) AS name_key, GROUP_CONCAT(id ORDER BY id) AS ids
+FROM brand
+WHERE deleted_at IS NULL
+GROUP BY LOWER(TRIM(name))
+HAVING COUNT(*) > 1;
+
+-- 重名品牌到保留品牌的映射。使用普通表而不是临时表，MySQL 同一语句中不能两次引用同一个临时表
+DROP TABLE IF EXISTS brand_merge_map;
+CREATE TABLE brand_merge_map (
+    source_id INT UNSIGNED NOT NULL PRIMARY KEY,
+    target_id INT UNSIGNED NOT NULL
+);
+INSERT INTO brand_merge_map (source_id, target_id)
+SELECT b.id, k.target_id
+FROM brand b
+JOIN (
+    SELECT LOWER(TRIM(name)) AS name_key, MIN(id) AS target_id
+    FROM brand
+    WHERE deleted_at IS NULL
+    GROUP BY LOWER(TRIM(name))
+    HAVING COUNT(*) > 1
+) k ON LOWER(TRIM(b.name)) = k.name_key
+WHERE b.deleted_at IS NULL AND b.id <> k.target_id;
+
+START TRANSACTION;
+
+-- 迁移商品（包括已软删除的商品）
+UPDATE goods g
+JOIN brand_merge_map m ON g.brand_id = m.source_id
+SET g.brand_id = m.target_id;
+
+-- 迁移定向到源品牌的轮播图
+UPDATE banner bn
+JOIN brand_merge_map m ON bn.brand_id = m.source_id
+SET bn.brand_id = m.target_id;
+
+-- 分类品牌关联的唯一索引 (category_id, brand_id) 包含软删除的记录
+-- 1. 保留品牌已有该分类（可能已软删除）时，恢复保留品牌的关联
+UPDATE category_brand t
+JOIN brand_merge_map m ON t.brand_id = m.target_id
+JOIN category_brand s ON s.brand_id = m.source_id AND s.category_id = t.category_id AND s.deleted_at IS NULL
+SET t.deleted_at = NULL;
+
+-- 2. 删除与保留品牌关联重复的源品牌关联
+DELETE s
+FROM category_brand s
+JOIN brand_merge_map m ON s.brand_id = m.source_id
+JOIN category_brand t ON t.brand_id = m.target_id AND t.category_id = s.category_id;
+
+-- 3. 多个源品牌合并到同一品牌且关联了同一分类时，只保留ID最小的关联，优先保留未删除的
+DELETE s
+FROM category_brand s
+JOIN brand_merge_map m ON s.brand_id = m.source_id
+JOIN category_brand s2 ON s2.category_id = s.category_id AND s2.id <> s.id
+    AND (s2.deleted_at IS NULL) >= (s.deleted_at IS NULL)
+    AND ((s2.deleted_at IS NULL) > (s.deleted_at IS NULL) OR s2.id < s.id)
+JOIN brand_merge_map m2 ON s2.brand_id = m2.source_id AND m2.target_id = m.target_id;
+
+-- 4. 其余源品牌关联改为保留品牌
+UPDATE category_brand s
+JOIN brand_merge_map m ON s.brand_id = m.source_id
+SET s.brand_id = m.target_id;
+
+-- 软删除源品牌
+UPDATE brand b
+JOIN brand_merge_map m ON b.id = m.source_id
+SET b.deleted_at = NOW();
+
+COMMIT;
+
+-- 合并记录，保留到确认无误后再删除
+SELECT * FROM brand_merge_map ORDER BY target_id, source_id;
+-- DROP TABLE brand_merge_map;
+
+-- 加生成列和唯一键，定义与 model.Brand.NameKey 保持一致，软删除的品牌生成列为NULL，不占用名称
+ALTER TABLE brand
+    ADD COLUMN name_key VARCHAR(50) GENERATED ALWAYS AS (IF(deleted_at IS NULL, LOWER(TRIM(name)), NULL)) STORED,
+    ADD UNIQUE INDEX uk_brand_name_key (name_key);
+
+-- 校验：结果应为空
+SELECT name_key, COUNT(*) AS cnt FROM brand WHERE name_key IS NOT NULL GROUP BY name_key HAVING cnt > 1;
@@ -0,0 +1,13 @@
+-- 商品服务分类属性模板改为物理删除：清理此前软删除的属性模板
+-- 说明：软删除的记录仍占用 (category_id, name) 唯一索引 idx_category_attr_name，导致同名属性无法重新创建
+-- 对应的商品属性值在删除模板时已物理删除，这里只需清理模板本身
+
+SET NAMES utf8mb4;
+
+-- 确认待清理的记录没有残留的商品属性值，结果应为0
+SELECT COUNT(*) AS 'Orphan Values'
+FROM goods_attribute_value v
+JOIN category_attribute a ON a.id = v.attribute_id
+WHERE a.deleted_at IS NOT NULL;
+
+DELETE FROM category_attribute WHERE deleted_at IS NOT NULL;
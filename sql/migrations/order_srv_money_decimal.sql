@@ -0,0 +1,30 @@
+-- 订单服务金额字段迁移：FLOAT 改为 DECIMAL 定点数，订单拆分商品总额、运费、优惠和应付金额
+-- 说明：必须在新版本服务启动前执行。AutoMigrate 只修改列类型，不会把以分存储的优惠金额换算为元
+-- 注意：order_discount、order_refund、order_payment 等表金额以分为单位存为 BIGINT，不在本脚本范围内
+
+SET NAMES utf8mb4;
+
+-- 购物车加入时价格
+ALTER TABLE shopping_cart MODIFY COLUMN goods_price DECIMAL(10,2) NOT NULL DEFAULT 0;
+
+-- 订单商品快照价格和分摊优惠
+ALTER TABLE order_goods MODIFY COLUMN goods_price DECIMAL(10,2) NOT NULL DEFAULT 0;
+ALTER TABLE order_goods MODIFY COLUMN discount_amount DECIMAL(12,2) NOT NULL DEFAULT 0;
+UPDATE order_goods SET discount_amount = discount_amount / 100 WHERE discount_amount <> 0;
+
+-- 订单金额明细，order_mount 为应付金额
+ALTER TABLE order_info MODIFY COLUMN order_mount DECIMAL(12,2) NOT NULL DEFAULT 0;
+-- 优惠金额原为以分为单位的 BIGINT，改类型后换算为元
+ALTER TABLE order_info MODIFY COLUMN discount_amount DECIMAL(12,2) NOT NULL DEFAULT 0;
+UPDATE order_info SET discount_amount = discount_amount / 100 WHERE discount_amount <> 0;
+ALTER TABLE order_info
+    ADD COLUMN goods_amount DECIMAL(12,2) NOT NULL DEFAULT 0 AFTER status,
+    ADD COLUMN shipping_fee DECIMAL(12,2) NOT NULL DEFAULT 0 AFTER goods_amount;
+
+-- 回填商品总额：历史订单未收取运费，商品总额 = 应付金额 + 优惠金额
+UPDATE order_info SET goods_amount = order_mount + discount_amount WHERE goods_amount = 0;
+
+-- 校验：商品总额 + 运费 - 优惠 应等于应付金额
+SELECT COUNT(*) AS 'Mismatched Orders'
+FROM order_info
+WHERE goods_amount + shipping_fee - discount_amount <> order_mount;
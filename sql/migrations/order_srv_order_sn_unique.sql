@@ -0,0 +1,11 @@
+-- 订单服务订单号唯一约束：订单号改由Snowflake生成器生成，order_info.order_sn 加唯一索引
+-- 说明：必须在新版本服务启动前执行。旧的随机订单号可能重复，先执行下面的查询确认没有重复数据，有重复时人工处理后再加索引
+
+SET NAMES utf8mb4;
+
+-- 检查重复订单号，结果应为空
+SELECT order_sn, COUNT(*) AS cnt FROM order_info GROUP BY order_sn HAVING cnt > 1;
+
+-- 普通索引替换为唯一索引
+ALTER TABLE order_info DROP INDEX idx_order_info_order_sn;
+ALTER TABLE order_info ADD UNIQUE INDEX uk_order_info_order_sn (order_sn);
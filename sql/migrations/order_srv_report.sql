@@ -0,0 +1,6 @@
+-- 订单服务报表：汇总表按下单时间重算最近若干天的数据，为 order_info.created_at 加索引避免全表扫描
+-- 说明：order_daily_stat、goods_daily_stat 两张汇总表由 AutoMigrate 创建，本脚本只补充索引
+
+SET NAMES utf8mb4;
+
+ALTER TABLE order_info ADD INDEX idx_order_info_created_at (created_at);
@@ -6,6 +6,8 @@ SET sql_mode = 'NO_AUTO_VALUE_ON_ZERO';
 
 -- 品牌测试数据
 -- 创建各个分类的知名品牌数据
+-- 注意：brand 表的 name_key 列由数据库根据名称生成（忽略大小写和首尾空格），带唯一索引 uk_brand_name_key，
+-- 新增品牌时名称不能与已有品牌仅大小写不同；旧库升级前先执行 migrations/goods_srv_brand_name_key.sql
 
 -- 清空现有数据
 DELETE FROM category_brand;
@@ -131,5 +133,8 @@ INSERT INTO category_brand (category_id, brand_id, created_at, updated_at) VALUE
 -- 品牌涵盖各主要分类，包含国际知名品牌和国内品牌
 -- category_brand 表建立了分类和品牌的多对多关系
 -- 一个品牌可以关联多个分类，一个分类可以有多个品牌
+-- 品牌名称忽略大小写唯一，结果应为空
+SELECT LOWER(TRIM(name)) AS name_key, COUNT(*) AS cnt FROM brand WHERE deleted_at IS NULL GROUP BY LOWER(TRIM(name)) HAVING cnt > 1;
+
 -- 恢复外键检查
 SET FOREIGN_KEY_CHECKS = 1;
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"crypto/sha512"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -111,6 +112,9 @@ func (s *UserServer) GetUserById(ctx context.Context, req *proto.IdRequest) (*pr
 	// 通过手机号获取用户信息
 	var user model.User
 	result := global.DB.First(&user, req.Id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.NotFound, "用户不存在")
+	}
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/consul/api"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
 
@@ -23,4 +24,5 @@ var (
 	ServerConfig *config.ServerConfig
 	ConsulClient *api.Client
 	RedisClient  *redis.Client
+	OrderClient  *grpc.ClientConn
 )
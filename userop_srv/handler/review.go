@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+	"userop_srv/global"
+	"userop_srv/model"
+	"userop_srv/proto"
+	orderproto "userop_srv/proto/order"
+	"userop_srv/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+const (
+	maxReviewContentLen = 500
+	maxReviewImages     = 9
+	maxReviewReplyLen   = 500
+)
+
+// 可以评价的订单状态
+var reviewableOrderStatus = map[string]bool{
+	"TRADE_SUCCESS":  true,
+	"TRADE_FINISHED": true,
+}
+
+// CreateReview 创建商品评价，只能评价本人已完成订单中的商品，每个订单商品只能评价一次
+func (s *UserOpServer) CreateReview(ctx context.Context, req *proto.ReviewRequest) (*proto.ReviewResponse, error) {
+	if req.UserId <= 0 || req.OrderId <= 0 || req.OrderGoodsId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户、订单和订单商品不能为空")
+	}
+	if req.Rating < 1 || req.Rating > 5 {
+		return nil, status.Errorf(codes.InvalidArgument, "评分必须在1到5之间")
+	}
+	if utf8.RuneCountInString(req.Content) > maxReviewContentLen {
+		return nil, status.Errorf(codes.InvalidArgument, "评价内容不能超过%d个字", maxReviewContentLen)
+	}
+	if len(req.Images) > maxReviewImages {
+		return nil, status.Errorf(codes.InvalidArgument, "评价图片不能超过%d张", maxReviewImages)
+	}
+
+	goodsId, err := findReviewableOrderGoods(ctx, req.UserId, req.OrderId, req.OrderGoodsId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 唯一索引包含软删除的记录，这里一并检查
+	var count int64
+	if result := global.DB.Unscoped().Model(&model.GoodsReview{}).Where("order_goods = ?", req.OrderGoodsId).Count(&count); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询评价失败")
+	}
+	if count > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "该商品已经评价过了")
+	}
+
+	review := model.GoodsReview{
+		User:       req.UserId,
+		Goods:      goodsId,
+		Order:      req.OrderId,
+		OrderGoods: req.OrderGoodsId,
+		Rating:     req.Rating,
+		Content:    req.Content,
+		Images:     req.Images,
+		Status:     model.ReviewStatusPending,
+	}
+	if result := global.DB.Create(&review); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "创建评价失败")
+	}
+
+	return &proto.ReviewResponse{
+		Id: review.ID,
+	}, nil
+}
+
+// findReviewableOrderGoods 通过订单服务校验订单商品属于用户且订单已完成，返回商品ID
+func findReviewableOrderGoods(ctx context.Context, userId, orderId, orderGoodsId int32) (int32, error) {
+	conn := util.ServiceConn(&global.OrderClient, "order_srv")
+	if conn == nil {
+		return 0, status.Errorf(codes.Unavailable, "订单服务不可用")
+	}
+
+	orderClient := orderproto.NewOrderServiceClient(conn)
+	detail, err := orderClient.OrderDetail(ctx, &orderproto.OrderRequest{
+		Id:     orderId,
+		UserId: userId,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, status.Errorf(codes.NotFound, "订单不存在")
+		}
+		global.Logger.Errorf("查询订单详情失败，订单ID: %d，错误: %v", orderId, err)
+		return 0, status.Errorf(codes.Unavailable, "查询订单失败")
+	}
+
+	if !reviewableOrderStatus[detail.OrderInfo.GetStatus()] {
+		return 0, status.Errorf(codes.FailedPrecondition, "订单未完成，暂不能评价")
+	}
+
+	for _, item := range detail.Goods {
+		if item.Id == orderGoodsId {
+			return item.GoodsId, nil
+		}
+	}
+	return 0, status.Errorf(codes.NotFound, "订单商品不存在")
+}
+
+// ReviewList 评价列表，默认只返回审核通过的评价
+func (s *UserOpServer) ReviewList(ctx context.Context, req *proto.ReviewFilterRequest) (*proto.ReviewListResponse, error) {
+	var reviews []model.GoodsReview
+	var count int64
+
+	query := global.DB.Model(&model.GoodsReview{})
+	if req.GoodsId > 0 {
+		query = query.Where("goods = ?", req.GoodsId)
+	}
+	if req.UserId > 0 {
+		query = query.Where("user = ?", req.UserId)
+	}
+	if req.Status != nil {
+		query = query.Where("status = ?", req.GetStatus())
+	} else {
+		query = query.Where("status = ?", model.ReviewStatusApproved)
+	}
+
+	if result := query.Count(&count); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询评价失败")
+	}
+	if result := query.Order("id desc").Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Find(&reviews); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询评价失败")
+	}
+
+	reviewList := make([]*proto.ReviewInfo, 0, len(reviews))
+	for _, review := range reviews {
+		info := &proto.ReviewInfo{
+			Id:           review.ID,
+			UserId:       review.User,
+			GoodsId:      review.Goods,
+			OrderId:      review.Order,
+			OrderGoodsId: review.OrderGoods,
+			Rating:       review.Rating,
+			Content:      review.Content,
+			Images:       review.Images,
+			Status:       review.Status,
+			RejectReason: review.RejectReason,
+			Reply:        review.Reply,
+			AddTime:      review.CreatedAt.Unix(),
+		}
+		if review.ReplyTime != nil {
+			info.ReplyTime = review.ReplyTime.Unix()
+		}
+		reviewList = append(reviewList, info)
+	}
+
+	return &proto.ReviewListResponse{
+		Total: int32(count),
+		Data:  reviewList,
+	}, nil
+}
+
+// ModerateReview 审核评价，并重新计算商品评分汇总
+func (s *UserOpServer) ModerateReview(ctx context.Context, req *proto.ModerateReviewRequest) (*emptypb.Empty, error) {
+	if req.Status != model.ReviewStatusApproved && req.Status != model.ReviewStatusRejected {
+		return nil, status.Errorf(codes.InvalidArgument, "审核状态无效")
+	}
+
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		var review model.GoodsReview
+		if result := tx.First(&review, req.Id); result.Error != nil {
+			return result.Error
+		}
+
+		reason := ""
+		if req.Status == model.ReviewStatusRejected {
+			reason = req.Reason
+		}
+		if result := tx.Model(&review).Updates(map[string]interface{}{
+			"status":        req.Status,
+			"reject_reason": reason,
+		}); result.Error != nil {
+			return result.Error
+		}
+		return model.RefreshGoodsRating(tx, review.Goods)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "评价不存在")
+		}
+		global.Logger.Errorf("审核评价失败，评价ID: %d，错误: %v", req.Id, err)
+		return nil, status.Errorf(codes.Internal, "审核评价失败")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ReplyReview 商家回复评价，重复回复会覆盖之前的内容
+func (s *UserOpServer) ReplyReview(ctx context.Context, req *proto.ReplyReviewRequest) (*emptypb.Empty, error) {
+	if req.Reply == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "回复内容不能为空")
+	}
+	if utf8.RuneCountInString(req.Reply) > maxReviewReplyLen {
+		return nil, status.Errorf(codes.InvalidArgument, "回复内容不能超过%d个字", maxReviewReplyLen)
+	}
+
+	now := time.Now()
+	result := global.DB.Model(&model.GoodsReview{}).Where("id = ?", req.Id).Updates(map[string]interface{}{
+		"reply":      req.Reply,
+		"reply_time": &now,
+	})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "回复评价失败")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "评价不存在")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetGoodsRatings 批量获取商品评分汇总，供商品服务列表展示，没有评价的商品返回0
+func (s *UserOpServer) GetGoodsRatings(ctx context.Context, req *proto.GoodsRatingRequest) (*proto.GoodsRatingListResponse, error) {
+	if len(req.GoodsIds) == 0 {
+		return &proto.GoodsRatingListResponse{}, nil
+	}
+
+	var ratings []model.GoodsRating
+	if result := global.DB.Where("goods IN ?", req.GoodsIds).Find(&ratings); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询商品评分失败")
+	}
+
+	ratingMap := make(map[int32]model.GoodsRating, len(ratings))
+	for _, rating := range ratings {
+		ratingMap[rating.Goods] = rating
+	}
+
+	data := make([]*proto.GoodsRating, 0, len(req.GoodsIds))
+	for _, goodsId := range req.GoodsIds {
+		rating := ratingMap[goodsId]
+		data = append(data, &proto.GoodsRating{
+			GoodsId:     goodsId,
+			AvgRating:   rating.AvgRating,
+			RatingCount: rating.RatingCount,
+		})
+	}
+
+	return &proto.GoodsRatingListResponse{
+		Data: data,
+	}, nil
+}
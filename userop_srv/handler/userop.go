@@ -2,9 +2,13 @@ package handler
 
 import (
 	"context"
+	"math"
+	"time"
 	"userop_srv/global"
 	"userop_srv/model"
 	"userop_srv/proto"
+	orderproto "userop_srv/proto/order"
+	"userop_srv/util"
 
 	"gorm.io/gorm"
 	"google.golang.org/grpc/codes"
@@ -12,6 +16,9 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// 关联售后单失败后撤销售后单的超时时间
+const afterSaleCompensateTimeout = 3 * time.Second
+
 type UserOpServer struct {
 	proto.UnimplementedUserOpServer
 }
@@ -205,6 +212,10 @@ func (s *UserOpServer) CreateMessage(ctx context.Context, req *proto.MessageRequ
 	message.Message = req.Message
 	message.File = req.File
 
+	if req.MessageType == model.MessageTypeAfterSale {
+		return createAfterSaleMessage(ctx, &message, req)
+	}
+
 	if result := global.DB.Create(&message); result.Error != nil {
 		return nil, status.Errorf(codes.Internal, "创建留言失败")
 	}
@@ -214,6 +225,76 @@ func (s *UserOpServer) CreateMessage(ctx context.Context, req *proto.MessageRequ
 	}, nil
 }
 
+// createAfterSaleMessage 创建售后留言，同时在订单服务创建售后单，任一失败则都不创建
+// 远程调用不放在本地事务内：先提交留言再创建售后单，售后单创建失败时删除留言，
+// 关联售后单失败时撤销售后单，避免售后单指向已回滚的留言
+func createAfterSaleMessage(ctx context.Context, message *model.LeavingMessage, req *proto.MessageRequest) (*proto.MessageResponse, error) {
+	if req.OrderId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "售后留言必须关联订单")
+	}
+	conn := util.ServiceConn(&global.OrderClient, "order_srv")
+	if conn == nil {
+		return nil, status.Errorf(codes.Unavailable, "订单服务不可用")
+	}
+	refundType := req.RefundType
+	if refundType == "" {
+		refundType = model.RefundTypeRefundOnly
+	}
+	message.Order = req.OrderId
+
+	if err := global.DB.Create(message).Error; err != nil {
+		return nil, status.Errorf(codes.Internal, "创建留言失败")
+	}
+
+	orderClient := orderproto.NewOrderServiceClient(conn)
+	refund, err := orderClient.RefundCreate(ctx, &orderproto.RefundRequest{
+		OrderId:      req.OrderId,
+		UserId:       req.UserId,
+		OrderGoodsId: req.OrderGoodsId,
+		Type:         refundType,
+		RefundAmount: int64(math.Round(float64(req.RefundAmount) * 100)),
+		Nums:         req.RefundNums,
+		Reason:       req.Subject,
+		Description:  req.Message,
+		MessageId:    int32(message.ID),
+	})
+	if err != nil {
+		deleteAfterSaleMessage(message)
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.AlreadyExists:
+			return nil, err
+		}
+		global.Logger.Errorf("创建售后单失败，订单ID: %d，错误: %v", req.OrderId, err)
+		return nil, status.Errorf(codes.Unavailable, "创建售后单失败")
+	}
+
+	message.Refund = refund.Id
+	if err := global.DB.Model(message).Update("refund", refund.Id).Error; err != nil {
+		global.Logger.Errorf("关联售后单失败，留言ID: %d，售后单ID: %d，错误: %v", message.ID, refund.Id, err)
+		// 请求可能已取消，补偿操作使用独立的超时
+		cancelCtx, cancel := context.WithTimeout(context.Background(), afterSaleCompensateTimeout)
+		defer cancel()
+		if _, cancelErr := orderClient.RefundCancel(cancelCtx, &orderproto.RefundOperateRequest{Id: refund.Id, UserId: req.UserId}); cancelErr != nil {
+			// 售后单仍指向留言，留言保留以便人工处理
+			global.Logger.Errorf("撤销售后单失败，需人工处理，留言ID: %d，售后单ID: %d，错误: %v", message.ID, refund.Id, cancelErr)
+			return nil, status.Errorf(codes.Internal, "创建留言失败")
+		}
+		deleteAfterSaleMessage(message)
+		return nil, status.Errorf(codes.Internal, "创建留言失败")
+	}
+
+	return &proto.MessageResponse{
+		Id: int32(message.ID),
+	}, nil
+}
+
+// deleteAfterSaleMessage 售后单未创建成功时删除已提交的售后留言
+func deleteAfterSaleMessage(message *model.LeavingMessage) {
+	if err := global.DB.Unscoped().Delete(message).Error; err != nil {
+		global.Logger.Errorf("删除售后留言失败，留言ID: %d，错误: %v", message.ID, err)
+	}
+}
+
 // MessageList 留言列表
 func (s *UserOpServer) MessageList(ctx context.Context, req *proto.MessageRequest) (*proto.MessageListResponse, error) {
 	var messages []model.LeavingMessage
@@ -238,6 +319,8 @@ func (s *UserOpServer) MessageList(ctx context.Context, req *proto.MessageReques
 			Subject:     message.Subject,
 			Message:     message.Message,
 			File:        message.File,
+			OrderId:     message.Order,
+			RefundId:    message.Refund,
 		})
 	}
 
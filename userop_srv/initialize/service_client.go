@@ -1,18 +1,26 @@
 package initialize
 
 import (
+	"userop_srv/global"
+	"userop_srv/util"
+
 	"go.uber.org/zap"
 )
 
 // InitServiceClients 初始化服务客户端连接
+// 依赖的服务尚未启动时不阻止启动，使用时再通过util.ServiceConn重新连接
 func InitServiceClients() {
-	// userop_srv 目前不需要连接其他服务
-	// 如果后续需要调用其他服务，可以在这里添加
+	// 订单服务，商品评价和售后留言需要校验订单
+	if util.ServiceConn(&global.OrderClient, "order_srv") == nil {
+		zap.S().Warn("订单服务暂不可用，使用时重新连接")
+	}
 	zap.S().Info("用户操作服务客户端初始化完成")
 }
 
 // CloseServiceClients 关闭所有服务客户端连接
 func CloseServiceClients() {
-	// 如果后续添加了服务客户端连接，在这里关闭
+	if global.OrderClient != nil {
+		global.OrderClient.Close()
+	}
 	zap.S().Info("所有服务客户端连接已关闭")
-}
\ No newline at end of file
+}
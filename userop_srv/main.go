@@ -43,6 +43,9 @@ func main() {
 	// 初始化 Consul
 	global.ConsulClient = initialize.InitConsul()
 
+	// 初始化服务客户端
+	initialize.InitServiceClients()
+
 	// 创建 gRPC 服务器
 	server := grpc.NewServer()
 
@@ -1,5 +1,16 @@
 package model
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
 // UserFav 用户收藏表
 type UserFav struct {
 	BaseModel
@@ -35,8 +46,105 @@ type LeavingMessage struct {
 	Subject     string `gorm:"type:varchar(100)"`
 	Message     string `gorm:"type:text"`
 	File        string `gorm:"type:varchar(200) comment '上传的文件'"`
+	Order       int32  `gorm:"type:int;index;comment:关联订单ID"`
+	Refund      int32  `gorm:"type:int;comment:关联售后单ID"`
 }
 
+// MessageTypeAfterSale 售后留言，创建时同时在订单服务创建售后单
+const MessageTypeAfterSale int32 = 4
+
+// RefundTypeRefundOnly 仅退款，售后留言未指定售后类型时的默认值，与订单服务的售后类型一致
+const RefundTypeRefundOnly = "REFUND_ONLY"
+
 func (LeavingMessage) TableName() string {
 	return "leavingmessages"
-}
\ No newline at end of file
+}
+
+// 评价审核状态
+const (
+	ReviewStatusPending  int32 = 1 // 待审核
+	ReviewStatusApproved int32 = 2 // 审核通过
+	ReviewStatusRejected int32 = 3 // 审核拒绝
+)
+
+// GormList 字符串列表，以JSON格式存储
+type GormList []string
+
+// Value 实现 driver.Valuer 接口
+func (g GormList) Value() (driver.Value, error) {
+	if len(g) == 0 {
+		return "[]", nil
+	}
+	return json.Marshal(g)
+}
+
+// Scan 实现 sql.Scanner 接口
+func (g *GormList) Scan(value interface{}) error {
+	if value == nil {
+		*g = GormList{}
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, g)
+}
+
+// GoodsReview 商品评价表，每个订单商品只能评价一次
+type GoodsReview struct {
+	BaseModel
+	User         int32    `gorm:"type:int;index"`
+	Goods        int32    `gorm:"type:int;index:idx_goods_status"`
+	Order        int32    `gorm:"type:int;index"`
+	OrderGoods   int32    `gorm:"type:int;uniqueIndex"`
+	Rating       int32    `gorm:"type:tinyint comment '评分1-5'"`
+	Content      string   `gorm:"type:text"`
+	Images       GormList `gorm:"type:json"`
+	Status       int32    `gorm:"type:tinyint comment '审核状态: 1(待审核),2(通过),3(拒绝)';index:idx_goods_status"`
+	RejectReason string   `gorm:"type:varchar(200)"`
+	Reply        string   `gorm:"type:text comment '商家回复'"`
+	ReplyTime    *time.Time
+}
+
+func (GoodsReview) TableName() string {
+	return "goodsreview"
+}
+
+// GoodsRating 商品评分汇总表，只统计审核通过的评价
+type GoodsRating struct {
+	BaseModel
+	Goods       int32   `gorm:"type:int;uniqueIndex"`
+	RatingCount int32   `gorm:"type:int;not null;default:0"`
+	RatingSum   int64   `gorm:"type:bigint;not null;default:0"`
+	AvgRating   float32 `gorm:"type:decimal(3,2);not null;default:0"`
+}
+
+func (GoodsRating) TableName() string {
+	return "goodsrating"
+}
+
+// RefreshGoodsRating 按审核通过的评价重新计算商品评分汇总
+func RefreshGoodsRating(tx *gorm.DB, goodsId int32) error {
+	var agg struct {
+		Cnt   int32
+		Total int64
+	}
+	if err := tx.Model(&GoodsReview{}).
+		Select("COUNT(*) AS cnt, COALESCE(SUM(rating), 0) AS total").
+		Where("goods = ? AND status = ?", goodsId, ReviewStatusApproved).
+		Scan(&agg).Error; err != nil {
+		return err
+	}
+
+	rating := GoodsRating{Goods: goodsId, RatingCount: agg.Cnt, RatingSum: agg.Total}
+	if agg.Cnt > 0 {
+		rating.AvgRating = float32(math.Round(float64(agg.Total)/float64(agg.Cnt)*100) / 100)
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "goods"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rating_count", "rating_sum", "avg_rating", "updated_at"}),
+	}).Create(&rating).Error
+}
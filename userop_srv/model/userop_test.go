@@ -81,7 +81,7 @@ func setupTestDB(t *testing.T) {
 	global.DB = db
 
 	// 自动迁移用户操作相关表结构
-	if err := db.AutoMigrate(&UserFav{}, &Address{}, &LeavingMessage{}); err != nil {
+	if err := db.AutoMigrate(&UserFav{}, &Address{}, &LeavingMessage{}, &GoodsReview{}, &GoodsRating{}); err != nil {
 		t.Fatalf("自动迁移表结构失败: %v", err)
 	}
 }
@@ -90,6 +90,8 @@ func cleanTestData() {
 	global.DB.Exec("DELETE FROM userfav")
 	global.DB.Exec("DELETE FROM address")
 	global.DB.Exec("DELETE FROM leavingmessages")
+	global.DB.Exec("DELETE FROM goodsreview")
+	global.DB.Exec("DELETE FROM goodsrating")
 }
 
 // TestUserFavCRUD 测试用户收藏的CRUD操作
@@ -463,6 +465,34 @@ func TestLeavingMessageCRUD(t *testing.T) {
 	})
 }
 
+// TestRefreshGoodsRating 测试商品评分汇总只统计审核通过的评价
+func TestRefreshGoodsRating(t *testing.T) {
+	setupTestDB(t)
+	defer cleanTestData()
+
+	reviews := []GoodsReview{
+		{User: 1, Goods: 900, Order: 1, OrderGoods: 9001, Rating: 5, Status: ReviewStatusApproved},
+		{User: 2, Goods: 900, Order: 2, OrderGoods: 9002, Rating: 4, Status: ReviewStatusApproved},
+		{User: 3, Goods: 900, Order: 3, OrderGoods: 9003, Rating: 1, Status: ReviewStatusPending},
+		{User: 4, Goods: 900, Order: 4, OrderGoods: 9004, Rating: 1, Status: ReviewStatusRejected},
+	}
+	assert.NoError(t, global.DB.Create(&reviews).Error)
+
+	assert.NoError(t, RefreshGoodsRating(global.DB, 900))
+	var rating GoodsRating
+	assert.NoError(t, global.DB.Where("goods = ?", 900).First(&rating).Error)
+	assert.Equal(t, int32(2), rating.RatingCount)
+	assert.Equal(t, int64(9), rating.RatingSum)
+	assert.InDelta(t, 4.5, rating.AvgRating, 0.001)
+
+	// 再次计算时更新已有汇总
+	assert.NoError(t, global.DB.Model(&reviews[1]).Update("status", ReviewStatusRejected).Error)
+	assert.NoError(t, RefreshGoodsRating(global.DB, 900))
+	assert.NoError(t, global.DB.Where("goods = ?", 900).First(&rating).Error)
+	assert.Equal(t, int32(1), rating.RatingCount)
+	assert.InDelta(t, 5.0, rating.AvgRating, 0.001)
+}
+
 // TestTableNames 测试表名设置
 func TestTableNames(t *testing.T) {
 	assert.Equal(t, "userfav", UserFav{}.TableName())
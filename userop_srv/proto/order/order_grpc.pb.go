@@ -0,0 +1,1810 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: order.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OrderService_CartItemList_FullMethodName         = "/OrderService/CartItemList"
+	OrderService_CartItemAdd_FullMethodName          = "/OrderService/CartItemAdd"
+	OrderService_CartItemUpdate_FullMethodName       = "/OrderService/CartItemUpdate"
+	OrderService_CartItemDelete_FullMethodName       = "/OrderService/CartItemDelete"
+	OrderService_CartItemRefresh_FullMethodName      = "/OrderService/CartItemRefresh"
+	OrderService_CartItemBatchCheck_FullMethodName   = "/OrderService/CartItemBatchCheck"
+	OrderService_CartItemBatchDelete_FullMethodName  = "/OrderService/CartItemBatchDelete"
+	OrderService_CartItemBatchUpdate_FullMethodName  = "/OrderService/CartItemBatchUpdate"
+	OrderService_GuestCartList_FullMethodName        = "/OrderService/GuestCartList"
+	OrderService_GuestCartAdd_FullMethodName         = "/OrderService/GuestCartAdd"
+	OrderService_GuestCartUpdate_FullMethodName      = "/OrderService/GuestCartUpdate"
+	OrderService_GuestCartDelete_FullMethodName      = "/OrderService/GuestCartDelete"
+	OrderService_MergeCart_FullMethodName            = "/OrderService/MergeCart"
+	OrderService_OrderCreate_FullMethodName          = "/OrderService/OrderCreate"
+	OrderService_OrderList_FullMethodName            = "/OrderService/OrderList"
+	OrderService_OrderDetail_FullMethodName          = "/OrderService/OrderDetail"
+	OrderService_OrderUpdate_FullMethodName          = "/OrderService/OrderUpdate"
+	OrderService_OrderDelete_FullMethodName          = "/OrderService/OrderDelete"
+	OrderService_OrderTimeline_FullMethodName        = "/OrderService/OrderTimeline"
+	OrderService_OrderPreview_FullMethodName         = "/OrderService/OrderPreview"
+	OrderService_OrderSearch_FullMethodName          = "/OrderService/OrderSearch"
+	OrderService_OrderExport_FullMethodName          = "/OrderService/OrderExport"
+	OrderService_JobLeader_FullMethodName            = "/OrderService/JobLeader"
+	OrderService_OutboxDeadLetterList_FullMethodName = "/OrderService/OutboxDeadLetterList"
+	OrderService_OutboxReplay_FullMethodName         = "/OrderService/OutboxReplay"
+	OrderService_CouponTemplateCreate_FullMethodName = "/OrderService/CouponTemplateCreate"
+	OrderService_CouponTemplateList_FullMethodName   = "/OrderService/CouponTemplateList"
+	OrderService_CouponIssue_FullMethodName          = "/OrderService/CouponIssue"
+	OrderService_UserCouponList_FullMethodName       = "/OrderService/UserCouponList"
+	OrderService_PromotionCreate_FullMethodName      = "/OrderService/PromotionCreate"
+	OrderService_PromotionList_FullMethodName        = "/OrderService/PromotionList"
+	OrderService_PriceCalculate_FullMethodName       = "/OrderService/PriceCalculate"
+	OrderService_PaymentCreate_FullMethodName        = "/OrderService/PaymentCreate"
+	OrderService_PaymentNotify_FullMethodName        = "/OrderService/PaymentNotify"
+	OrderService_RefundCreate_FullMethodName         = "/OrderService/RefundCreate"
+	OrderService_RefundAudit_FullMethodName          = "/OrderService/RefundAudit"
+	OrderService_RefundConfirmReturn_FullMethodName  = "/OrderService/RefundConfirmReturn"
+	OrderService_RefundCancel_FullMethodName         = "/OrderService/RefundCancel"
+	OrderService_RefundList_FullMethodName           = "/OrderService/RefundList"
+	OrderService_OrderShip_FullMethodName            = "/OrderService/OrderShip"
+	OrderService_ShipmentTrackAdd_FullMethodName     = "/OrderService/ShipmentTrackAdd"
+	OrderService_OrderShipmentList_FullMethodName    = "/OrderService/OrderShipmentList"
+	OrderService_OrderConfirmReceipt_FullMethodName  = "/OrderService/OrderConfirmReceipt"
+	OrderService_SalesStats_FullMethodName           = "/OrderService/SalesStats"
+	OrderService_TopGoods_FullMethodName             = "/OrderService/TopGoods"
+)
+
+// OrderServiceClient is the client API for OrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrderServiceClient interface {
+	// 购物车
+	CartItemList(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*CartItemListResponse, error)
+	CartItemAdd(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*ShopCartInfoResponse, error)
+	CartItemUpdate(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CartItemDelete(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CartItemRefresh(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*CartRefreshResponse, error)
+	CartItemBatchCheck(ctx context.Context, in *CartBatchCheckRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error)
+	CartItemBatchDelete(ctx context.Context, in *CartBatchRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error)
+	CartItemBatchUpdate(ctx context.Context, in *CartBatchUpdateRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error)
+	GuestCartList(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GuestCartResponse, error)
+	GuestCartAdd(ctx context.Context, in *GuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error)
+	GuestCartUpdate(ctx context.Context, in *GuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error)
+	GuestCartDelete(ctx context.Context, in *GuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error)
+	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error)
+	// 订单
+	OrderCreate(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoResponse, error)
+	OrderList(ctx context.Context, in *OrderFilterRequest, opts ...grpc.CallOption) (*OrderListResponse, error)
+	OrderDetail(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoDetailResponse, error)
+	OrderUpdate(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	OrderDelete(ctx context.Context, in *OrderDelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	OrderTimeline(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderTimelineResponse, error)
+	OrderPreview(ctx context.Context, in *OrderPreviewRequest, opts ...grpc.CallOption) (*OrderPreviewResponse, error)
+	OrderSearch(ctx context.Context, in *OrderSearchRequest, opts ...grpc.CallOption) (*OrderSearchResponse, error)
+	OrderExport(ctx context.Context, in *OrderSearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderInfoDetailResponse], error)
+	JobLeader(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JobLeaderResponse, error)
+	OutboxDeadLetterList(ctx context.Context, in *OutboxFilterRequest, opts ...grpc.CallOption) (*OutboxDeadLetterListResponse, error)
+	OutboxReplay(ctx context.Context, in *OutboxReplayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CouponTemplateCreate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateInfo, error)
+	CouponTemplateList(ctx context.Context, in *PromotionFilterRequest, opts ...grpc.CallOption) (*CouponTemplateListResponse, error)
+	CouponIssue(ctx context.Context, in *CouponIssueRequest, opts ...grpc.CallOption) (*UserCouponInfo, error)
+	UserCouponList(ctx context.Context, in *UserCouponFilterRequest, opts ...grpc.CallOption) (*UserCouponListResponse, error)
+	PromotionCreate(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*PromotionInfo, error)
+	PromotionList(ctx context.Context, in *PromotionFilterRequest, opts ...grpc.CallOption) (*PromotionListResponse, error)
+	PriceCalculate(ctx context.Context, in *PriceCalculateRequest, opts ...grpc.CallOption) (*PriceCalculateResponse, error)
+	// 支付
+	PaymentCreate(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	PaymentNotify(ctx context.Context, in *PaymentNotifyRequest, opts ...grpc.CallOption) (*PaymentNotifyResponse, error)
+	// 售后
+	RefundCreate(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*RefundInfoResponse, error)
+	RefundAudit(ctx context.Context, in *RefundAuditRequest, opts ...grpc.CallOption) (*RefundInfoResponse, error)
+	RefundConfirmReturn(ctx context.Context, in *RefundOperateRequest, opts ...grpc.CallOption) (*RefundInfoResponse, error)
+	RefundCancel(ctx context.Context, in *RefundOperateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RefundList(ctx context.Context, in *RefundFilterRequest, opts ...grpc.CallOption) (*RefundListResponse, error)
+	// 物流
+	OrderShip(ctx context.Context, in *OrderShipRequest, opts ...grpc.CallOption) (*ShipmentInfo, error)
+	ShipmentTrackAdd(ctx context.Context, in *ShipmentTrackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	OrderShipmentList(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*ShipmentListResponse, error)
+	OrderConfirmReceipt(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 报表，数据来自定时刷新的汇总表
+	SalesStats(ctx context.Context, in *SalesStatsRequest, opts ...grpc.CallOption) (*SalesStatsResponse, error)
+	TopGoods(ctx context.Context, in *TopGoodsRequest, opts ...grpc.CallOption) (*TopGoodsResponse, error)
+}
+
+type orderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderServiceClient(cc grpc.ClientConnInterface) OrderServiceClient {
+	return &orderServiceClient{cc}
+}
+
+func (c *orderServiceClient) CartItemList(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*CartItemListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartItemListResponse)
+	err := c.cc.Invoke(ctx, OrderService_CartItemList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CartItemAdd(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*ShopCartInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShopCartInfoResponse)
+	err := c.cc.Invoke(ctx, OrderService_CartItemAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CartItemUpdate(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_CartItemUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CartItemDelete(ctx context.Context, in *CartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_CartItemDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CartItemRefresh(ctx context.Context, in *UserInfo, opts ...grpc.CallOption) (*CartRefreshResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartRefreshResponse)
+	err := c.cc.Invoke(ctx, OrderService_CartItemRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CartItemBatchCheck(ctx context.Context, in *CartBatchCheckRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartSummaryResponse)
+	err := c.cc.Invoke(ctx, OrderService_CartItemBatchCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CartItemBatchDelete(ctx context.Context, in *CartBatchRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartSummaryResponse)
+	err := c.cc.Invoke(ctx, OrderService_CartItemBatchDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CartItemBatchUpdate(ctx context.Context, in *CartBatchUpdateRequest, opts ...grpc.CallOption) (*CartSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartSummaryResponse)
+	err := c.cc.Invoke(ctx, OrderService_CartItemBatchUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GuestCartList(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*GuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_GuestCartList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GuestCartAdd(ctx context.Context, in *GuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_GuestCartAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GuestCartUpdate(ctx context.Context, in *GuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_GuestCartUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GuestCartDelete(ctx context.Context, in *GuestCartItemRequest, opts ...grpc.CallOption) (*GuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_GuestCartDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_MergeCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderCreate(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderInfoResponse)
+	err := c.cc.Invoke(ctx, OrderService_OrderCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderList(ctx context.Context, in *OrderFilterRequest, opts ...grpc.CallOption) (*OrderListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderListResponse)
+	err := c.cc.Invoke(ctx, OrderService_OrderList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderDetail(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderInfoDetailResponse)
+	err := c.cc.Invoke(ctx, OrderService_OrderDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderUpdate(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_OrderUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderDelete(ctx context.Context, in *OrderDelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_OrderDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderTimeline(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderTimelineResponse)
+	err := c.cc.Invoke(ctx, OrderService_OrderTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderPreview(ctx context.Context, in *OrderPreviewRequest, opts ...grpc.CallOption) (*OrderPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderPreviewResponse)
+	err := c.cc.Invoke(ctx, OrderService_OrderPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderSearch(ctx context.Context, in *OrderSearchRequest, opts ...grpc.CallOption) (*OrderSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderSearchResponse)
+	err := c.cc.Invoke(ctx, OrderService_OrderSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderExport(ctx context.Context, in *OrderSearchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderInfoDetailResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_OrderExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[OrderSearchRequest, OrderInfoDetailResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_OrderExportClient = grpc.ServerStreamingClient[OrderInfoDetailResponse]
+
+func (c *orderServiceClient) JobLeader(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JobLeaderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobLeaderResponse)
+	err := c.cc.Invoke(ctx, OrderService_JobLeader_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OutboxDeadLetterList(ctx context.Context, in *OutboxFilterRequest, opts ...grpc.CallOption) (*OutboxDeadLetterListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OutboxDeadLetterListResponse)
+	err := c.cc.Invoke(ctx, OrderService_OutboxDeadLetterList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OutboxReplay(ctx context.Context, in *OutboxReplayRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_OutboxReplay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CouponTemplateCreate(ctx context.Context, in *CouponTemplateRequest, opts ...grpc.CallOption) (*CouponTemplateInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponTemplateInfo)
+	err := c.cc.Invoke(ctx, OrderService_CouponTemplateCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CouponTemplateList(ctx context.Context, in *PromotionFilterRequest, opts ...grpc.CallOption) (*CouponTemplateListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CouponTemplateListResponse)
+	err := c.cc.Invoke(ctx, OrderService_CouponTemplateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CouponIssue(ctx context.Context, in *CouponIssueRequest, opts ...grpc.CallOption) (*UserCouponInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCouponInfo)
+	err := c.cc.Invoke(ctx, OrderService_CouponIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UserCouponList(ctx context.Context, in *UserCouponFilterRequest, opts ...grpc.CallOption) (*UserCouponListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCouponListResponse)
+	err := c.cc.Invoke(ctx, OrderService_UserCouponList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PromotionCreate(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*PromotionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionInfo)
+	err := c.cc.Invoke(ctx, OrderService_PromotionCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PromotionList(ctx context.Context, in *PromotionFilterRequest, opts ...grpc.CallOption) (*PromotionListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionListResponse)
+	err := c.cc.Invoke(ctx, OrderService_PromotionList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PriceCalculate(ctx context.Context, in *PriceCalculateRequest, opts ...grpc.CallOption) (*PriceCalculateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceCalculateResponse)
+	err := c.cc.Invoke(ctx, OrderService_PriceCalculate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PaymentCreate(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrderService_PaymentCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PaymentNotify(ctx context.Context, in *PaymentNotifyRequest, opts ...grpc.CallOption) (*PaymentNotifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentNotifyResponse)
+	err := c.cc.Invoke(ctx, OrderService_PaymentNotify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundCreate(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*RefundInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundInfoResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundAudit(ctx context.Context, in *RefundAuditRequest, opts ...grpc.CallOption) (*RefundInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundInfoResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundConfirmReturn(ctx context.Context, in *RefundOperateRequest, opts ...grpc.CallOption) (*RefundInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundInfoResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundConfirmReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundCancel(ctx context.Context, in *RefundOperateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_RefundCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundList(ctx context.Context, in *RefundFilterRequest, opts ...grpc.CallOption) (*RefundListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundListResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderShip(ctx context.Context, in *OrderShipRequest, opts ...grpc.CallOption) (*ShipmentInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentInfo)
+	err := c.cc.Invoke(ctx, OrderService_OrderShip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ShipmentTrackAdd(ctx context.Context, in *ShipmentTrackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_ShipmentTrackAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderShipmentList(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*ShipmentListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentListResponse)
+	err := c.cc.Invoke(ctx, OrderService_OrderShipmentList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OrderConfirmReceipt(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_OrderConfirmReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SalesStats(ctx context.Context, in *SalesStatsRequest, opts ...grpc.CallOption) (*SalesStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SalesStatsResponse)
+	err := c.cc.Invoke(ctx, OrderService_SalesStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) TopGoods(ctx context.Context, in *TopGoodsRequest, opts ...grpc.CallOption) (*TopGoodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopGoodsResponse)
+	err := c.cc.Invoke(ctx, OrderService_TopGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderServiceServer is the server API for OrderService service.
+// All implementations must embed UnimplementedOrderServiceServer
+// for forward compatibility.
+type OrderServiceServer interface {
+	// 购物车
+	CartItemList(context.Context, *UserInfo) (*CartItemListResponse, error)
+	CartItemAdd(context.Context, *CartItemRequest) (*ShopCartInfoResponse, error)
+	CartItemUpdate(context.Context, *CartItemRequest) (*emptypb.Empty, error)
+	CartItemDelete(context.Context, *CartItemRequest) (*emptypb.Empty, error)
+	CartItemRefresh(context.Context, *UserInfo) (*CartRefreshResponse, error)
+	CartItemBatchCheck(context.Context, *CartBatchCheckRequest) (*CartSummaryResponse, error)
+	CartItemBatchDelete(context.Context, *CartBatchRequest) (*CartSummaryResponse, error)
+	CartItemBatchUpdate(context.Context, *CartBatchUpdateRequest) (*CartSummaryResponse, error)
+	GuestCartList(context.Context, *GuestCartRequest) (*GuestCartResponse, error)
+	GuestCartAdd(context.Context, *GuestCartItemRequest) (*GuestCartResponse, error)
+	GuestCartUpdate(context.Context, *GuestCartItemRequest) (*GuestCartResponse, error)
+	GuestCartDelete(context.Context, *GuestCartItemRequest) (*GuestCartResponse, error)
+	MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error)
+	// 订单
+	OrderCreate(context.Context, *OrderRequest) (*OrderInfoResponse, error)
+	OrderList(context.Context, *OrderFilterRequest) (*OrderListResponse, error)
+	OrderDetail(context.Context, *OrderRequest) (*OrderInfoDetailResponse, error)
+	OrderUpdate(context.Context, *OrderStatus) (*emptypb.Empty, error)
+	OrderDelete(context.Context, *OrderDelRequest) (*emptypb.Empty, error)
+	OrderTimeline(context.Context, *OrderRequest) (*OrderTimelineResponse, error)
+	OrderPreview(context.Context, *OrderPreviewRequest) (*OrderPreviewResponse, error)
+	OrderSearch(context.Context, *OrderSearchRequest) (*OrderSearchResponse, error)
+	OrderExport(*OrderSearchRequest, grpc.ServerStreamingServer[OrderInfoDetailResponse]) error
+	JobLeader(context.Context, *emptypb.Empty) (*JobLeaderResponse, error)
+	OutboxDeadLetterList(context.Context, *OutboxFilterRequest) (*OutboxDeadLetterListResponse, error)
+	OutboxReplay(context.Context, *OutboxReplayRequest) (*emptypb.Empty, error)
+	CouponTemplateCreate(context.Context, *CouponTemplateRequest) (*CouponTemplateInfo, error)
+	CouponTemplateList(context.Context, *PromotionFilterRequest) (*CouponTemplateListResponse, error)
+	CouponIssue(context.Context, *CouponIssueRequest) (*UserCouponInfo, error)
+	UserCouponList(context.Context, *UserCouponFilterRequest) (*UserCouponListResponse, error)
+	PromotionCreate(context.Context, *PromotionRequest) (*PromotionInfo, error)
+	PromotionList(context.Context, *PromotionFilterRequest) (*PromotionListResponse, error)
+	PriceCalculate(context.Context, *PriceCalculateRequest) (*PriceCalculateResponse, error)
+	// 支付
+	PaymentCreate(context.Context, *PaymentRequest) (*PaymentResponse, error)
+	PaymentNotify(context.Context, *PaymentNotifyRequest) (*PaymentNotifyResponse, error)
+	// 售后
+	RefundCreate(context.Context, *RefundRequest) (*RefundInfoResponse, error)
+	RefundAudit(context.Context, *RefundAuditRequest) (*RefundInfoResponse, error)
+	RefundConfirmReturn(context.Context, *RefundOperateRequest) (*RefundInfoResponse, error)
+	RefundCancel(context.Context, *RefundOperateRequest) (*emptypb.Empty, error)
+	RefundList(context.Context, *RefundFilterRequest) (*RefundListResponse, error)
+	// 物流
+	OrderShip(context.Context, *OrderShipRequest) (*ShipmentInfo, error)
+	ShipmentTrackAdd(context.Context, *ShipmentTrackRequest) (*emptypb.Empty, error)
+	OrderShipmentList(context.Context, *OrderRequest) (*ShipmentListResponse, error)
+	OrderConfirmReceipt(context.Context, *OrderRequest) (*emptypb.Empty, error)
+	// 报表，数据来自定时刷新的汇总表
+	SalesStats(context.Context, *SalesStatsRequest) (*SalesStatsResponse, error)
+	TopGoods(context.Context, *TopGoodsRequest) (*TopGoodsResponse, error)
+	mustEmbedUnimplementedOrderServiceServer()
+}
+
+// UnimplementedOrderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrderServiceServer struct{}
+
+func (UnimplementedOrderServiceServer) CartItemList(context.Context, *UserInfo) (*CartItemListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemList not implemented")
+}
+func (UnimplementedOrderServiceServer) CartItemAdd(context.Context, *CartItemRequest) (*ShopCartInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemAdd not implemented")
+}
+func (UnimplementedOrderServiceServer) CartItemUpdate(context.Context, *CartItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemUpdate not implemented")
+}
+func (UnimplementedOrderServiceServer) CartItemDelete(context.Context, *CartItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemDelete not implemented")
+}
+func (UnimplementedOrderServiceServer) CartItemRefresh(context.Context, *UserInfo) (*CartRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemRefresh not implemented")
+}
+func (UnimplementedOrderServiceServer) CartItemBatchCheck(context.Context, *CartBatchCheckRequest) (*CartSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemBatchCheck not implemented")
+}
+func (UnimplementedOrderServiceServer) CartItemBatchDelete(context.Context, *CartBatchRequest) (*CartSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemBatchDelete not implemented")
+}
+func (UnimplementedOrderServiceServer) CartItemBatchUpdate(context.Context, *CartBatchUpdateRequest) (*CartSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CartItemBatchUpdate not implemented")
+}
+func (UnimplementedOrderServiceServer) GuestCartList(context.Context, *GuestCartRequest) (*GuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestCartList not implemented")
+}
+func (UnimplementedOrderServiceServer) GuestCartAdd(context.Context, *GuestCartItemRequest) (*GuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestCartAdd not implemented")
+}
+func (UnimplementedOrderServiceServer) GuestCartUpdate(context.Context, *GuestCartItemRequest) (*GuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestCartUpdate not implemented")
+}
+func (UnimplementedOrderServiceServer) GuestCartDelete(context.Context, *GuestCartItemRequest) (*GuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestCartDelete not implemented")
+}
+func (UnimplementedOrderServiceServer) MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCart not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderCreate(context.Context, *OrderRequest) (*OrderInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderCreate not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderList(context.Context, *OrderFilterRequest) (*OrderListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderList not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderDetail(context.Context, *OrderRequest) (*OrderInfoDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderDetail not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderUpdate(context.Context, *OrderStatus) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderUpdate not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderDelete(context.Context, *OrderDelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderDelete not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderTimeline(context.Context, *OrderRequest) (*OrderTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderTimeline not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderPreview(context.Context, *OrderPreviewRequest) (*OrderPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderPreview not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderSearch(context.Context, *OrderSearchRequest) (*OrderSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderSearch not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderExport(*OrderSearchRequest, grpc.ServerStreamingServer[OrderInfoDetailResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method OrderExport not implemented")
+}
+func (UnimplementedOrderServiceServer) JobLeader(context.Context, *emptypb.Empty) (*JobLeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobLeader not implemented")
+}
+func (UnimplementedOrderServiceServer) OutboxDeadLetterList(context.Context, *OutboxFilterRequest) (*OutboxDeadLetterListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboxDeadLetterList not implemented")
+}
+func (UnimplementedOrderServiceServer) OutboxReplay(context.Context, *OutboxReplayRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboxReplay not implemented")
+}
+func (UnimplementedOrderServiceServer) CouponTemplateCreate(context.Context, *CouponTemplateRequest) (*CouponTemplateInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CouponTemplateCreate not implemented")
+}
+func (UnimplementedOrderServiceServer) CouponTemplateList(context.Context, *PromotionFilterRequest) (*CouponTemplateListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CouponTemplateList not implemented")
+}
+func (UnimplementedOrderServiceServer) CouponIssue(context.Context, *CouponIssueRequest) (*UserCouponInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CouponIssue not implemented")
+}
+func (UnimplementedOrderServiceServer) UserCouponList(context.Context, *UserCouponFilterRequest) (*UserCouponListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCouponList not implemented")
+}
+func (UnimplementedOrderServiceServer) PromotionCreate(context.Context, *PromotionRequest) (*PromotionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromotionCreate not implemented")
+}
+func (UnimplementedOrderServiceServer) PromotionList(context.Context, *PromotionFilterRequest) (*PromotionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromotionList not implemented")
+}
+func (UnimplementedOrderServiceServer) PriceCalculate(context.Context, *PriceCalculateRequest) (*PriceCalculateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceCalculate not implemented")
+}
+func (UnimplementedOrderServiceServer) PaymentCreate(context.Context, *PaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentCreate not implemented")
+}
+func (UnimplementedOrderServiceServer) PaymentNotify(context.Context, *PaymentNotifyRequest) (*PaymentNotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentNotify not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundCreate(context.Context, *RefundRequest) (*RefundInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundCreate not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundAudit(context.Context, *RefundAuditRequest) (*RefundInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundAudit not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundConfirmReturn(context.Context, *RefundOperateRequest) (*RefundInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundConfirmReturn not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundCancel(context.Context, *RefundOperateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundCancel not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundList(context.Context, *RefundFilterRequest) (*RefundListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundList not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderShip(context.Context, *OrderShipRequest) (*ShipmentInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderShip not implemented")
+}
+func (UnimplementedOrderServiceServer) ShipmentTrackAdd(context.Context, *ShipmentTrackRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipmentTrackAdd not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderShipmentList(context.Context, *OrderRequest) (*ShipmentListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderShipmentList not implemented")
+}
+func (UnimplementedOrderServiceServer) OrderConfirmReceipt(context.Context, *OrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderConfirmReceipt not implemented")
+}
+func (UnimplementedOrderServiceServer) SalesStats(context.Context, *SalesStatsRequest) (*SalesStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SalesStats not implemented")
+}
+func (UnimplementedOrderServiceServer) TopGoods(context.Context, *TopGoodsRequest) (*TopGoodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopGoods not implemented")
+}
+func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
+func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderServiceServer will
+// result in compilation errors.
+type UnsafeOrderServiceServer interface {
+	mustEmbedUnimplementedOrderServiceServer()
+}
+
+func RegisterOrderServiceServer(s grpc.ServiceRegistrar, srv OrderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOrderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrderService_ServiceDesc, srv)
+}
+
+func _OrderService_CartItemList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemList(ctx, req.(*UserInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CartItemAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemAdd(ctx, req.(*CartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CartItemUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemUpdate(ctx, req.(*CartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CartItemDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemDelete(ctx, req.(*CartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CartItemRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemRefresh(ctx, req.(*UserInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CartItemBatchCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartBatchCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemBatchCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemBatchCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemBatchCheck(ctx, req.(*CartBatchCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CartItemBatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemBatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemBatchDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemBatchDelete(ctx, req.(*CartBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CartItemBatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartBatchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CartItemBatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CartItemBatchUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CartItemBatchUpdate(ctx, req.(*CartBatchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GuestCartList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GuestCartList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GuestCartList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GuestCartList(ctx, req.(*GuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GuestCartAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GuestCartAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GuestCartAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GuestCartAdd(ctx, req.(*GuestCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GuestCartUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GuestCartUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GuestCartUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GuestCartUpdate(ctx, req.(*GuestCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GuestCartDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GuestCartDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GuestCartDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GuestCartDelete(ctx, req.(*GuestCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_MergeCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).MergeCart(ctx, req.(*MergeCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderCreate(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderList(ctx, req.(*OrderFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderDetail(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderUpdate(ctx, req.(*OrderStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderDelete(ctx, req.(*OrderDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderTimeline(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderPreview(ctx, req.(*OrderPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderSearch(ctx, req.(*OrderSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OrderSearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).OrderExport(m, &grpc.GenericServerStream[OrderSearchRequest, OrderInfoDetailResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_OrderExportServer = grpc.ServerStreamingServer[OrderInfoDetailResponse]
+
+func _OrderService_JobLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).JobLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_JobLeader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).JobLeader(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OutboxDeadLetterList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutboxFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OutboxDeadLetterList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OutboxDeadLetterList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OutboxDeadLetterList(ctx, req.(*OutboxFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OutboxReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutboxReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OutboxReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OutboxReplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OutboxReplay(ctx, req.(*OutboxReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CouponTemplateCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CouponTemplateCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CouponTemplateCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CouponTemplateCreate(ctx, req.(*CouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CouponTemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CouponTemplateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CouponTemplateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CouponTemplateList(ctx, req.(*PromotionFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CouponIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CouponIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CouponIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CouponIssue(ctx, req.(*CouponIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UserCouponList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCouponFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UserCouponList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UserCouponList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UserCouponList(ctx, req.(*UserCouponFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PromotionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PromotionCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PromotionCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PromotionCreate(ctx, req.(*PromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PromotionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PromotionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PromotionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PromotionList(ctx, req.(*PromotionFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PriceCalculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceCalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PriceCalculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PriceCalculate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PriceCalculate(ctx, req.(*PriceCalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PaymentCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PaymentCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PaymentCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PaymentCreate(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_PaymentNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentNotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).PaymentNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_PaymentNotify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).PaymentNotify(ctx, req.(*PaymentNotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundCreate(ctx, req.(*RefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundAudit(ctx, req.(*RefundAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundConfirmReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOperateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundConfirmReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundConfirmReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundConfirmReturn(ctx, req.(*RefundOperateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOperateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundCancel(ctx, req.(*RefundOperateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundList(ctx, req.(*RefundFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderShip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderShipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderShip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderShip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderShip(ctx, req.(*OrderShipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ShipmentTrackAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipmentTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ShipmentTrackAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ShipmentTrackAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ShipmentTrackAdd(ctx, req.(*ShipmentTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderShipmentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderShipmentList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderShipmentList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderShipmentList(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OrderConfirmReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OrderConfirmReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OrderConfirmReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OrderConfirmReceipt(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SalesStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SalesStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SalesStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SalesStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SalesStats(ctx, req.(*SalesStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_TopGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).TopGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_TopGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).TopGoods(ctx, req.(*TopGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "OrderService",
+	HandlerType: (*OrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CartItemList",
+			Handler:    _OrderService_CartItemList_Handler,
+		},
+		{
+			MethodName: "CartItemAdd",
+			Handler:    _OrderService_CartItemAdd_Handler,
+		},
+		{
+			MethodName: "CartItemUpdate",
+			Handler:    _OrderService_CartItemUpdate_Handler,
+		},
+		{
+			MethodName: "CartItemDelete",
+			Handler:    _OrderService_CartItemDelete_Handler,
+		},
+		{
+			MethodName: "CartItemRefresh",
+			Handler:    _OrderService_CartItemRefresh_Handler,
+		},
+		{
+			MethodName: "CartItemBatchCheck",
+			Handler:    _OrderService_CartItemBatchCheck_Handler,
+		},
+		{
+			MethodName: "CartItemBatchDelete",
+			Handler:    _OrderService_CartItemBatchDelete_Handler,
+		},
+		{
+			MethodName: "CartItemBatchUpdate",
+			Handler:    _OrderService_CartItemBatchUpdate_Handler,
+		},
+		{
+			MethodName: "GuestCartList",
+			Handler:    _OrderService_GuestCartList_Handler,
+		},
+		{
+			MethodName: "GuestCartAdd",
+			Handler:    _OrderService_GuestCartAdd_Handler,
+		},
+		{
+			MethodName: "GuestCartUpdate",
+			Handler:    _OrderService_GuestCartUpdate_Handler,
+		},
+		{
+			MethodName: "GuestCartDelete",
+			Handler:    _OrderService_GuestCartDelete_Handler,
+		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _OrderService_MergeCart_Handler,
+		},
+		{
+			MethodName: "OrderCreate",
+			Handler:    _OrderService_OrderCreate_Handler,
+		},
+		{
+			MethodName: "OrderList",
+			Handler:    _OrderService_OrderList_Handler,
+		},
+		{
+			MethodName: "OrderDetail",
+			Handler:    _OrderService_OrderDetail_Handler,
+		},
+		{
+			MethodName: "OrderUpdate",
+			Handler:    _OrderService_OrderUpdate_Handler,
+		},
+		{
+			MethodName: "OrderDelete",
+			Handler:    _OrderService_OrderDelete_Handler,
+		},
+		{
+			MethodName: "OrderTimeline",
+			Handler:    _OrderService_OrderTimeline_Handler,
+		},
+		{
+			MethodName: "OrderPreview",
+			Handler:    _OrderService_OrderPreview_Handler,
+		},
+		{
+			MethodName: "OrderSearch",
+			Handler:    _OrderService_OrderSearch_Handler,
+		},
+		{
+			MethodName: "JobLeader",
+			Handler:    _OrderService_JobLeader_Handler,
+		},
+		{
+			MethodName: "OutboxDeadLetterList",
+			Handler:    _OrderService_OutboxDeadLetterList_Handler,
+		},
+		{
+			MethodName: "OutboxReplay",
+			Handler:    _OrderService_OutboxReplay_Handler,
+		},
+		{
+			MethodName: "CouponTemplateCreate",
+			Handler:    _OrderService_CouponTemplateCreate_Handler,
+		},
+		{
+			MethodName: "CouponTemplateList",
+			Handler:    _OrderService_CouponTemplateList_Handler,
+		},
+		{
+			MethodName: "CouponIssue",
+			Handler:    _OrderService_CouponIssue_Handler,
+		},
+		{
+			MethodName: "UserCouponList",
+			Handler:    _OrderService_UserCouponList_Handler,
+		},
+		{
+			MethodName: "PromotionCreate",
+			Handler:    _OrderService_PromotionCreate_Handler,
+		},
+		{
+			MethodName: "PromotionList",
+			Handler:    _OrderService_PromotionList_Handler,
+		},
+		{
+			MethodName: "PriceCalculate",
+			Handler:    _OrderService_PriceCalculate_Handler,
+		},
+		{
+			MethodName: "PaymentCreate",
+			Handler:    _OrderService_PaymentCreate_Handler,
+		},
+		{
+			MethodName: "PaymentNotify",
+			Handler:    _OrderService_PaymentNotify_Handler,
+		},
+		{
+			MethodName: "RefundCreate",
+			Handler:    _OrderService_RefundCreate_Handler,
+		},
+		{
+			MethodName: "RefundAudit",
+			Handler:    _OrderService_RefundAudit_Handler,
+		},
+		{
+			MethodName: "RefundConfirmReturn",
+			Handler:    _OrderService_RefundConfirmReturn_Handler,
+		},
+		{
+			MethodName: "RefundCancel",
+			Handler:    _OrderService_RefundCancel_Handler,
+		},
+		{
+			MethodName: "RefundList",
+			Handler:    _OrderService_RefundList_Handler,
+		},
+		{
+			MethodName: "OrderShip",
+			Handler:    _OrderService_OrderShip_Handler,
+		},
+		{
+			MethodName: "ShipmentTrackAdd",
+			Handler:    _OrderService_ShipmentTrackAdd_Handler,
+		},
+		{
+			MethodName: "OrderShipmentList",
+			Handler:    _OrderService_OrderShipmentList_Handler,
+		},
+		{
+			MethodName: "OrderConfirmReceipt",
+			Handler:    _OrderService_OrderConfirmReceipt_Handler,
+		},
+		{
+			MethodName: "SalesStats",
+			Handler:    _OrderService_SalesStats_Handler,
+		},
+		{
+			MethodName: "TopGoods",
+			Handler:    _OrderService_TopGoods_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "OrderExport",
+			Handler:       _OrderService_OrderExport_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "order.proto",
+}
@@ -31,6 +31,11 @@ const (
 	UserOp_DeleteAddress_FullMethodName    = "/userop.UserOp/DeleteAddress"
 	UserOp_CreateMessage_FullMethodName    = "/userop.UserOp/CreateMessage"
 	UserOp_MessageList_FullMethodName      = "/userop.UserOp/MessageList"
+	UserOp_CreateReview_FullMethodName     = "/userop.UserOp/CreateReview"
+	UserOp_ReviewList_FullMethodName       = "/userop.UserOp/ReviewList"
+	UserOp_ModerateReview_FullMethodName   = "/userop.UserOp/ModerateReview"
+	UserOp_ReplyReview_FullMethodName      = "/userop.UserOp/ReplyReview"
+	UserOp_GetGoodsRatings_FullMethodName  = "/userop.UserOp/GetGoodsRatings"
 )
 
 // UserOpClient is the client API for UserOp service.
@@ -51,6 +56,12 @@ type UserOpClient interface {
 	// 留言相关
 	CreateMessage(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	MessageList(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*MessageListResponse, error)
+	// 商品评价相关
+	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	ReviewList(ctx context.Context, in *ReviewFilterRequest, opts ...grpc.CallOption) (*ReviewListResponse, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetGoodsRatings(ctx context.Context, in *GoodsRatingRequest, opts ...grpc.CallOption) (*GoodsRatingListResponse, error)
 }
 
 type userOpClient struct {
@@ -171,6 +182,56 @@ func (c *userOpClient) MessageList(ctx context.Context, in *MessageRequest, opts
 	return out, nil
 }
 
+func (c *userOpClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, UserOp_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) ReviewList(ctx context.Context, in *ReviewFilterRequest, opts ...grpc.CallOption) (*ReviewListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewListResponse)
+	err := c.cc.Invoke(ctx, UserOp_ReviewList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) ReplyReview(ctx context.Context, in *ReplyReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserOp_ReplyReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userOpClient) GetGoodsRatings(ctx context.Context, in *GoodsRatingRequest, opts ...grpc.CallOption) (*GoodsRatingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsRatingListResponse)
+	err := c.cc.Invoke(ctx, UserOp_GetGoodsRatings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserOpServer is the server API for UserOp service.
 // All implementations must embed UnimplementedUserOpServer
 // for forward compatibility.
@@ -189,6 +250,12 @@ type UserOpServer interface {
 	// 留言相关
 	CreateMessage(context.Context, *MessageRequest) (*MessageResponse, error)
 	MessageList(context.Context, *MessageRequest) (*MessageListResponse, error)
+	// 商品评价相关
+	CreateReview(context.Context, *ReviewRequest) (*ReviewResponse, error)
+	ReviewList(context.Context, *ReviewFilterRequest) (*ReviewListResponse, error)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*emptypb.Empty, error)
+	ReplyReview(context.Context, *ReplyReviewRequest) (*emptypb.Empty, error)
+	GetGoodsRatings(context.Context, *GoodsRatingRequest) (*GoodsRatingListResponse, error)
 	mustEmbedUnimplementedUserOpServer()
 }
 
@@ -232,6 +299,21 @@ func (UnimplementedUserOpServer) CreateMessage(context.Context, *MessageRequest)
 func (UnimplementedUserOpServer) MessageList(context.Context, *MessageRequest) (*MessageListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageList not implemented")
 }
+func (UnimplementedUserOpServer) CreateReview(context.Context, *ReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedUserOpServer) ReviewList(context.Context, *ReviewFilterRequest) (*ReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewList not implemented")
+}
+func (UnimplementedUserOpServer) ModerateReview(context.Context, *ModerateReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedUserOpServer) ReplyReview(context.Context, *ReplyReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+}
+func (UnimplementedUserOpServer) GetGoodsRatings(context.Context, *GoodsRatingRequest) (*GoodsRatingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsRatings not implemented")
+}
 func (UnimplementedUserOpServer) mustEmbedUnimplementedUserOpServer() {}
 func (UnimplementedUserOpServer) testEmbeddedByValue()                {}
 
@@ -451,6 +533,96 @@ func _UserOp_MessageList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserOp_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).CreateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_ReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).ReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_ReviewList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).ReviewList(ctx, req.(*ReviewFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).ReplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_ReplyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).ReplyReview(ctx, req.(*ReplyReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserOp_GetGoodsRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserOpServer).GetGoodsRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserOp_GetGoodsRatings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserOpServer).GetGoodsRatings(ctx, req.(*GoodsRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserOp_ServiceDesc is the grpc.ServiceDesc for UserOp service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -502,6 +674,26 @@ var UserOp_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageList",
 			Handler:    _UserOp_MessageList_Handler,
 		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _UserOp_CreateReview_Handler,
+		},
+		{
+			MethodName: "ReviewList",
+			Handler:    _UserOp_ReviewList_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _UserOp_ModerateReview_Handler,
+		},
+		{
+			MethodName: "ReplyReview",
+			Handler:    _UserOp_ReplyReview_Handler,
+		},
+		{
+			MethodName: "GetGoodsRatings",
+			Handler:    _UserOp_GetGoodsRatings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "userop.proto",
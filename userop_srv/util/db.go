@@ -23,6 +23,8 @@ func SetupTestTables() error {
 		&model.UserFav{},
 		&model.Address{},
 		&model.LeavingMessage{},
+		&model.GoodsReview{},
+		&model.GoodsRating{},
 	)
 }
 
@@ -33,6 +35,8 @@ func CleanTestTables() error {
 		&model.UserFav{},
 		&model.Address{},
 		&model.LeavingMessage{},
+		&model.GoodsReview{},
+		&model.GoodsRating{},
 	}
 	for _, table := range tables {
 		if err := global.DB.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(table).Error; err != nil {
@@ -65,5 +69,7 @@ func DropAllTables() error {
 		&model.UserFav{},
 		&model.Address{},
 		&model.LeavingMessage{},
+		&model.GoodsReview{},
+		&model.GoodsRating{},
 	)
 }
@@ -0,0 +1,69 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"userop_srv/global"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// 依赖的服务不可用时重新查询Consul的最小间隔
+	serviceConnRetryInterval = 5 * time.Second
+	serviceLookupTimeout     = 2 * time.Second
+)
+
+var serviceConns = struct {
+	sync.Mutex
+	lastTry map[string]time.Time
+}{lastTry: make(map[string]time.Time)}
+
+// ServiceConn 返回依赖服务的连接，未连接时按间隔重新从Consul获取健康实例并连接，仍不可用时返回nil
+// 服务之间互相依赖，启动时依赖的服务可能还未注册，不能只在启动时查询一次。连接必须通过该函数读取
+func ServiceConn(conn **grpc.ClientConn, name string) *grpc.ClientConn {
+	serviceConns.Lock()
+	defer serviceConns.Unlock()
+	if *conn != nil {
+		return *conn
+	}
+	if global.ConsulClient == nil || time.Since(serviceConns.lastTry[name]) < serviceConnRetryInterval {
+		return nil
+	}
+	serviceConns.lastTry[name] = time.Now()
+
+	c, addr, err := dialService(name)
+	if err != nil {
+		zap.S().Warnf("连接服务%s失败，%v后重试: %v", name, serviceConnRetryInterval, err)
+		return nil
+	}
+	*conn = c
+	zap.S().Infof("服务%s客户端连接成功: %s", name, addr)
+	return c
+}
+
+// dialService 从Consul获取健康的服务实例并建立连接，简单选择第一个健康的实例
+func dialService(name string) (*grpc.ClientConn, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), serviceLookupTimeout)
+	defer cancel()
+	services, _, err := global.ConsulClient.Health().Service(name, "", true, (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, "", fmt.Errorf("从Consul获取服务失败: %w", err)
+	}
+	if len(services) == 0 {
+		return nil, "", fmt.Errorf("没有可用的服务实例")
+	}
+	service := services[0]
+	addr := fmt.Sprintf("%s:%d", service.Service.Address, service.Service.Port)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, "", err
+	}
+	return conn, addr, nil
+}